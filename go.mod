@@ -3,17 +3,26 @@ module github.com/litmuschaos/litmusctl
 go 1.16
 
 require (
+	github.com/AlecAivazis/survey/v2 v2.3.6
+	github.com/Khan/genqlient v0.5.0
 	github.com/argoproj/argo-workflows/v3 v3.3.1
 	github.com/fatih/color v1.13.0
 	github.com/golang-jwt/jwt v3.2.2+incompatible
 	github.com/gorhill/cronexpr v0.0.0-20180427100037-88b0669f7d75
+	github.com/gorilla/websocket v1.5.0
 	github.com/litmuschaos/chaos-operator v0.0.0-20221010164339-e91b0109a875
 	github.com/litmuschaos/litmus/litmus-portal/graphql-server v0.0.0-20221019142834-cbc3e089e654
+	github.com/mattn/go-colorable v0.1.12
 	github.com/mitchellh/go-homedir v1.1.0
 	github.com/spf13/cobra v1.3.0
+	github.com/spf13/pflag v1.0.5
 	github.com/spf13/viper v1.10.1
+	github.com/vektah/gqlparser v1.3.1 // indirect
+	github.com/vektah/gqlparser/v2 v2.4.5
+	golang.org/x/crypto v0.0.0-20220315160706-3147a52a75dd
 	golang.org/x/term v0.0.0-20210927222741-03fcf44c2211
 	gopkg.in/yaml.v2 v2.4.0
+	gopkg.in/yaml.v3 v3.0.1
 	k8s.io/api v0.23.3
 	k8s.io/apimachinery v0.23.3
 	k8s.io/client-go v12.0.0+incompatible
@@ -16,15 +16,18 @@ limitations under the License.
 package agent
 
 import (
-	"fmt"
+	"context"
+	"errors"
 	"os"
 	"strconv"
 	"strings"
+	"text/tabwriter"
 
 	"github.com/litmuschaos/litmusctl/pkg/apis"
 	"github.com/litmuschaos/litmusctl/pkg/k8s"
 	"github.com/litmuschaos/litmusctl/pkg/types"
 	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"k8s.io/apimachinery/pkg/util/version"
 )
 
 func PrintExistingAgents(agent apis.AgentData) {
@@ -41,66 +44,47 @@ func PrintExistingAgents(agent apis.AgentData) {
 
 // GetProjectID display list of projects and returns the project id based on input
 func GetProjectID(u apis.ProjectDetails) string {
-	var pid int
-	utils.White_B.Println("Project list:")
-	for index := range u.Data.Projects {
-		utils.White_B.Printf("%d.  %s\n", index+1, u.Data.Projects[index].Name)
+	var projects []string
+	for _, project := range u.Data.Projects {
+		projects = append(projects, project.Name)
 	}
 
-repeat:
-	utils.White_B.Printf("\nSelect a project [Range: 1-%s]: ", fmt.Sprint(len(u.Data.Projects)))
-	fmt.Scanln(&pid)
-
-	for pid < 1 || pid > len(u.Data.Projects) {
-		utils.Red.Println("❗ Invalid Project. Please select a correct one.")
-		goto repeat
-	}
+	index, err := utils.PromptSelect("Select a project", projects, 0)
+	utils.PrintError(err)
 
-	return u.Data.Projects[pid-1].ID
+	return u.Data.Projects[index].ID
 }
 
 // GetModeType gets mode of Chaos Delegate installation as input
 func GetModeType() string {
-repeat:
-	var (
-		cluster_no   = 1
-		namespace_no = 2
-		mode         = cluster_no
-	)
-
-	utils.White_B.Println("\nInstallation Modes:\n1. Cluster\n2. Namespace")
-	utils.White_B.Print("\nSelect Mode [Default: ", utils.DefaultMode, "] [Range: 1-2]: ")
-	fmt.Scanln(&mode)
-
-	if mode == 1 {
-		return "cluster"
-	}
-
-	if mode == 2 {
-		return "namespace"
+	modes := []string{"cluster", "namespace"}
+	defaultMode := 0
+	for i, mode := range modes {
+		if mode == utils.DefaultMode {
+			defaultMode = i
+		}
 	}
 
-	if (mode != cluster_no) || (mode != namespace_no) {
-		utils.Red.Println("🚫 Invalid mode. Please enter the correct mode")
-		goto repeat
-	}
+	index, err := utils.PromptSelect("Select the installation mode", modes, defaultMode)
+	utils.PrintError(err)
 
-	return utils.DefaultMode
+	return modes[index]
 }
 
 // GetAgentDetails take details of Chaos Delegate as input
-func GetAgentDetails(mode string, pid string, c types.Credentials, kubeconfig *string) (types.Agent, error) {
-	var newAgent types.Agent
+func GetAgentDetails(ctx context.Context, mode string, pid string, c types.Credentials, kubeconfig *string) (types.Agent, error) {
+	var (
+		newAgent types.Agent
+		err      error
+	)
 	// Get agent name as input
 	utils.White_B.Println("\nEnter the details of the Chaos Delegate")
 	// Label for goto statement in case of invalid Chaos Delegate name
 
 AGENT_NAME:
-	utils.White_B.Print("\nChaos Delegate Name: ")
-	newAgent.AgentName = utils.Scanner()
-	if newAgent.AgentName == "" {
-		utils.Red.Println("⛔ Chaos Delegate name cannot be empty. Please enter a valid name.")
-		goto AGENT_NAME
+	newAgent.AgentName, err = utils.PromptInput("Chaos Delegate Name", "", utils.NotEmpty("Chaos Delegate name"))
+	if err != nil {
+		return types.Agent{}, err
 	}
 
 	// Check if Chaos Delegate with the given name already exists
@@ -123,57 +107,78 @@ AGENT_NAME:
 	}
 
 	// Get agent description as input
-	utils.White_B.Print("\nChaos Delegate Description: ")
-	newAgent.Description = utils.Scanner()
-
-	utils.White_B.Print("\nDo you want Chaos Delegate to skip SSL/TLS check (Y/N) (Default: N): ")
-	skipSSLDescision := utils.Scanner()
+	newAgent.Description, err = utils.PromptInput("Chaos Delegate Description", "", nil)
+	if err != nil {
+		return types.Agent{}, err
+	}
 
-	if strings.ToLower(skipSSLDescision) == "y" {
-		newAgent.SkipSSL = true
-	} else {
-		newAgent.SkipSSL = false
+	newAgent.SkipSSL, err = utils.PromptConfirm("Do you want Chaos Delegate to skip SSL/TLS check?", false)
+	if err != nil {
+		return types.Agent{}, err
 	}
 
-	utils.White_B.Print("\nDo you want NodeSelector to be added in the Chaos Delegate deployments (Y/N) (Default: N): ")
-	nodeSelectorDescision := utils.Scanner()
+	nodeSelectorDescision, err := utils.PromptConfirm("Do you want NodeSelector to be added in the Chaos Delegate deployments?", false)
+	if err != nil {
+		return types.Agent{}, err
+	}
 
-	if strings.ToLower(nodeSelectorDescision) == "y" {
-		utils.White_B.Print("\nEnter the NodeSelector (Format: key1=value1,key2=value2): ")
-		newAgent.NodeSelector = utils.Scanner()
+	if nodeSelectorDescision {
+		newAgent.NodeSelector, err = utils.PromptInput("Enter the NodeSelector (Format: key1=value1,key2=value2)", "", utils.NotEmpty("NodeSelector"))
+		if err != nil {
+			return types.Agent{}, err
+		}
 
 		if ok := utils.CheckKeyValueFormat(newAgent.NodeSelector); !ok {
 			os.Exit(1)
 		}
 	}
 
-	utils.White_B.Print("\nDo you want Tolerations to be added in the Chaos Delegate deployments? (Y/N) (Default: N): ")
-	tolerationDescision := utils.Scanner()
+	tolerationDescision, err := utils.PromptConfirm("Do you want Tolerations to be added in the Chaos Delegate deployments?", false)
+	if err != nil {
+		return types.Agent{}, err
+	}
 
-	if strings.ToLower(tolerationDescision) == "y" {
-		utils.White_B.Print("\nHow many tolerations? ")
-		no_of_tolerations := utils.Scanner()
+	if tolerationDescision {
+		no_of_tolerations, err := utils.PromptInput("How many tolerations?", "1", func(answer string) error {
+			if n, err := strconv.Atoi(answer); err != nil || n < 1 {
+				return errors.New("enter a number of tolerations greater than 0")
+			}
+			return nil
+		})
+		if err != nil {
+			return types.Agent{}, err
+		}
 
-		nts, err := strconv.Atoi(no_of_tolerations)
-		utils.PrintError(err)
+		nts, _ := strconv.Atoi(no_of_tolerations)
 
 		str := "["
 		for tol := 0; tol < nts; tol++ {
 			str += "{"
 
-			utils.White_B.Print("\nToleration count: ", tol+1)
+			utils.White_B.Print("\nToleration count: ", tol+1, "\n")
 
-			utils.White_B.Print("\nTolerationSeconds: (Press Enter to ignore)")
-			ts := utils.Scanner()
+			ts, err := utils.PromptInput("TolerationSeconds (Press Enter to ignore)", "", func(answer string) error {
+				if _, err := strconv.Atoi(answer); answer != "" && err != nil {
+					return errors.New("TolerationSeconds should be a number")
+				}
+				return nil
+			})
+			if err != nil {
+				return types.Agent{}, err
+			}
 
-			utils.White_B.Print("\nOperator: ")
-			operator := utils.Scanner()
+			operator, err := utils.PromptInput("Operator", "", nil)
+			if err != nil {
+				return types.Agent{}, err
+			}
 			if operator != "" {
 				str += "operator : \\\"" + operator + "\\\" "
 			}
 
-			utils.White_B.Print("\nEffect: ")
-			effect := utils.Scanner()
+			effect, err := utils.PromptInput("Effect", "", nil)
+			if err != nil {
+				return types.Agent{}, err
+			}
 
 			if effect != "" {
 				str += "effect: \\\"" + effect + "\\\" "
@@ -183,14 +188,18 @@ AGENT_NAME:
 				str += "tolerationSeconds: " + ts + " "
 			}
 
-			utils.White_B.Print("\nKey: ")
-			key := utils.Scanner()
+			key, err := utils.PromptInput("Key", "", nil)
+			if err != nil {
+				return types.Agent{}, err
+			}
 			if key != "" {
 				str += "key: \\\"" + key + "\\\" "
 			}
 
-			utils.White_B.Print("\nValue: ")
-			value := utils.Scanner()
+			value, err := utils.PromptInput("Value", "", nil)
+			if err != nil {
+				return types.Agent{}, err
+			}
 			if key != "" {
 				str += "value: \\\"" + value + "\\\" "
 			}
@@ -203,57 +212,168 @@ AGENT_NAME:
 	}
 
 	// Get platform name as input
-	newAgent.PlatformName = GetPlatformName(kubeconfig)
+	newAgent.PlatformName = GetPlatformName(ctx, kubeconfig)
 	// Set agent type
 	newAgent.ClusterType = utils.AgentType
 	// Set project id
 	newAgent.ProjectId = pid
 	// Get namespace
-	newAgent.Namespace, newAgent.NsExists = k8s.ValidNs(mode, utils.ChaosAgentLabel, kubeconfig)
+	newAgent.Namespace, newAgent.NsExists, err = k8s.ValidNs(ctx, mode, utils.ChaosAgentLabel, kubeconfig)
+	if err != nil {
+		return types.Agent{}, err
+	}
 
 	return newAgent, nil
 }
 
-func ValidateSAPermissions(namespace string, mode string, kubeconfig *string) {
-	var (
-		pems      [2]bool
-		err       error
-		resources [2]string
-	)
+func ValidateSAPermissions(ctx context.Context, namespace string, mode string, kubeconfig *string) {
+	report, err := k8s.CheckSAPermissions(ctx, InstallPermissions(mode, namespace), kubeconfig)
+	if err != nil {
+		utils.Red.Println(err)
+	}
 
-	if mode == "cluster" {
-		resources = [2]string{"clusterrole", "clusterrolebinding"}
-	} else {
-		resources = [2]string{"role", "rolebinding"}
+	PrintPermissionReport(report)
+
+	if err != nil || !report.Allowed() {
+		utils.Red.Println("\n🚫 You don't have sufficient permissions.\n🙄 Please use a service account with sufficient permissions, or ask a cluster admin to grant them with: litmusctl generate rbac --mode " + mode)
+		os.Exit(1)
 	}
 
-	for i, resource := range resources {
-		pems[i], err = k8s.CheckSAPermissions(k8s.CheckSAPermissionsParams{Verb: "create", Resource: resource, Print: true, Namespace: namespace}, kubeconfig)
-		if err != nil {
-			utils.Red.Println(err)
+	utils.White_B.Println("\n🌟 Sufficient permissions. Installing the Chaos Delegate...")
+}
+
+// PrintPermissionReport prints the outcome of each permission check, followed by
+// the reasons of the denied ones
+func PrintPermissionReport(report k8s.PermissionReport) {
+	for _, result := range report {
+		if result.Allowed {
+			utils.White_B.Print("\n🔑 ", result.Verb, " ", result.Resource, " ✅")
+		} else {
+			utils.White_B.Print("\n🔑 ", result.Verb, " ", result.Resource, " ❌")
+		}
+	}
+	utils.White_B.Println()
+
+	for _, result := range report.Denied() {
+		switch {
+		case result.Err != nil:
+			utils.Red.Println(result.Resource + ": " + result.Err.Error())
+		case result.EvaluationError != "":
+			utils.Red.Println(result.Resource + ": " + result.EvaluationError)
+		case result.Reason != "":
+			utils.White_B.Println(result.Resource + ": " + result.Reason)
 		}
 	}
+}
 
-	for _, pem := range pems {
-		if !pem {
-			utils.Red.Println("\n🚫 You don't have sufficient permissions.\n🙄 Please use a service account with sufficient permissions.")
-			os.Exit(1)
+// chaosAPIGroups are the API groups of the CRDs used by the Chaos Delegate
+var chaosAPIGroups = []string{"argoproj.io", "litmuschaos.io"}
+
+// ValidateClusterCompatibility checks that the Kubernetes version of the cluster is supported,
+// and that the CRDs are installed when they aren't part of the Chaos Delegate manifest
+func ValidateClusterCompatibility(ctx context.Context, mode string, kubeconfig *string) {
+	capabilities, err := k8s.GetClusterCapabilities(ctx, kubeconfig)
+	if err != nil {
+		utils.Red.Println("\n❌ Unable to discover the cluster capabilities: " + err.Error())
+		os.Exit(1)
+	}
+
+	serverVersion, err := version.ParseGeneric(capabilities.Version.GitVersion)
+	if err != nil {
+		utils.Red.Println("\n❌ Unable to parse the Kubernetes version " + capabilities.Version.GitVersion + ": " + err.Error())
+		os.Exit(1)
+	}
+	if !serverVersion.AtLeast(version.MustParseGeneric(utils.MinKubernetesVersion)) {
+		utils.Red.Println("\n🚫 Kubernetes " + capabilities.Version.GitVersion + " is not supported by the Chaos Delegate.\n🙄 Please upgrade the cluster to " + utils.MinKubernetesVersion + " or newer.")
+		os.Exit(1)
+	}
+	utils.White_B.Print("\n☸️  Kubernetes ", capabilities.Version.GitVersion, " ✅")
+
+	var missing []string
+	for _, group := range chaosAPIGroups {
+		if !capabilities.APIGroups[group] {
+			missing = append(missing, group)
 		}
 	}
 
-	utils.White_B.Println("\n🌟 Sufficient permissions. Installing the Chaos Delegate...")
+	// The CRDs are installed along with the Chaos Delegate in cluster mode only
+	if len(missing) > 0 && mode == "namespace" {
+		utils.Red.Println("\n🚫 The CRDs of the " + strings.Join(missing, ", ") + " API groups are not installed in the cluster.\n🙄 The namespaced mode requires a cluster admin to install them first, see https://docs.litmuschaos.io for the CRD manifests.")
+		os.Exit(1)
+	}
+	utils.White_B.Println()
+}
+
+// ValidateExistingSA checks that an existing service account has the permissions
+// required by the Chaos Delegate, and reports the missing ones
+func ValidateExistingSA(ctx context.Context, agent types.Agent, kubeconfig *string) {
+	namespace := agent.Namespace
+	if agent.Mode == "cluster" {
+		namespace = ""
+	}
+
+	checks := []k8s.CheckSAPermissionsParams{
+		{Verb: "get", Resource: "configmaps"},
+		{Verb: "update", Resource: "configmaps"},
+		{Verb: "get", Resource: "secrets"},
+		{Verb: "list", Resource: "pods"},
+		{Verb: "watch", Resource: "pods"},
+		{Verb: "list", Resource: "events"},
+		{Verb: "create", Resource: "workflows", Group: "argoproj.io"},
+		{Verb: "list", Resource: "chaosengines", Group: "litmuschaos.io"},
+	}
+	for i := range checks {
+		checks[i].Namespace = namespace
+	}
+
+	utils.White_B.Print("\n🏃 Checking the permissions of the service account ", agent.ServiceAccount, "....")
+	report, err := k8s.CheckServiceAccountPermissions(ctx, agent.Namespace, agent.ServiceAccount, checks, kubeconfig)
+	if err != nil {
+		utils.Red.Println("\n❌ Unable to check the permissions of the service account: ", err.Error())
+		return
+	}
+
+	PrintPermissionReport(report)
+
+	if !report.Allowed() {
+		utils.Red.Println("\n⚠️ The service account " + agent.ServiceAccount + " is missing the permissions above, the Chaos Delegate may fail to start.\n🙄 Please bind the required roles to it, or use a new service account.")
+	}
+}
+
+// PrintPodEvents prints the warning events of the Chaos Delegate pods, which usually
+// explain why they aren't running
+func PrintPodEvents(ctx context.Context, params k8s.WatchPodParams, kubeconfig *string) {
+	events, err := k8s.PodWarningEvents(ctx, params, kubeconfig)
+	if err != nil {
+		utils.Red.Println("Unable to fetch the events of the Chaos Delegate pods: " + err.Error())
+		return
+	}
+	if len(events) == 0 {
+		return
+	}
+
+	utils.White_B.Println("\n📋 Events of the Chaos Delegate pods:")
+	writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+	utils.White_B.Fprintln(writer, "POD\tREASON\tCOUNT\tMESSAGE")
+	for _, event := range events {
+		utils.White.Fprintln(writer, event.InvolvedObject.Name+"\t"+event.Reason+"\t"+strconv.Itoa(int(event.Count))+"\t"+strings.TrimSpace(event.Message))
+	}
+	writer.Flush()
 }
 
 // Summary display the agent details based on input
-func Summary(agent types.Agent, kubeconfig *string) {
+func Summary(ctx context.Context, agent types.Agent, kubeconfig *string) {
 	utils.White_B.Printf("\n📌 Summary \nChaos Delegate Name: %s\nChaos Delegate Description: %s\nChaos Delegate SSL/TLS Skip: %t\nPlatform Name: %s\n", agent.AgentName, agent.Description, agent.SkipSSL, agent.PlatformName)
-	if ok, _ := k8s.NsExists(agent.Namespace, kubeconfig); ok {
+	if ok, _ := k8s.NsExists(ctx, agent.Namespace, kubeconfig); ok {
 		utils.White_B.Println("Namespace: ", agent.Namespace)
 	} else {
 		utils.White_B.Println("Namespace: ", agent.Namespace, "(new)")
 	}
 
-	if k8s.SAExists(k8s.SAExistsParams{Namespace: agent.Namespace, Serviceaccount: agent.ServiceAccount}, kubeconfig) {
+	saExists, err := k8s.SAExists(ctx, k8s.SAExistsParams{Namespace: agent.Namespace, Serviceaccount: agent.ServiceAccount}, kubeconfig)
+	utils.PrintError(err)
+
+	if saExists {
 		utils.White_B.Println("Service Account: ", agent.ServiceAccount)
 	} else {
 		utils.White_B.Println("Service Account: ", agent.ServiceAccount, "(new)")
@@ -263,11 +383,10 @@ func Summary(agent types.Agent, kubeconfig *string) {
 }
 
 func ConfirmInstallation() {
-	var descision string
-	utils.White_B.Print("\n🤷 Do you want to continue with the above details? [Y/N]: ")
-	fmt.Scanln(&descision)
+	descision, err := utils.PromptConfirm("🤷 Do you want to continue with the above details?", false)
+	utils.PrintError(err)
 
-	if strings.ToLower(descision) == "yes" || strings.ToLower(descision) == "y" {
+	if descision {
 		utils.White_B.Println("👍 Continuing Chaos Delegate connection!!")
 	} else {
 		utils.Red.Println("✋ Exiting Chaos Delegate connection!!")
@@ -275,6 +394,35 @@ func ConfirmInstallation() {
 	}
 }
 
+// CheckExistingInstallation looks for an existing Litmus installation in the cluster, e.g. the one of the
+// ChaosCenter or another Chaos Delegate, and returns whether one was found. The connection goes on
+// anyway, the manifest is then applied server-side so that only the fields it would change in the
+// objects of the other installation fail, as conflicts.
+func CheckExistingInstallation(ctx context.Context, adopt bool, kubeconfig *string) bool {
+	installation, err := k8s.FindLitmusInstallation(ctx, kubeconfig)
+	if err != nil {
+		utils.Red.Println("\n⚠️ Unable to check for an existing Litmus installation: " + err.Error())
+		return false
+	}
+	if !installation.Exists() {
+		return false
+	}
+
+	utils.Red.Println("\n⚠️  Found an existing Litmus installation in the cluster:")
+	for _, crd := range installation.CRDs {
+		utils.White.Println("- CRD " + crd)
+	}
+	for _, operator := range installation.Operators {
+		utils.White.Println("- chaos-operator " + operator)
+	}
+	if adopt {
+		utils.White_B.Println("👍 The conflicting fields of the objects in the namespace of the Chaos Delegate are taken over, the cluster-scoped ones, e.g. the CRDs, are left to the existing installation.")
+	} else {
+		utils.White_B.Println("👍 Continuing, the connection fails only if the Chaos Delegate manifest changes fields owned by the existing installation.")
+	}
+	return true
+}
+
 func CreateRandomProject(cred types.Credentials) string {
 	rand, err := utils.GenerateRandomString(10)
 	utils.PrintError(err)
@@ -17,7 +17,6 @@ package agent
 
 import (
 	"context"
-	"fmt"
 	"strings"
 
 	"github.com/litmuschaos/litmusctl/pkg/k8s"
@@ -25,44 +24,34 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
-// - Entering any character other than numbers returns 0. Input validation need to be done.
-// - If input is given as "123abc", "abc" will be used for next user input. Buffer need to be read completely.
 // - String literals like "AWS" are used at multiple places. Need to be changed to constants.
-func GetPlatformName(kubeconfig *string) string {
-	var platform int
-	discoveredPlatform := DiscoverPlatform(kubeconfig)
-	utils.White_B.Println("\nPlatform List: ")
-	utils.White_B.Println(utils.PlatformList)
-	utils.White_B.Print("\nSelect a platform [Default: ", discoveredPlatform, "] [Range: 1-5]: ")
-	fmt.Scanln(&platform)
-	switch platform {
-	case 0:
-		return discoveredPlatform
-	case 1:
-		return "AWS"
-	case 2:
-		return "GKE"
-	case 3:
-		return "Openshift"
-	case 4:
-		return "Rancher"
-	default:
-		return utils.DefaultPlatform
+func GetPlatformName(ctx context.Context, kubeconfig *string) string {
+	discoveredPlatform := DiscoverPlatform(ctx, kubeconfig)
+	defaultPlatform := 0
+	for i, platform := range utils.PlatformList {
+		if platform == discoveredPlatform {
+			defaultPlatform = i
+		}
 	}
+
+	index, err := utils.PromptSelect("Select a platform", utils.PlatformList, defaultPlatform)
+	utils.PrintError(err)
+
+	return utils.PlatformList[index]
 }
 
 // discoverPlatform determines the host platform and returns it
-func DiscoverPlatform(kubeconfig *string) string {
-	if ok, _ := IsAWSPlatform(kubeconfig); ok {
+func DiscoverPlatform(ctx context.Context, kubeconfig *string) string {
+	if ok, _ := IsAWSPlatform(ctx, kubeconfig); ok {
 		return "AWS"
 	}
-	if ok, _ := IsGKEPlatform(kubeconfig); ok {
+	if ok, _ := IsGKEPlatform(ctx, kubeconfig); ok {
 		return "GKE"
 	}
-	if ok, _ := IsOpenshiftPlatform(kubeconfig); ok {
+	if ok, _ := IsOpenshiftPlatform(ctx, kubeconfig); ok {
 		return "Openshift"
 	}
-	if ok, _ := k8s.NsExists("cattle-system", kubeconfig); ok {
+	if ok, _ := k8s.NsExists(ctx, "cattle-system", kubeconfig); ok {
 		return "Rancher"
 	}
 	return utils.DefaultPlatform
@@ -72,21 +61,25 @@ func DiscoverPlatform(kubeconfig *string) string {
 // by checking the ProviderID inside node spec
 //
 // Sample node custom resource of an AWS node
-// {
-//     "apiVersion": "v1",
-//     "kind": "Node",
-//     ....
-//     "spec": {
-//         "providerID": "aws:///us-east-2b/i-0bf24d83f4b993738"
-//     }
-//   }
-// }
-func IsAWSPlatform(kubeconfig *string) (bool, error) {
+//
+//	{
+//	    "apiVersion": "v1",
+//	    "kind": "Node",
+//	    ....
+//	    "spec": {
+//	        "providerID": "aws:///us-east-2b/i-0bf24d83f4b993738"
+//	    }
+//	  }
+//	}
+func IsAWSPlatform(ctx context.Context, kubeconfig *string) (bool, error) {
 	clientset, err := k8s.ClientSet(kubeconfig)
 	if err != nil {
 		return false, err
 	}
-	nodeList, err := clientset.CoreV1().Nodes().List(context.TODO(), v1.ListOptions{})
+	ctx, cancel := context.WithTimeout(ctx, k8s.RequestTimeout)
+	defer cancel()
+
+	nodeList, err := clientset.CoreV1().Nodes().List(ctx, v1.ListOptions{})
 	if err != nil || len(nodeList.Items) == 0 {
 		return false, err
 	}
@@ -100,21 +93,25 @@ func IsAWSPlatform(kubeconfig *string) (bool, error) {
 // by checking the ProviderID inside node spec
 //
 // Sample node custom resource of an GKE node
-// {
-//     "apiVersion": "v1",
-//     "kind": "Node",
-//     ....
-//     "spec": {
-//         "providerID": ""
-//     }
-//   }
-// }
-func IsGKEPlatform(kubeconfig *string) (bool, error) {
+//
+//	{
+//	    "apiVersion": "v1",
+//	    "kind": "Node",
+//	    ....
+//	    "spec": {
+//	        "providerID": ""
+//	    }
+//	  }
+//	}
+func IsGKEPlatform(ctx context.Context, kubeconfig *string) (bool, error) {
 	clientset, err := k8s.ClientSet(kubeconfig)
 	if err != nil {
 		return false, err
 	}
-	nodeList, err := clientset.CoreV1().Nodes().List(context.TODO(), v1.ListOptions{})
+	ctx, cancel := context.WithTimeout(ctx, k8s.RequestTimeout)
+	defer cancel()
+
+	nodeList, err := clientset.CoreV1().Nodes().List(ctx, v1.ListOptions{})
 	if err != nil || len(nodeList.Items) == 0 {
 		return false, err
 	}
@@ -129,22 +126,26 @@ func IsGKEPlatform(kubeconfig *string) (bool, error) {
 // label on the nodes
 //
 // Sample node custom resource of an Openshift node
-// {
-//     "apiVersion": "v1",
-//     "kind": "Node",
-//     "metadata": {
-//         "labels": {
-//             "node.openshift.io/os_id": "rhcos"
-//         }
-//    }
-//    ....
-// }
-func IsOpenshiftPlatform(kubeconfig *string) (bool, error) {
+//
+//	{
+//	    "apiVersion": "v1",
+//	    "kind": "Node",
+//	    "metadata": {
+//	        "labels": {
+//	            "node.openshift.io/os_id": "rhcos"
+//	        }
+//	   }
+//	   ....
+//	}
+func IsOpenshiftPlatform(ctx context.Context, kubeconfig *string) (bool, error) {
 	clientset, err := k8s.ClientSet(kubeconfig)
 	if err != nil {
 		return false, err
 	}
-	nodeList, err := clientset.CoreV1().Nodes().List(context.TODO(), v1.ListOptions{
+	ctx, cancel := context.WithTimeout(ctx, k8s.RequestTimeout)
+	defer cancel()
+
+	nodeList, err := clientset.CoreV1().Nodes().List(ctx, v1.ListOptions{
 		LabelSelector: utils.OpenshiftIdentifier,
 	})
 	if err != nil {
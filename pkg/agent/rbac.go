@@ -0,0 +1,119 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package agent
+
+import (
+	"strings"
+
+	"github.com/litmuschaos/litmusctl/pkg/k8s"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// installerRoleName is the name of the role generated for the users installing the Chaos Delegate
+const installerRoleName = "litmusctl-installer"
+
+// InstallPermissions returns the permissions required to install the Chaos Delegate in the given mode.
+// They are both checked before the installation and used to generate the RBAC for the installer.
+func InstallPermissions(mode string, namespace string) []k8s.CheckSAPermissionsParams {
+	if mode == "cluster" {
+		return []k8s.CheckSAPermissionsParams{
+			{Verb: "create", Resource: "clusterroles", Group: rbacv1.GroupName},
+			{Verb: "create", Resource: "clusterrolebindings", Group: rbacv1.GroupName},
+		}
+	}
+
+	permissions := []k8s.CheckSAPermissionsParams{
+		{Verb: "create", Resource: "roles", Group: rbacv1.GroupName},
+		{Verb: "create", Resource: "rolebindings", Group: rbacv1.GroupName},
+		// The role of the Chaos Delegate grants permissions the installer doesn't need to hold
+		{Verb: "escalate", Resource: "roles", Group: rbacv1.GroupName},
+		{Verb: "bind", Resource: "roles", Group: rbacv1.GroupName},
+		{Verb: "create", Resource: "serviceaccounts"},
+		{Verb: "create", Resource: "configmaps"},
+		{Verb: "create", Resource: "secrets"},
+		{Verb: "create", Resource: "services"},
+		{Verb: "create", Resource: "deployments", Group: "apps"},
+	}
+	for i := range permissions {
+		permissions[i].Namespace = namespace
+	}
+	return permissions
+}
+
+// InstallRBAC returns the YAML of the role and the role binding granting the subject the
+// permissions to install the Chaos Delegate in the given mode
+func InstallRBAC(mode string, namespace string, subject rbacv1.Subject) (string, error) {
+	// The resources created are read and patched by kubectl apply as well
+	var rules []rbacv1.PolicyRule
+	index := map[string]int{}
+	for _, permission := range InstallPermissions(mode, namespace) {
+		key := permission.Group + "/" + permission.Resource
+		i, ok := index[key]
+		if !ok {
+			i = len(rules)
+			index[key] = i
+			rules = append(rules, rbacv1.PolicyRule{APIGroups: []string{permission.Group}, Resources: []string{permission.Resource}})
+		}
+		rules[i].Verbs = append(rules[i].Verbs, permission.Verb)
+		if permission.Verb == "create" {
+			rules[i].Verbs = append(rules[i].Verbs, "get", "patch")
+		}
+	}
+
+	var objects []interface{}
+	if mode == "cluster" {
+		objects = []interface{}{
+			rbacv1.ClusterRole{
+				TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRole"},
+				ObjectMeta: metav1.ObjectMeta{Name: installerRoleName},
+				Rules:      rules,
+			},
+			rbacv1.ClusterRoleBinding{
+				TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRoleBinding"},
+				ObjectMeta: metav1.ObjectMeta{Name: installerRoleName},
+				Subjects:   []rbacv1.Subject{subject},
+				RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: installerRoleName},
+			},
+		}
+	} else {
+		objects = []interface{}{
+			rbacv1.Role{
+				TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "Role"},
+				ObjectMeta: metav1.ObjectMeta{Name: installerRoleName, Namespace: namespace},
+				Rules:      rules,
+			},
+			rbacv1.RoleBinding{
+				TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "RoleBinding"},
+				ObjectMeta: metav1.ObjectMeta{Name: installerRoleName, Namespace: namespace},
+				Subjects:   []rbacv1.Subject{subject},
+				RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "Role", Name: installerRoleName},
+			},
+		}
+	}
+
+	var documents []string
+	for _, object := range objects {
+		document, err := yaml.Marshal(object)
+		if err != nil {
+			return "", err
+		}
+		documents = append(documents, string(document))
+	}
+
+	return strings.Join(documents, "---\n"), nil
+}
@@ -16,12 +16,14 @@ limitations under the License.
 package apis
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 
+	"github.com/litmuschaos/litmusctl/pkg/apis/gql"
 	"github.com/litmuschaos/litmusctl/pkg/utils"
 
 	types "github.com/litmuschaos/litmusctl/pkg/types"
@@ -40,6 +42,13 @@ type AgentDetails struct {
 	IsActive     bool   `json:"isActive"`
 	IsRegistered bool   `json:"isRegistered"`
 	ClusterID    string `json:"clusterID"`
+	Version      string `json:"version"`
+	Namespace    string `json:"agentNamespace"`
+	// LastHeartbeat is the last time the Chaos Delegate reported to the ChaosCenter. The ChaosCenters
+	// before 3.0 have no heartbeat, the last update of the Chaos Delegate is used instead.
+	LastHeartbeat string `json:"lastHeartbeat"`
+	// EnvironmentID is only set by the ChaosCenters from 3.0, which group the Chaos Delegates in environments
+	EnvironmentID string `json:"environmentID,omitempty"`
 }
 
 type AgentList struct {
@@ -48,33 +57,37 @@ type AgentList struct {
 
 // GetAgentList lists the Chaos Delegate connected to the specified project
 func GetAgentList(c types.Credentials, pid string) (AgentData, error) {
-	query := `{"query":"query{\n  listClusters(projectID: \"` + pid + `\"){\n  clusterID clusterName isActive isRegistered\n  }\n}"}`
-	resp, err := SendRequest(SendRequestParams{Endpoint: c.Endpoint + utils.GQLAPIPath, Token: c.Token}, []byte(query), string(types.Post))
-	if err != nil {
-		return AgentData{}, err
+	return GetAgentListByTags(c, pid, nil)
+}
+
+// GetAgentListByTags lists the Chaos Delegates of the project carrying all the given tags,
+// the tags are filtered by the server
+func GetAgentListByTags(c types.Credentials, pid string, tags []string) (AgentData, error) {
+	if DetectSchema(c.Endpoint) == SchemaV3 {
+		return getAgentListV3(c, pid, tags)
+	}
+	if len(tags) > 0 {
+		return AgentData{}, errTagsV2
 	}
 
-	bodyBytes, err := ioutil.ReadAll(resp.Body)
-	defer resp.Body.Close()
+	resp, err := gql.ListClusters(context.Background(), graphQLClient(c.Endpoint, c.Token), pid)
 	if err != nil {
-		return AgentData{}, err
+		return AgentData{}, graphQLError(err)
 	}
 
-	if resp.StatusCode == http.StatusOK {
-		var agent AgentData
-		err = json.Unmarshal(bodyBytes, &agent)
-		if err != nil {
-			return AgentData{}, err
-		}
-
-		if len(agent.Errors) > 0 {
-			return AgentData{}, errors.New(agent.Errors[0].Message)
-		}
-
-		return agent, nil
-	} else {
-		return AgentData{}, err
+	var agents AgentData
+	for _, cluster := range resp.ListClusters {
+		agents.Data.GetAgent = append(agents.Data.GetAgent, AgentDetails{
+			AgentName:     cluster.ClusterName,
+			IsActive:      cluster.IsActive,
+			IsRegistered:  cluster.IsRegistered,
+			ClusterID:     cluster.ClusterID,
+			Version:       cluster.Version,
+			Namespace:     cluster.AgentNamespace,
+			LastHeartbeat: cluster.UpdatedAt,
+		})
 	}
+	return agents, nil
 }
 
 type AgentConnectionData struct {
@@ -0,0 +1,125 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apitest
+
+import (
+	"net/http"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// The default user and project of the mock ChaosCenter
+const (
+	Username    = "admin"
+	Password    = "litmus"
+	UserID      = "5ec2b52a-8e34-4e5c-9b7f-7a4c3e1d0001"
+	ProjectID   = "0c3c7bd1-1f3a-4b3e-8a6e-1b5d2f9c0001"
+	ProjectName = "admin-project"
+
+	// ServerVersion is the version of ChaosCenter reported by the mock, speaking the 2.x schema
+	ServerVersion = "2.14.0"
+
+	// DelegateID is the ID of the active Chaos Delegate of the default project
+	DelegateID = "b1f0a2c3-5d6e-4f70-8a9b-0c1d2e3f0001"
+)
+
+// Token is the access token of the default user, accepted by the mock ChaosCenter. It carries
+// the claims read by litmusctl, and expires in 2100.
+var Token = func() string {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"uid":      UserID,
+		"username": Username,
+		"role":     "admin",
+		"iat":      1672531200,
+		"exp":      4102444800,
+	}).SignedString([]byte("apitest"))
+	if err != nil {
+		panic(err)
+	}
+	return token
+}()
+
+// createdAt is the creation time of the fixtures, in milliseconds as reported by the ChaosCenter
+const createdAt = "1672531200000"
+
+// defaultFields are the canned responses of the GraphQL fields
+func defaultFields() map[string]interface{} {
+	return map[string]interface{}{
+		"getServerVersion": map[string]interface{}{
+			"key":   "version",
+			"value": ServerVersion,
+		},
+		"listClusters": []map[string]interface{}{
+			{
+				"clusterID":             DelegateID,
+				"clusterName":           "self-agent",
+				"description":           "",
+				"isActive":              true,
+				"isRegistered":          true,
+				"isClusterConfirmed":    true,
+				"clusterType":           "internal",
+				"agentNamespace":        "litmus",
+				"agentScope":            "namespace",
+				"platformName":          "Kubernetes",
+				"version":               ServerVersion,
+				"noOfWorkflows":         0,
+				"noOfSchedules":         0,
+				"lastWorkflowTimestamp": "0",
+				"createdAt":             createdAt,
+				"updatedAt":             createdAt,
+				"projectID":             ProjectID,
+			},
+		},
+		"listWorkflows": map[string]interface{}{
+			"totalNoOfWorkflows": 0,
+			"workflows":          []interface{}{},
+		},
+		"listWorkflowRuns": map[string]interface{}{
+			"totalNoOfWorkflowRuns": 0,
+			"workflowRuns":          []interface{}{},
+		},
+	}
+}
+
+// defaultRoutes are the canned responses of the auth server routes
+func defaultRoutes() map[string]Response {
+	member := map[string]interface{}{
+		"UserID":     UserID,
+		"UserName":   Username,
+		"Role":       "Owner",
+		"Invitation": "Accepted",
+		"JoinedAt":   createdAt,
+	}
+	project := map[string]interface{}{
+		"ID":        ProjectID,
+		"Name":      ProjectName,
+		"CreatedAt": createdAt,
+		"Members":   []interface{}{member},
+	}
+
+	return map[string]Response{
+		"/status":        {Status: http.StatusOK, Body: map[string]string{"status": "up"}},
+		"/list_projects": {Status: http.StatusOK, Body: map[string]interface{}{"data": []interface{}{project}}},
+		"/get_user_with_project/" + Username: {Status: http.StatusOK, Body: map[string]interface{}{
+			"data": map[string]interface{}{"ID": UserID, "Projects": []interface{}{project}},
+		}},
+	}
+}
+
+// publicRoutes are the auth server routes served without a token
+var publicRoutes = map[string]bool{
+	"/status": true,
+}
@@ -0,0 +1,360 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package apitest provides a mock ChaosCenter, serving the GraphQL API and the auth server
+// with canned fixtures, so that automations built on litmusctl can be tested without a live
+// ChaosCenter. The responses of single GraphQL fields and auth routes can be overridden, and
+// the requests received by the server are recorded for assertions.
+//
+//	server := apitest.NewServer()
+//	defer server.Close()
+//
+//	server.Respond("listClusters", []map[string]interface{}{{"clusterID": "d1", "clusterName": "my-delegate", "isActive": true}})
+//	agents, err := apis.GetAgentList(server.Credentials(), apitest.ProjectID)
+package apitest
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+// Resolver resolves a GraphQL field from the variables of the request. The returned value is
+// encoded as the data of the field, an error is returned as a GraphQL error of the field.
+type Resolver func(variables map[string]interface{}) (interface{}, error)
+
+// Response is the response of an auth server route
+type Response struct {
+	Status int
+	Body   interface{}
+}
+
+// Request is a request received by the mock ChaosCenter
+type Request struct {
+	Method string
+	Path   string
+	Header http.Header
+	Body   []byte
+
+	// OperationName, Fields and Variables are only set for the GraphQL requests, Fields
+	// are the names of the root fields queried
+	OperationName string
+	Fields        []string
+	Variables     map[string]interface{}
+}
+
+// Server is a mock ChaosCenter, listening on a local address
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	resolvers map[string]Resolver
+	routes    map[string]Response
+	requests  []Request
+}
+
+// publicFields are the GraphQL fields served without a token, as by the ChaosCenter
+var publicFields = map[string]bool{
+	"getServerVersion": true,
+	"__type":           true,
+	"__schema":         true,
+}
+
+// NewServer starts a mock ChaosCenter serving the default fixtures, it should be closed
+// once the test is done
+func NewServer() *Server {
+	s := &Server{
+		resolvers: make(map[string]Resolver),
+		routes:    make(map[string]Response),
+	}
+	for field, data := range defaultFields() {
+		s.Respond(field, data)
+	}
+	for path, response := range defaultRoutes() {
+		s.routes[path] = response
+	}
+	s.resolvers["__type"] = func(map[string]interface{}) (interface{}, error) { return nil, nil }
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(utils.GQLAPIPath, s.serveGraphQL)
+	mux.HandleFunc(utils.AuthAPIPath+"/", s.serveAuth)
+	s.Server = httptest.NewServer(mux)
+	return s
+}
+
+// Credentials returns the credentials of the default user on the mock ChaosCenter
+func (s *Server) Credentials() types.Credentials {
+	return types.Credentials{
+		Endpoint: s.URL,
+		Username: Username,
+		Token:    Token,
+	}
+}
+
+// Handle resolves the GraphQL field with the resolver
+func (s *Server) Handle(field string, resolver Resolver) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.resolvers[field] = resolver
+}
+
+// Respond answers the GraphQL field with the data, which is encoded to JSON
+func (s *Server) Respond(field string, data interface{}) {
+	s.Handle(field, func(map[string]interface{}) (interface{}, error) { return data, nil })
+}
+
+// Fail answers the GraphQL field with an error carrying the message
+func (s *Server) Fail(field string, message string) {
+	s.Handle(field, func(map[string]interface{}) (interface{}, error) { return nil, errors.New(message) })
+}
+
+// RespondAuth answers the auth server route, e.g. "/list_projects", with the status and
+// the body, which is encoded to JSON unless it's a string
+func (s *Server) RespondAuth(path string, status int, body interface{}) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[path] = Response{Status: status, Body: body}
+}
+
+// Requests returns the requests received so far, in order
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// GraphQLRequests returns the GraphQL requests received so far which queried the field
+func (s *Server) GraphQLRequests(field string) []Request {
+	var requests []Request
+	for _, request := range s.Requests() {
+		for _, f := range request.Fields {
+			if f == field {
+				requests = append(requests, request)
+				break
+			}
+		}
+	}
+	return requests
+}
+
+// Reset forgets the requests received so far
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+}
+
+func newRequest(r *http.Request, body []byte) Request {
+	return Request{
+		Method: r.Method,
+		Path:   r.URL.Path,
+		Header: r.Header.Clone(),
+		Body:   body,
+	}
+}
+
+func (s *Server) serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	request := newRequest(r, body)
+
+	var payload struct {
+		Query         string                 `json:"query"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		s.save(request)
+		writeJSON(w, http.StatusBadRequest, graphQLErrors("json request body could not be decoded: "+err.Error()))
+		return
+	}
+	request.OperationName, request.Variables = payload.OperationName, payload.Variables
+
+	operation, err := parseOperation(payload.Query, payload.OperationName)
+	if err != nil {
+		s.save(request)
+		writeJSON(w, http.StatusUnprocessableEntity, graphQLErrors(err.Error()))
+		return
+	}
+	if request.OperationName == "" {
+		request.OperationName = operation.Name
+	}
+	for _, selection := range operation.SelectionSet {
+		if field, ok := selection.(*ast.Field); ok {
+			request.Fields = append(request.Fields, field.Name)
+		}
+	}
+	s.save(request)
+
+	authorized := bearerToken(r.Header.Get("Authorization")) == Token
+	data := make(map[string]interface{})
+	var errs []map[string]interface{}
+	for _, selection := range operation.SelectionSet {
+		field, ok := selection.(*ast.Field)
+		if !ok {
+			continue
+		}
+		alias := field.Alias
+		if alias == "" {
+			alias = field.Name
+		}
+
+		s.mu.Lock()
+		resolver, ok := s.resolvers[field.Name]
+		s.mu.Unlock()
+
+		var value interface{}
+		switch {
+		case !ok:
+			errs = append(errs, graphQLError(`Cannot query field "`+field.Name+`" on type "`+rootType(operation)+`".`, nil))
+			continue
+		case !authorized && !publicFields[field.Name]:
+			err = errors.New("permission_denied")
+		default:
+			value, err = resolver(payload.Variables)
+		}
+		if err != nil {
+			errs = append(errs, graphQLError(err.Error(), []string{alias}))
+			value = nil
+		}
+		data[alias] = value
+	}
+
+	response := map[string]interface{}{"data": data}
+	if len(errs) > 0 {
+		response["errors"] = errs
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (s *Server) serveAuth(w http.ResponseWriter, r *http.Request) {
+	body, _ := ioutil.ReadAll(r.Body)
+	s.save(newRequest(r, body))
+
+	path := strings.TrimPrefix(r.URL.Path, utils.AuthAPIPath)
+	if path == "/login" {
+		s.serveLogin(w, body)
+		return
+	}
+
+	s.mu.Lock()
+	response, ok := s.routes[path]
+	s.mu.Unlock()
+	if !ok {
+		writeJSON(w, http.StatusNotFound, map[string]string{"error": "not_found", "errorDescription": "404 page not found"})
+		return
+	}
+	if !publicRoutes[path] && bearerToken(r.Header.Get("Authorization")) != Token {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "unauthorized", "errorDescription": "The user does not have requested authorization to access this resource"})
+		return
+	}
+	writeJSON(w, response.Status, response.Body)
+}
+
+// serveLogin answers the login of the default user, unless the route is overridden
+func (s *Server) serveLogin(w http.ResponseWriter, body []byte) {
+	s.mu.Lock()
+	response, ok := s.routes["/login"]
+	s.mu.Unlock()
+	if ok {
+		writeJSON(w, response.Status, response.Body)
+		return
+	}
+
+	var credentials struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+	}
+	if json.Unmarshal(body, &credentials) != nil || credentials.Username != Username || credentials.Password != Password {
+		writeJSON(w, http.StatusUnauthorized, map[string]string{"error": "invalid_credentials", "errorDescription": "Invalid Credentials"})
+		return
+	}
+	writeJSON(w, http.StatusOK, types.AuthResponse{AccessToken: Token, ExpiresIn: 86400, Type: "Bearer"})
+}
+
+func (s *Server) save(request Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = append(s.requests, request)
+}
+
+// parseOperation parses the GraphQL document and returns the operation to execute
+func parseOperation(query string, operationName string) (*ast.OperationDefinition, error) {
+	document, err := parser.ParseQuery(&ast.Source{Input: query})
+	if err != nil {
+		return nil, err
+	}
+	if len(document.Operations) == 0 {
+		return nil, errors.New("no operation provided")
+	}
+	if operationName == "" {
+		if len(document.Operations) > 1 {
+			return nil, errors.New("operation name is required")
+		}
+		return document.Operations[0], nil
+	}
+	operation := document.Operations.ForName(operationName)
+	if operation == nil {
+		return nil, errors.New("operation " + operationName + " not found")
+	}
+	return operation, nil
+}
+
+func rootType(operation *ast.OperationDefinition) string {
+	switch operation.Operation {
+	case ast.Mutation:
+		return "Mutation"
+	case ast.Subscription:
+		return "Subscription"
+	default:
+		return "Query"
+	}
+}
+
+func bearerToken(header string) string {
+	return strings.TrimSpace(strings.TrimPrefix(header, "Bearer "))
+}
+
+func graphQLError(message string, path []string) map[string]interface{} {
+	err := map[string]interface{}{"message": message}
+	if path != nil {
+		err["path"] = path
+	}
+	return err
+}
+
+func graphQLErrors(message string) map[string]interface{} {
+	return map[string]interface{}{"errors": []map[string]interface{}{graphQLError(message, nil)}}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	if text, ok := body.(string); ok {
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(text))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,155 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/apis/apitest"
+	"github.com/litmuschaos/litmusctl/pkg/types"
+)
+
+func TestMain(m *testing.M) {
+	// The responses of the fixtures differ from one test to another
+	apis.NoCache = true
+	os.Exit(m.Run())
+}
+
+func TestAuth(t *testing.T) {
+	server := apitest.NewServer()
+	defer server.Close()
+
+	response, err := apis.Auth(types.AuthInput{Endpoint: server.URL, Username: apitest.Username, Password: apitest.Password})
+	if err != nil {
+		t.Fatalf("Auth: %v", err)
+	}
+	if response.AccessToken != apitest.Token {
+		t.Errorf("Auth returned the access token %q, want apitest.Token", response.AccessToken)
+	}
+
+	if _, err := apis.Auth(types.AuthInput{Endpoint: server.URL, Username: apitest.Username, Password: "wrong"}); err == nil {
+		t.Error("Auth succeeded with a wrong password")
+	}
+}
+
+func TestGetServerVersion(t *testing.T) {
+	server := apitest.NewServer()
+	defer server.Close()
+
+	version, err := apis.GetServerVersion(server.URL)
+	if err != nil {
+		t.Fatalf("GetServerVersion: %v", err)
+	}
+	if got := version.Data.GetServerVersion.Value; got != apitest.ServerVersion {
+		t.Errorf("GetServerVersion = %q, want %q", got, apitest.ServerVersion)
+	}
+}
+
+func TestGetProjectDetails(t *testing.T) {
+	server := apitest.NewServer()
+	defer server.Close()
+
+	details, err := apis.GetProjectDetails(server.Credentials())
+	if err != nil {
+		t.Fatalf("GetProjectDetails: %v", err)
+	}
+	if details.Data.ID != apitest.UserID {
+		t.Errorf("GetProjectDetails returned the user %q, want %q", details.Data.ID, apitest.UserID)
+	}
+	if len(details.Data.Projects) != 1 || details.Data.Projects[0].ID != apitest.ProjectID || details.Data.Projects[0].Name != apitest.ProjectName {
+		t.Fatalf("GetProjectDetails returned the projects %+v, want %s", details.Data.Projects, apitest.ProjectName)
+	}
+	if members := details.Data.Projects[0].Members; len(members) != 1 || members[0].UserID != apitest.UserID || members[0].Role != "Owner" {
+		t.Errorf("GetProjectDetails returned the members %+v, want %s as Owner", members, apitest.Username)
+	}
+}
+
+func TestGetAgentList(t *testing.T) {
+	server := apitest.NewServer()
+	defer server.Close()
+
+	agents, err := apis.GetAgentList(server.Credentials(), apitest.ProjectID)
+	if err != nil {
+		t.Fatalf("GetAgentList: %v", err)
+	}
+	if len(agents.Data.GetAgent) != 1 {
+		t.Fatalf("GetAgentList returned %d Chaos Delegates, want 1", len(agents.Data.GetAgent))
+	}
+	agent := agents.Data.GetAgent[0]
+	if agent.ClusterID != apitest.DelegateID || agent.AgentName != "self-agent" || !agent.IsActive || agent.Namespace != "litmus" {
+		t.Errorf("GetAgentList returned %+v, want the active self-agent in litmus", agent)
+	}
+
+	requests := server.GraphQLRequests("listClusters")
+	if len(requests) != 1 {
+		t.Fatalf("the server received %d listClusters requests, want 1", len(requests))
+	}
+	if got := requests[0].Variables["projectID"]; got != apitest.ProjectID {
+		t.Errorf("listClusters was queried with the project %v, want %s", got, apitest.ProjectID)
+	}
+	if got := requests[0].Header.Get("Authorization"); got != "Bearer "+apitest.Token && got != apitest.Token {
+		t.Errorf("listClusters was queried with the Authorization %q, want the token", got)
+	}
+}
+
+func TestGetAgentListOverridden(t *testing.T) {
+	server := apitest.NewServer()
+	defer server.Close()
+
+	server.Respond("listClusters", []map[string]interface{}{
+		{"clusterID": "d1", "clusterName": "first", "isActive": true},
+		{"clusterID": "d2", "clusterName": "second", "isActive": false},
+	})
+	agents, err := apis.GetAgentList(server.Credentials(), apitest.ProjectID)
+	if err != nil {
+		t.Fatalf("GetAgentList: %v", err)
+	}
+	if len(agents.Data.GetAgent) != 2 || agents.Data.GetAgent[1].AgentName != "second" || agents.Data.GetAgent[1].IsActive {
+		t.Errorf("GetAgentList returned %+v, want the overridden Chaos Delegates", agents.Data.GetAgent)
+	}
+
+	server.Fail("listClusters", "project not found")
+	if _, err := apis.GetAgentList(server.Credentials(), apitest.ProjectID); err == nil {
+		t.Error("GetAgentList succeeded on a GraphQL error")
+	}
+}
+
+func TestUnauthorized(t *testing.T) {
+	server := apitest.NewServer()
+	defer server.Close()
+
+	credentials := server.Credentials()
+	credentials.Token = "invalid"
+	if _, err := apis.GetAgentList(credentials, apitest.ProjectID); err == nil {
+		t.Error("GetAgentList succeeded with an invalid token")
+	}
+}
+
+func TestGetAllWorkflows(t *testing.T) {
+	server := apitest.NewServer()
+	defer server.Close()
+
+	workflows, err := apis.GetAllWorkflows(model.ListWorkflowsRequest{ProjectID: apitest.ProjectID}, nil, server.Credentials())
+	if err != nil {
+		t.Fatalf("GetAllWorkflows: %v", err)
+	}
+	if n := len(workflows.Data.ListWorkflowDetails.Workflows); n != 0 {
+		t.Errorf("GetAllWorkflows returned %d Chaos Scenarios, want none", n)
+	}
+}
@@ -0,0 +1,37 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ErrGraphQLBlocked is returned when the GraphQL API of the ChaosCenter is blocked by the gateway in
+// front of it, which only exposes the REST API of the auth server. The project, user, invitation and
+// login operations go through the REST API of the auth server, and keep working.
+var ErrGraphQLBlocked = errors.New("the GraphQL API of the ChaosCenter is not reachable, the gateway in front of it may only expose the auth server")
+
+// graphQLBlocked checks whether the response to a GraphQL request comes from a gateway blocking the
+// GraphQL API rather than from the GraphQL server, which always answers with JSON
+func graphQLBlocked(resp *http.Response) bool {
+	switch resp.StatusCode {
+	case http.StatusForbidden, http.StatusNotFound, http.StatusMethodNotAllowed, http.StatusBadGateway:
+		return !strings.Contains(resp.Header.Get("Content-Type"), "json")
+	}
+	return false
+}
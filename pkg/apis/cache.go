@@ -0,0 +1,79 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	types "github.com/litmuschaos/litmusctl/pkg/types"
+)
+
+// ResponseCacheTTL is the time the responses of the read-only queries are cached for
+const ResponseCacheTTL = time.Minute
+
+// NoCache disables the response cache, set by the --no-cache flag
+var NoCache bool
+
+// cachedResponse decodes the cached response of the query into out, or fetches it and caches it.
+// The responses are cached per endpoint and token, so that users never see the responses of others.
+func cachedResponse(cred types.Credentials, query []string, out interface{}, fetch func() error) error {
+	dir, ok := responseCacheDir(cred)
+	if !ok {
+		return fetch()
+	}
+	key := sha256.Sum256([]byte(strings.Join(query, "\x00")))
+	cacheFile := filepath.Join(dir, hex.EncodeToString(key[:])+".json")
+
+	if info, err := os.Stat(cacheFile); !NoCache && err == nil && time.Since(info.ModTime()) < ResponseCacheTTL {
+		if data, err := ioutil.ReadFile(cacheFile); err == nil && json.Unmarshal(data, out) == nil {
+			return nil
+		}
+	}
+
+	if err := fetch(); err != nil {
+		return err
+	}
+	if data, err := json.Marshal(out); err == nil {
+		if err := os.MkdirAll(dir, 0700); err == nil {
+			_ = ioutil.WriteFile(cacheFile, data, 0600)
+		}
+	}
+	return nil
+}
+
+// invalidateResponseCache drops the cached responses of the credentials, after a mutation
+func invalidateResponseCache(cred types.Credentials) {
+	if dir, ok := responseCacheDir(cred); ok {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+// responseCacheDir returns the directory the responses of the credentials are cached in
+func responseCacheDir(cred types.Credentials) (string, bool) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", false
+	}
+	identity := sha256.Sum256([]byte(cred.Endpoint + "\x00" + cred.Token))
+	return filepath.Join(cacheDir, "litmusctl", "responses", hex.EncodeToString(identity[:])), true
+}
@@ -0,0 +1,54 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// authorizedDoer sends the requests of the typed GraphQL client with the token, like SendRequest
+type authorizedDoer struct {
+	token string
+}
+
+func (d authorizedDoer) Do(req *http.Request) (*http.Response, error) {
+	req.Header.Set("Authorization", d.token)
+	resp, err := doRequest(req)
+	if err == nil && graphQLBlocked(resp) {
+		resp.Body.Close()
+		return nil, ErrGraphQLBlocked
+	}
+	return resp, err
+}
+
+// graphQLClient returns the typed GraphQL client of the ChaosCenter, see the gql package
+func graphQLClient(endpoint string, token string) graphql.Client {
+	return graphql.NewClient(endpoint+utils.GQLAPIPath, authorizedDoer{token: token})
+}
+
+// graphQLError returns the first error of the GraphQL response, like the hand-written requests
+func graphQLError(err error) error {
+	var errList gqlerror.List
+	if errors.As(err, &errList) && len(errList) > 0 {
+		return errors.New(errList[0].Message)
+	}
+	return err
+}
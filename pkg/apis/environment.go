@@ -0,0 +1,395 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	types "github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+)
+
+type Environment struct {
+	ProjectID     string                `json:"projectID"`
+	EnvironmentID string                `json:"environmentID"`
+	Name          string                `json:"name"`
+	Description   *string               `json:"description,omitempty"`
+	Tags          []string              `json:"tags,omitempty"`
+	Type          types.EnvironmentType `json:"type"`
+	CreatedAt     string                `json:"createdAt"`
+	UpdatedAt     string                `json:"updatedAt"`
+	InfraIDs      []string              `json:"infraIDs,omitempty"`
+}
+
+type EnvironmentData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data CreateEnvironmentDetails `json:"data"`
+}
+
+type CreateEnvironmentDetails struct {
+	CreateEnvironment Environment `json:"createEnvironment"`
+}
+
+type CreateEnvironmentGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		ProjectID string                         `json:"projectID"`
+		Request   types.CreateEnvironmentRequest `json:"request"`
+	} `json:"variables"`
+}
+
+// CreateEnvironment sends GraphQL API request for creating an environment.
+func CreateEnvironment(projectID string, request types.CreateEnvironmentRequest, cred types.Credentials) (EnvironmentData, error) {
+
+	defer invalidateResponseCache(cred)
+
+	var gqlReq CreateEnvironmentGraphQLRequest
+	var err error
+
+	gqlReq.Query = `mutation createEnvironment($projectID: ID!, $request: CreateEnvironmentRequest!) {
+                      createEnvironment(projectID: $projectID, request: $request) {
+                        environmentID
+                        name
+                        type
+                        tags
+                        createdAt
+                      }
+                    }`
+	gqlReq.Variables.ProjectID = projectID
+	gqlReq.Variables.Request = request
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return EnvironmentData{}, err
+	}
+
+	resp, err := SendRequest(
+		SendRequestParams{
+			Endpoint: cred.Endpoint + utils.GQLAPIPath,
+			Token:    cred.Token,
+		},
+		query,
+		string(types.Post),
+	)
+	if err != nil {
+		return EnvironmentData{}, err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return EnvironmentData{}, errors.New("Error in creating environment: " + err.Error())
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var createdEnvironment EnvironmentData
+		err = json.Unmarshal(bodyBytes, &createdEnvironment)
+		if err != nil {
+			return EnvironmentData{}, errors.New("Error in creating environment: " + err.Error())
+		}
+
+		if len(createdEnvironment.Errors) > 0 {
+			return EnvironmentData{}, errors.New(createdEnvironment.Errors[0].Message)
+		}
+
+		return createdEnvironment, nil
+	} else {
+		return EnvironmentData{}, errors.New("Error in creating environment: " + string(bodyBytes))
+	}
+}
+
+type EnvironmentListData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data EnvironmentList `json:"data"`
+}
+
+type EnvironmentList struct {
+	ListEnvironments struct {
+		TotalNoOfEnvironments int           `json:"totalNoOfEnvironments"`
+		Environments          []Environment `json:"environments"`
+	} `json:"listEnvironments"`
+}
+
+type ListEnvironmentsGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		ProjectID string                  `json:"projectID"`
+		Request   ListEnvironmentsRequest `json:"request"`
+	} `json:"variables"`
+}
+
+// ListEnvironmentsRequest selects the environments to list. If environment IDs are given, only those
+// environments are returned, and without pagination all the environments are returned in a single page.
+type ListEnvironmentsRequest struct {
+	EnvironmentIDs []string                `json:"environmentIDs,omitempty"`
+	Pagination     *model.Pagination       `json:"pagination,omitempty"`
+	Filter         *EnvironmentFilterInput `json:"filter,omitempty"`
+	Sort           *EnvironmentSortInput   `json:"sort,omitempty"`
+}
+
+// EnvironmentFilterInput filters the environments by type and by tags, an environment has to carry all the tags
+type EnvironmentFilterInput struct {
+	Type *types.EnvironmentType `json:"type,omitempty"`
+	Tags []string               `json:"tags,omitempty"`
+}
+
+// EnvironmentSortInput sorts the environments by NAME or TIME
+type EnvironmentSortInput struct {
+	Field     string `json:"field"`
+	Ascending *bool  `json:"ascending,omitempty"`
+}
+
+const environmentListFields = `totalNoOfEnvironments
+                        environments {
+                          projectID
+                          environmentID
+                          name
+                          description
+                          tags
+                          type
+                          createdAt
+                          updatedAt
+                          infraIDs
+                        }`
+
+// ListEnvironments sends GraphQL API request for fetching the environments of a project.
+// The response is cached for ResponseCacheTTL.
+func ListEnvironments(projectID string, request ListEnvironmentsRequest, cred types.Credentials) (EnvironmentListData, error) {
+	key, err := json.Marshal(request)
+	if err != nil {
+		return EnvironmentListData{}, err
+	}
+
+	var data EnvironmentListData
+	err = cachedResponse(cred, []string{"listEnvironments", projectID, string(key)}, &data, func() (err error) {
+		data, err = listEnvironments(projectID, request, cred)
+		return err
+	})
+	return data, err
+}
+
+func listEnvironments(projectID string, request ListEnvironmentsRequest, cred types.Credentials) (EnvironmentListData, error) {
+
+	var gqlReq ListEnvironmentsGraphQLRequest
+	var err error
+
+	gqlReq.Query = `query listEnvironments($projectID: ID!, $request: ListEnvironmentRequest) {
+                      listEnvironments(projectID: $projectID, request: $request) {
+                        ` + environmentListFields + `
+                      }
+                    }`
+	gqlReq.Variables.ProjectID = projectID
+	gqlReq.Variables.Request = request
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return EnvironmentListData{}, err
+	}
+
+	resp, err := SendRequest(
+		SendRequestParams{
+			Endpoint: cred.Endpoint + utils.GQLAPIPath,
+			Token:    cred.Token,
+		},
+		query,
+		string(types.Post),
+	)
+	if err != nil {
+		return EnvironmentListData{}, err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return EnvironmentListData{}, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var environmentList EnvironmentListData
+		err = json.Unmarshal(bodyBytes, &environmentList)
+		if err != nil {
+			return EnvironmentListData{}, err
+		}
+
+		if len(environmentList.Errors) > 0 {
+			return EnvironmentListData{}, errors.New(environmentList.Errors[0].Message)
+		}
+
+		return environmentList, nil
+	} else {
+		return EnvironmentListData{}, errors.New("Error while fetching the environments")
+	}
+}
+
+type EnvironmentDescriptionData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data struct {
+		EnvironmentList
+		InfraList
+	} `json:"data"`
+}
+
+type DescribeEnvironmentGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		ProjectID     string `json:"projectID"`
+		EnvironmentID string `json:"environmentID"`
+	} `json:"variables"`
+}
+
+// DescribeEnvironment fetches an environment along with its Chaos Infrastructures, batched in a single GraphQL request
+func DescribeEnvironment(projectID string, environmentID string, cred types.Credentials) (EnvironmentDescriptionData, error) {
+
+	var gqlReq DescribeEnvironmentGraphQLRequest
+	var err error
+
+	gqlReq.Query = `query describeEnvironment($projectID: ID!, $environmentID: ID!) {
+                      listEnvironments(projectID: $projectID, request: {environmentIDs: [$environmentID]}) {
+                        ` + environmentListFields + `
+                      }
+                      listInfras(projectID: $projectID, request: {environmentIDs: [$environmentID]}) {
+                        ` + infraListFields + `
+                      }
+                    }`
+	gqlReq.Variables.ProjectID = projectID
+	gqlReq.Variables.EnvironmentID = environmentID
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return EnvironmentDescriptionData{}, err
+	}
+
+	resp, err := SendRequest(
+		SendRequestParams{
+			Endpoint: cred.Endpoint + utils.GQLAPIPath,
+			Token:    cred.Token,
+		},
+		query,
+		string(types.Post),
+	)
+	if err != nil {
+		return EnvironmentDescriptionData{}, err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return EnvironmentDescriptionData{}, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var description EnvironmentDescriptionData
+		err = json.Unmarshal(bodyBytes, &description)
+		if err != nil {
+			return EnvironmentDescriptionData{}, err
+		}
+
+		if len(description.Errors) > 0 {
+			return EnvironmentDescriptionData{}, errors.New(description.Errors[0].Message)
+		}
+
+		return description, nil
+	} else {
+		return EnvironmentDescriptionData{}, errors.New("Error while fetching the environment")
+	}
+}
+
+type DeleteEnvironmentData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data DeleteEnvironmentDetails `json:"data"`
+}
+
+type DeleteEnvironmentDetails struct {
+	DeleteEnvironment string `json:"deleteEnvironment"`
+}
+
+type DeleteEnvironmentGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		ProjectID     string `json:"projectID"`
+		EnvironmentID string `json:"environmentID"`
+	} `json:"variables"`
+}
+
+// DeleteEnvironment sends GraphQL API request for deleting an environment.
+func DeleteEnvironment(projectID string, environmentID string, cred types.Credentials) (DeleteEnvironmentData, error) {
+
+	defer invalidateResponseCache(cred)
+
+	var gqlReq DeleteEnvironmentGraphQLRequest
+	var err error
+
+	gqlReq.Query = `mutation deleteEnvironment($projectID: ID!, $environmentID: ID!) {
+                      deleteEnvironment(projectID: $projectID, environmentID: $environmentID)
+                    }`
+	gqlReq.Variables.ProjectID = projectID
+	gqlReq.Variables.EnvironmentID = environmentID
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return DeleteEnvironmentData{}, err
+	}
+
+	resp, err := SendRequest(
+		SendRequestParams{
+			Endpoint: cred.Endpoint + utils.GQLAPIPath,
+			Token:    cred.Token,
+		},
+		query,
+		string(types.Post),
+	)
+	if err != nil {
+		return DeleteEnvironmentData{}, err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return DeleteEnvironmentData{}, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var deletedEnvironment DeleteEnvironmentData
+		err = json.Unmarshal(bodyBytes, &deletedEnvironment)
+		if err != nil {
+			return DeleteEnvironmentData{}, err
+		}
+
+		if len(deletedEnvironment.Errors) > 0 {
+			return DeleteEnvironmentData{}, errors.New(deletedEnvironment.Errors[0].Message)
+		}
+
+		return deletedEnvironment, nil
+	} else {
+		return DeleteEnvironmentData{}, errors.New("Error while deleting the environment")
+	}
+}
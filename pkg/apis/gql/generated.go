@@ -0,0 +1,367 @@
+// Code generated by github.com/Khan/genqlient, DO NOT EDIT.
+
+package gql
+
+import (
+	"context"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+)
+
+// DeleteChaosWorkflowResponse is returned by DeleteChaosWorkflow on success.
+type DeleteChaosWorkflowResponse struct {
+	// Removes a workflow from cluster
+	DeleteChaosWorkflow bool `json:"deleteChaosWorkflow"`
+}
+
+// GetDeleteChaosWorkflow returns DeleteChaosWorkflowResponse.DeleteChaosWorkflow, and is useful for accessing the field via an interface.
+func (v *DeleteChaosWorkflowResponse) GetDeleteChaosWorkflow() bool { return v.DeleteChaosWorkflow }
+
+// GetServerVersionGetServerVersionServerVersionResponse includes the requested fields of the GraphQL type ServerVersionResponse.
+// The GraphQL type's documentation follows.
+//
+// Response received for fetching GQL server version
+type GetServerVersionGetServerVersionServerVersionResponse struct {
+	// Returns server version key
+	Key string `json:"key"`
+	// Returns server version value
+	Value string `json:"value"`
+}
+
+// GetKey returns GetServerVersionGetServerVersionServerVersionResponse.Key, and is useful for accessing the field via an interface.
+func (v *GetServerVersionGetServerVersionServerVersionResponse) GetKey() string { return v.Key }
+
+// GetValue returns GetServerVersionGetServerVersionServerVersionResponse.Value, and is useful for accessing the field via an interface.
+func (v *GetServerVersionGetServerVersionServerVersionResponse) GetValue() string { return v.Value }
+
+// GetServerVersionResponse is returned by GetServerVersion on success.
+type GetServerVersionResponse struct {
+	// Returns version of gql server
+	GetServerVersion GetServerVersionGetServerVersionServerVersionResponse `json:"getServerVersion"`
+}
+
+// GetGetServerVersion returns GetServerVersionResponse.GetServerVersion, and is useful for accessing the field via an interface.
+func (v *GetServerVersionResponse) GetGetServerVersion() GetServerVersionGetServerVersionServerVersionResponse {
+	return v.GetServerVersion
+}
+
+// ListClustersListClustersCluster includes the requested fields of the GraphQL type Cluster.
+// The GraphQL type's documentation follows.
+//
+// Defines the details for a cluster
+type ListClustersListClustersCluster struct {
+	// ID of the cluster
+	ClusterID string `json:"clusterID"`
+	// Name of the cluster
+	ClusterName string `json:"clusterName"`
+	// Bool value indicating if the cluster agent is active or not
+	IsActive bool `json:"isActive"`
+	// Bool value indicating if the cluster agent is registered or not
+	IsRegistered bool `json:"isRegistered"`
+	// Version of the cluster agent
+	Version string `json:"version"`
+	// Namespace where the cluster agent is being installed
+	AgentNamespace string `json:"agentNamespace"`
+	// Timestamp when the cluster agent was last updated
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// GetClusterID returns ListClustersListClustersCluster.ClusterID, and is useful for accessing the field via an interface.
+func (v *ListClustersListClustersCluster) GetClusterID() string { return v.ClusterID }
+
+// GetClusterName returns ListClustersListClustersCluster.ClusterName, and is useful for accessing the field via an interface.
+func (v *ListClustersListClustersCluster) GetClusterName() string { return v.ClusterName }
+
+// GetIsActive returns ListClustersListClustersCluster.IsActive, and is useful for accessing the field via an interface.
+func (v *ListClustersListClustersCluster) GetIsActive() bool { return v.IsActive }
+
+// GetIsRegistered returns ListClustersListClustersCluster.IsRegistered, and is useful for accessing the field via an interface.
+func (v *ListClustersListClustersCluster) GetIsRegistered() bool { return v.IsRegistered }
+
+// GetVersion returns ListClustersListClustersCluster.Version, and is useful for accessing the field via an interface.
+func (v *ListClustersListClustersCluster) GetVersion() string { return v.Version }
+
+// GetAgentNamespace returns ListClustersListClustersCluster.AgentNamespace, and is useful for accessing the field via an interface.
+func (v *ListClustersListClustersCluster) GetAgentNamespace() string { return v.AgentNamespace }
+
+// GetUpdatedAt returns ListClustersListClustersCluster.UpdatedAt, and is useful for accessing the field via an interface.
+func (v *ListClustersListClustersCluster) GetUpdatedAt() string { return v.UpdatedAt }
+
+// ListClustersResponse is returned by ListClusters on success.
+type ListClustersResponse struct {
+	// Returns clusters with a particular cluster type in the project
+	ListClusters []ListClustersListClustersCluster `json:"listClusters"`
+}
+
+// GetListClusters returns ListClustersResponse.ListClusters, and is useful for accessing the field via an interface.
+func (v *ListClustersResponse) GetListClusters() []ListClustersListClustersCluster {
+	return v.ListClusters
+}
+
+// ListWorkflowRunsResponse is returned by ListWorkflowRuns on success.
+type ListWorkflowRunsResponse struct {
+	// Returns the list of workflow runs in a project based on various filter parameters
+	ListWorkflowRuns model.ListWorkflowRunsResponse `json:"listWorkflowRuns"`
+}
+
+// GetListWorkflowRuns returns ListWorkflowRunsResponse.ListWorkflowRuns, and is useful for accessing the field via an interface.
+func (v *ListWorkflowRunsResponse) GetListWorkflowRuns() model.ListWorkflowRunsResponse {
+	return v.ListWorkflowRuns
+}
+
+// ListWorkflowsResponse is returned by ListWorkflows on success.
+type ListWorkflowsResponse struct {
+	// Returns the list of workflows in a project based on various filter parameters
+	ListWorkflows model.ListWorkflowsResponse `json:"listWorkflows"`
+}
+
+// GetListWorkflows returns ListWorkflowsResponse.ListWorkflows, and is useful for accessing the field via an interface.
+func (v *ListWorkflowsResponse) GetListWorkflows() model.ListWorkflowsResponse {
+	return v.ListWorkflows
+}
+
+// __DeleteChaosWorkflowInput is used internally by genqlient
+type __DeleteChaosWorkflowInput struct {
+	ProjectID     string  `json:"projectID"`
+	WorkflowID    *string `json:"workflowID"`
+	WorkflowRunID string  `json:"workflowRunID"`
+}
+
+// GetProjectID returns __DeleteChaosWorkflowInput.ProjectID, and is useful for accessing the field via an interface.
+func (v *__DeleteChaosWorkflowInput) GetProjectID() string { return v.ProjectID }
+
+// GetWorkflowID returns __DeleteChaosWorkflowInput.WorkflowID, and is useful for accessing the field via an interface.
+func (v *__DeleteChaosWorkflowInput) GetWorkflowID() *string { return v.WorkflowID }
+
+// GetWorkflowRunID returns __DeleteChaosWorkflowInput.WorkflowRunID, and is useful for accessing the field via an interface.
+func (v *__DeleteChaosWorkflowInput) GetWorkflowRunID() string { return v.WorkflowRunID }
+
+// __ListClustersInput is used internally by genqlient
+type __ListClustersInput struct {
+	ProjectID string `json:"projectID"`
+}
+
+// GetProjectID returns __ListClustersInput.ProjectID, and is useful for accessing the field via an interface.
+func (v *__ListClustersInput) GetProjectID() string { return v.ProjectID }
+
+// __ListWorkflowRunsInput is used internally by genqlient
+type __ListWorkflowRunsInput struct {
+	Request model.ListWorkflowRunsRequest `json:"request"`
+}
+
+// GetRequest returns __ListWorkflowRunsInput.Request, and is useful for accessing the field via an interface.
+func (v *__ListWorkflowRunsInput) GetRequest() model.ListWorkflowRunsRequest { return v.Request }
+
+// __ListWorkflowsInput is used internally by genqlient
+type __ListWorkflowsInput struct {
+	Request model.ListWorkflowsRequest `json:"request"`
+}
+
+// GetRequest returns __ListWorkflowsInput.Request, and is useful for accessing the field via an interface.
+func (v *__ListWorkflowsInput) GetRequest() model.ListWorkflowsRequest { return v.Request }
+
+func DeleteChaosWorkflow(
+	ctx context.Context,
+	client graphql.Client,
+	projectID string,
+	workflowID *string,
+	workflowRunID string,
+) (*DeleteChaosWorkflowResponse, error) {
+	req := &graphql.Request{
+		OpName: "DeleteChaosWorkflow",
+		Query: `
+mutation DeleteChaosWorkflow ($projectID: String!, $workflowID: String, $workflowRunID: String) {
+	deleteChaosWorkflow(projectID: $projectID, workflowID: $workflowID, workflowRunID: $workflowRunID)
+}
+`,
+		Variables: &__DeleteChaosWorkflowInput{
+			ProjectID:     projectID,
+			WorkflowID:    workflowID,
+			WorkflowRunID: workflowRunID,
+		},
+	}
+	var err error
+
+	var data DeleteChaosWorkflowResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func GetServerVersion(
+	ctx context.Context,
+	client graphql.Client,
+) (*GetServerVersionResponse, error) {
+	req := &graphql.Request{
+		OpName: "GetServerVersion",
+		Query: `
+query GetServerVersion {
+	getServerVersion {
+		key
+		value
+	}
+}
+`,
+	}
+	var err error
+
+	var data GetServerVersionResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func ListClusters(
+	ctx context.Context,
+	client graphql.Client,
+	projectID string,
+) (*ListClustersResponse, error) {
+	req := &graphql.Request{
+		OpName: "ListClusters",
+		Query: `
+query ListClusters ($projectID: String!) {
+	listClusters(projectID: $projectID) {
+		clusterID
+		clusterName
+		isActive
+		isRegistered
+		version
+		agentNamespace
+		updatedAt
+	}
+}
+`,
+		Variables: &__ListClustersInput{
+			ProjectID: projectID,
+		},
+	}
+	var err error
+
+	var data ListClustersResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func ListWorkflowRuns(
+	ctx context.Context,
+	client graphql.Client,
+	request model.ListWorkflowRunsRequest,
+) (*ListWorkflowRunsResponse, error) {
+	req := &graphql.Request{
+		OpName: "ListWorkflowRuns",
+		Query: `
+query ListWorkflowRuns ($request: ListWorkflowRunsRequest!) {
+	listWorkflowRuns(request: $request) {
+		totalNoOfWorkflowRuns
+		workflowRuns {
+			workflowRunID
+			workflowID
+			clusterName
+			workflowName
+			projectID
+			clusterID
+			clusterType
+			isRemoved
+			lastUpdated
+			phase
+			resiliencyScore
+			experimentsPassed
+			experimentsFailed
+			experimentsAwaited
+			experimentsStopped
+			experimentsNa
+			totalExperiments
+			executedBy
+		}
+	}
+}
+`,
+		Variables: &__ListWorkflowRunsInput{
+			Request: request,
+		},
+	}
+	var err error
+
+	var data ListWorkflowRunsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func ListWorkflows(
+	ctx context.Context,
+	client graphql.Client,
+	request model.ListWorkflowsRequest,
+) (*ListWorkflowsResponse, error) {
+	req := &graphql.Request{
+		OpName: "ListWorkflows",
+		Query: `
+query ListWorkflows ($request: ListWorkflowsRequest!) {
+	listWorkflows(request: $request) {
+		totalNoOfWorkflows
+		workflows {
+			workflowID
+			workflowManifest
+			cronSyntax
+			clusterName
+			workflowName
+			workflowDescription
+			weightages {
+				experimentName
+				weightage
+			}
+			isCustomWorkflow
+			updatedAt
+			createdAt
+			projectID
+			clusterID
+			clusterType
+			isRemoved
+			lastUpdatedBy
+		}
+	}
+}
+`,
+		Variables: &__ListWorkflowsInput{
+			Request: request,
+		},
+	}
+	var err error
+
+	var data ListWorkflowsResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
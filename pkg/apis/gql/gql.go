@@ -0,0 +1,21 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Package gql is the typed GraphQL client of the ChaosCenter. The operations in operations/ are
+// validated against the schema in schema/ and generated into generated.go, run go generate after
+// changing them.
+package gql
+
+//go:generate go run github.com/Khan/genqlient@v0.5.0 genqlient.yaml
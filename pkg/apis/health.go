@@ -0,0 +1,164 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+)
+
+// FailureType classifies why an endpoint check failed
+type FailureType string
+
+const (
+	DNSFailure        FailureType = "DNS"
+	ConnectionFailure FailureType = "Connection"
+	TimeoutFailure    FailureType = "Timeout"
+	TLSFailure        FailureType = "TLS"
+	AuthFailure       FailureType = "Auth"
+	HTTPFailure       FailureType = "HTTP"
+)
+
+// EndpointCheck is the result of probing a single ChaosCenter server
+type EndpointCheck struct {
+	Name       string      `json:"name"`
+	URL        string      `json:"url"`
+	Healthy    bool        `json:"healthy"`
+	Skipped    bool        `json:"skipped,omitempty"`
+	StatusCode int         `json:"statusCode,omitempty"`
+	Latency    string      `json:"latency,omitempty"`
+	TLSExpiry  *time.Time  `json:"tlsExpiry,omitempty"`
+	Failure    FailureType `json:"failure,omitempty"`
+	Message    string      `json:"message,omitempty"`
+}
+
+// EndpointHealth is the result of probing the auth and GraphQL servers of a ChaosCenter
+type EndpointHealth struct {
+	Endpoint      string          `json:"endpoint"`
+	ServerVersion string          `json:"serverVersion,omitempty"`
+	Healthy       bool            `json:"healthy"`
+	Checks        []EndpointCheck `json:"checks"`
+}
+
+// CheckEndpoint probes the auth server with the token of the credentials, and the GraphQL
+// server with the version query. The auth check is skipped when there is no token.
+func CheckEndpoint(cred types.Credentials, timeout time.Duration) EndpointHealth {
+	client := &http.Client{Transport: utils.Transport, Timeout: timeout}
+	health := EndpointHealth{Endpoint: cred.Endpoint, Healthy: true}
+
+	authCheck := EndpointCheck{Name: "auth server", URL: cred.Endpoint + utils.AuthAPIPath + "/list_projects"}
+	if cred.Token == "" {
+		authCheck.Healthy, authCheck.Skipped = true, true
+		authCheck.Message = "no credentials are stored for the endpoint"
+	} else {
+		req, err := http.NewRequest(string(types.Get), authCheck.URL, nil)
+		if err == nil {
+			req.Header.Set("Authorization", "Bearer "+cred.Token)
+			_, err = probe(client, req, &authCheck)
+		}
+		if err != nil {
+			authCheck.Failure, authCheck.Message = classifyError(err), err.Error()
+		}
+	}
+
+	gqlCheck := EndpointCheck{Name: "graphql server", URL: cred.Endpoint + utils.GQLAPIPath}
+	req, err := http.NewRequest(string(types.Post), gqlCheck.URL, bytes.NewBufferString(`{"query":"query{\n getServerVersion{\n key value\n }\n}"}`))
+	if err == nil {
+		req.Header.Set("Content-Type", "application/json")
+		var body []byte
+		body, err = probe(client, req, &gqlCheck)
+		if err == nil && gqlCheck.Healthy {
+			var version ServerVersionResponse
+			if err := json.Unmarshal(body, &version); err != nil || len(version.Errors) > 0 {
+				gqlCheck.Healthy, gqlCheck.Failure = false, HTTPFailure
+				gqlCheck.Message = "unexpected response to the version query"
+			} else {
+				health.ServerVersion = version.Data.GetServerVersion.Value
+				gqlCheck.Message = "ChaosCenter version " + health.ServerVersion
+			}
+		}
+	}
+	if err != nil {
+		gqlCheck.Failure, gqlCheck.Message = classifyError(err), err.Error()
+	}
+
+	health.Checks = []EndpointCheck{authCheck, gqlCheck}
+	for _, check := range health.Checks {
+		health.Healthy = health.Healthy && check.Healthy
+	}
+	return health
+}
+
+// probe sends the request and records the latency, the status and the TLS certificate expiry in the check
+func probe(client *http.Client, req *http.Request, check *EndpointCheck) ([]byte, error) {
+	start := time.Now()
+	utils.SetRequestHeaders(req.Header, req.URL.String())
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	check.Latency = time.Since(start).Round(time.Millisecond).String()
+	check.StatusCode = resp.StatusCode
+
+	if resp.TLS != nil && len(resp.TLS.PeerCertificates) > 0 {
+		expiry := resp.TLS.PeerCertificates[0].NotAfter
+		check.TLSExpiry = &expiry
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		check.Failure, check.Message = AuthFailure, "the token was rejected ("+resp.Status+"), run litmusctl config set-account to log in again"
+	case resp.StatusCode != http.StatusOK:
+		check.Failure, check.Message = HTTPFailure, "unexpected response "+resp.Status
+	default:
+		check.Healthy = true
+	}
+
+	return ioutil.ReadAll(resp.Body)
+}
+
+// classifyError distinguishes DNS, TLS, timeout and connection failures
+func classifyError(err error) FailureType {
+	var (
+		dnsErr       *net.DNSError
+		authorityErr x509.UnknownAuthorityError
+		hostnameErr  x509.HostnameError
+		invalidErr   x509.CertificateInvalidError
+		netErr       net.Error
+	)
+	switch {
+	case errors.As(err, &dnsErr):
+		return DNSFailure
+	case errors.As(err, &authorityErr), errors.As(err, &hostnameErr), errors.As(err, &invalidErr),
+		strings.Contains(err.Error(), "x509:"), strings.Contains(err.Error(), "tls:"):
+		return TLSFailure
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return TimeoutFailure
+	default:
+		return ConnectionFailure
+	}
+}
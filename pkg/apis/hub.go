@@ -0,0 +1,402 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	types "github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+)
+
+type ChartListData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data ChartList `json:"data"`
+}
+
+type ChartList struct {
+	Charts []model.Chart `json:"listCharts"`
+}
+
+type ListChartsGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		HubName   string `json:"hubName"`
+		ProjectID string `json:"projectID"`
+	} `json:"variables"`
+}
+
+// ListCharts sends GraphQL API request for fetching the charts of a ChaosHub.
+// The response is cached for ResponseCacheTTL.
+func ListCharts(projectID string, hubName string, cred types.Credentials) (ChartListData, error) {
+	var data ChartListData
+	err := cachedResponse(cred, []string{"listCharts", projectID, hubName}, &data, func() (err error) {
+		data, err = listCharts(projectID, hubName, cred)
+		return err
+	})
+	return data, err
+}
+
+func listCharts(projectID string, hubName string, cred types.Credentials) (ChartListData, error) {
+
+	var gqlReq ListChartsGraphQLRequest
+	var err error
+
+	gqlReq.Query = `query listCharts($hubName: String!, $projectID: String!) {
+                      listCharts(hubName: $hubName, projectID: $projectID) {
+                        metadata {
+                          name
+                          annotations {
+                            categories
+                            chartDescription
+                          }
+                        }
+                        spec {
+                          displayName
+                          categoryDescription
+                          experiments
+                          platforms
+                        }
+                        packageInfo {
+                          packageName
+                          experiments {
+                            name
+                            desc
+                          }
+                        }
+                      }
+                    }`
+	gqlReq.Variables.HubName = hubName
+	gqlReq.Variables.ProjectID = projectID
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return ChartListData{}, err
+	}
+
+	resp, err := SendRequest(
+		SendRequestParams{
+			Endpoint: cred.Endpoint + utils.GQLAPIPath,
+			Token:    cred.Token,
+		},
+		query,
+		string(types.Post),
+	)
+	if err != nil {
+		return ChartListData{}, err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return ChartListData{}, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var chartList ChartListData
+		err = json.Unmarshal(bodyBytes, &chartList)
+		if err != nil {
+			return ChartListData{}, err
+		}
+
+		if len(chartList.Errors) > 0 {
+			return ChartListData{}, errors.New(chartList.Errors[0].Message)
+		}
+
+		return chartList, nil
+	} else {
+		return ChartListData{}, errors.New("Error while fetching the ChaosHub charts")
+	}
+}
+
+type ExperimentDetailsData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data ExperimentDetailsResponse `json:"data"`
+}
+
+type ExperimentDetailsResponse struct {
+	ExperimentDetails model.ExperimentDetails `json:"getExperimentDetails"`
+}
+
+type GetExperimentDetailsGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		Request model.ExperimentRequest `json:"request"`
+	} `json:"variables"`
+}
+
+// GetExperimentDetails sends GraphQL API request for fetching the ChaosExperiment and ChaosEngine manifests of a fault.
+func GetExperimentDetails(request model.ExperimentRequest, cred types.Credentials) (ExperimentDetailsData, error) {
+
+	var gqlReq GetExperimentDetailsGraphQLRequest
+	var err error
+
+	gqlReq.Query = `query getExperimentDetails($request: ExperimentRequest!) {
+                      getExperimentDetails(request: $request) {
+                        engineDetails
+                        experimentDetails
+                      }
+                    }`
+	gqlReq.Variables.Request = request
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return ExperimentDetailsData{}, err
+	}
+
+	resp, err := SendRequest(
+		SendRequestParams{
+			Endpoint: cred.Endpoint + utils.GQLAPIPath,
+			Token:    cred.Token,
+		},
+		query,
+		string(types.Post),
+	)
+	if err != nil {
+		return ExperimentDetailsData{}, err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return ExperimentDetailsData{}, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var experimentDetails ExperimentDetailsData
+		err = json.Unmarshal(bodyBytes, &experimentDetails)
+		if err != nil {
+			return ExperimentDetailsData{}, err
+		}
+
+		if len(experimentDetails.Errors) > 0 {
+			return ExperimentDetailsData{}, errors.New(experimentDetails.Errors[0].Message)
+		}
+
+		return experimentDetails, nil
+	} else {
+		return ExperimentDetailsData{}, errors.New("Error while fetching the Chaos Fault details")
+	}
+}
+
+type HubStatusListData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data HubStatusList `json:"data"`
+}
+
+type HubStatusList struct {
+	HubStatus []model.ChaosHubStatus `json:"listHubStatus"`
+}
+
+type ListHubStatusGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		ProjectID string `json:"projectID"`
+	} `json:"variables"`
+}
+
+// ListHubStatus sends GraphQL API request for fetching the ChaosHubs connected to a project.
+func ListHubStatus(projectID string, cred types.Credentials) (HubStatusListData, error) {
+
+	var gqlReq ListHubStatusGraphQLRequest
+	var err error
+
+	gqlReq.Query = `query listHubStatus($projectID: String!) {
+                      listHubStatus(projectID: $projectID) {
+                        id
+                        hubName
+                        repoURL
+                        repoBranch
+                        isAvailable
+                        totalExp
+                        hubType
+                        isPrivate
+                        authType
+                        lastSyncedAt
+                      }
+                    }`
+	gqlReq.Variables.ProjectID = projectID
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return HubStatusListData{}, err
+	}
+
+	resp, err := SendRequest(
+		SendRequestParams{
+			Endpoint: cred.Endpoint + utils.GQLAPIPath,
+			Token:    cred.Token,
+		},
+		query,
+		string(types.Post),
+	)
+	if err != nil {
+		return HubStatusListData{}, err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return HubStatusListData{}, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var hubStatusList HubStatusListData
+		err = json.Unmarshal(bodyBytes, &hubStatusList)
+		if err != nil {
+			return HubStatusListData{}, err
+		}
+
+		if len(hubStatusList.Errors) > 0 {
+			return HubStatusListData{}, errors.New(hubStatusList.Errors[0].Message)
+		}
+
+		return hubStatusList, nil
+	} else {
+		return HubStatusListData{}, errors.New("Error while fetching the ChaosHubs")
+	}
+}
+
+type ChaosHubData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data ChaosHubDetails `json:"data"`
+}
+
+type ChaosHubDetails struct {
+	AddChaosHub    *model.ChaosHub `json:"addChaosHub,omitempty"`
+	UpdateChaosHub *model.ChaosHub `json:"updateChaosHub,omitempty"`
+}
+
+type AddChaosHubGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		Request model.CreateChaosHubRequest `json:"request"`
+	} `json:"variables"`
+}
+
+// AddChaosHub sends GraphQL API request for connecting a ChaosHub to a project.
+// The credentials of private hubs are only sent as GraphQL variables.
+func AddChaosHub(request model.CreateChaosHubRequest, cred types.Credentials) (ChaosHubData, error) {
+
+	var gqlReq AddChaosHubGraphQLRequest
+	var err error
+
+	gqlReq.Query = `mutation addChaosHub($request: CreateChaosHubRequest!) {
+                      addChaosHub(request: $request) {
+                        id
+                        hubName
+                        repoURL
+                        repoBranch
+                        isPrivate
+                        authType
+                      }
+                    }`
+	gqlReq.Variables.Request = request
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return ChaosHubData{}, err
+	}
+
+	return sendChaosHubRequest(query, cred)
+}
+
+type UpdateChaosHubGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		Request model.UpdateChaosHubRequest `json:"request"`
+	} `json:"variables"`
+}
+
+// UpdateChaosHub sends GraphQL API request for updating the configuration and credentials of a ChaosHub.
+func UpdateChaosHub(request model.UpdateChaosHubRequest, cred types.Credentials) (ChaosHubData, error) {
+
+	var gqlReq UpdateChaosHubGraphQLRequest
+	var err error
+
+	gqlReq.Query = `mutation updateChaosHub($request: UpdateChaosHubRequest!) {
+                      updateChaosHub(request: $request) {
+                        id
+                        hubName
+                        repoURL
+                        repoBranch
+                        isPrivate
+                        authType
+                      }
+                    }`
+	gqlReq.Variables.Request = request
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return ChaosHubData{}, err
+	}
+
+	return sendChaosHubRequest(query, cred)
+}
+
+func sendChaosHubRequest(query []byte, cred types.Credentials) (ChaosHubData, error) {
+	defer invalidateResponseCache(cred)
+
+	resp, err := SendRequest(
+		SendRequestParams{
+			Endpoint: cred.Endpoint + utils.GQLAPIPath,
+			Token:    cred.Token,
+		},
+		query,
+		string(types.Post),
+	)
+	if err != nil {
+		return ChaosHubData{}, err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return ChaosHubData{}, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var chaosHub ChaosHubData
+		err = json.Unmarshal(bodyBytes, &chaosHub)
+		if err != nil {
+			return ChaosHubData{}, err
+		}
+
+		if len(chaosHub.Errors) > 0 {
+			return ChaosHubData{}, errors.New(chaosHub.Errors[0].Message)
+		}
+
+		return chaosHub, nil
+	} else {
+		return ChaosHubData{}, errors.New("Error while saving the ChaosHub")
+	}
+}
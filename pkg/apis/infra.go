@@ -0,0 +1,122 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	types "github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+)
+
+// Infra is a Chaos Infrastructure connected to an environment
+type Infra struct {
+	InfraID       string `json:"infraID"`
+	Name          string `json:"name"`
+	EnvironmentID string `json:"environmentID"`
+	IsActive      bool   `json:"isActive"`
+}
+
+type InfraListData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data InfraList `json:"data"`
+}
+
+type InfraList struct {
+	ListInfras struct {
+		TotalNoOfInfras int     `json:"totalNoOfInfras"`
+		Infras          []Infra `json:"infras"`
+	} `json:"listInfras"`
+}
+
+const infraListFields = `totalNoOfInfras
+                        infras {
+                          infraID
+                          name
+                          environmentID
+                          isActive
+                        }`
+
+type ListInfrasGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		ProjectID string `json:"projectID"`
+		Request   struct {
+			EnvironmentIDs []string `json:"environmentIDs,omitempty"`
+		} `json:"request"`
+	} `json:"variables"`
+}
+
+// ListInfras sends GraphQL API request for fetching the Chaos Infrastructures of a project.
+// If environment IDs are given, only the infrastructures of those environments are returned.
+func ListInfras(projectID string, environmentIDs []string, cred types.Credentials) (InfraListData, error) {
+
+	var gqlReq ListInfrasGraphQLRequest
+	var err error
+
+	gqlReq.Query = `query listInfras($projectID: ID!, $request: ListInfraRequest) {
+                      listInfras(projectID: $projectID, request: $request) {
+                        ` + infraListFields + `
+                      }
+                    }`
+	gqlReq.Variables.ProjectID = projectID
+	gqlReq.Variables.Request.EnvironmentIDs = environmentIDs
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return InfraListData{}, err
+	}
+
+	resp, err := SendRequest(
+		SendRequestParams{
+			Endpoint: cred.Endpoint + utils.GQLAPIPath,
+			Token:    cred.Token,
+		},
+		query,
+		string(types.Post),
+	)
+	if err != nil {
+		return InfraListData{}, err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return InfraListData{}, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var infraList InfraListData
+		err = json.Unmarshal(bodyBytes, &infraList)
+		if err != nil {
+			return InfraListData{}, err
+		}
+
+		if len(infraList.Errors) > 0 {
+			return InfraListData{}, errors.New(infraList.Errors[0].Message)
+		}
+
+		return infraList, nil
+	} else {
+		return InfraListData{}, errors.New("Error while fetching the Chaos Infrastructures")
+	}
+}
@@ -0,0 +1,328 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	types "github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+)
+
+const introspectionQuery = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types { ...FullType }
+    directives {
+      name
+      description
+      locations
+      args { ...InputValue }
+    }
+  }
+}
+
+fragment FullType on __Type {
+  kind
+  name
+  description
+  fields(includeDeprecated: true) {
+    name
+    description
+    args { ...InputValue }
+    type { ...TypeRef }
+    isDeprecated
+    deprecationReason
+  }
+  inputFields { ...InputValue }
+  interfaces { ...TypeRef }
+  enumValues(includeDeprecated: true) {
+    name
+    description
+    isDeprecated
+    deprecationReason
+  }
+  possibleTypes { ...TypeRef }
+}
+
+fragment InputValue on __InputValue {
+  name
+  description
+  type { ...TypeRef }
+  defaultValue
+}
+
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name ofType { kind name } } } } } } }
+}`
+
+type introspectionData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data struct {
+		Schema introspectionSchema `json:"__schema"`
+	} `json:"data"`
+}
+
+type introspectionSchema struct {
+	QueryType        *introspectionTypeRef `json:"queryType"`
+	MutationType     *introspectionTypeRef `json:"mutationType"`
+	SubscriptionType *introspectionTypeRef `json:"subscriptionType"`
+	Types            []introspectionType   `json:"types"`
+	Directives       []struct {
+		Name        string               `json:"name"`
+		Description string               `json:"description"`
+		Locations   []string             `json:"locations"`
+		Args        []introspectionInput `json:"args"`
+	} `json:"directives"`
+}
+
+type introspectionType struct {
+	Kind        string `json:"kind"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Fields      []struct {
+		Name              string               `json:"name"`
+		Description       string               `json:"description"`
+		Args              []introspectionInput `json:"args"`
+		Type              introspectionTypeRef `json:"type"`
+		IsDeprecated      bool                 `json:"isDeprecated"`
+		DeprecationReason *string              `json:"deprecationReason"`
+	} `json:"fields"`
+	InputFields []introspectionInput   `json:"inputFields"`
+	Interfaces  []introspectionTypeRef `json:"interfaces"`
+	EnumValues  []struct {
+		Name              string  `json:"name"`
+		Description       string  `json:"description"`
+		IsDeprecated      bool    `json:"isDeprecated"`
+		DeprecationReason *string `json:"deprecationReason"`
+	} `json:"enumValues"`
+	PossibleTypes []introspectionTypeRef `json:"possibleTypes"`
+}
+
+type introspectionInput struct {
+	Name         string               `json:"name"`
+	Description  string               `json:"description"`
+	Type         introspectionTypeRef `json:"type"`
+	DefaultValue *string              `json:"defaultValue"`
+}
+
+type introspectionTypeRef struct {
+	Kind   string                `json:"kind"`
+	Name   string                `json:"name"`
+	OfType *introspectionTypeRef `json:"ofType"`
+}
+
+// builtinScalars and builtinDirectives are part of every GraphQL schema, they're left out of the SDL
+var (
+	builtinScalars    = map[string]bool{"String": true, "Int": true, "Float": true, "Boolean": true, "ID": true}
+	builtinDirectives = map[string]bool{"skip": true, "include": true, "deprecated": true, "specifiedBy": true}
+)
+
+// GetSchemaSDL introspects the GraphQL schema of the ChaosCenter and returns it in the schema
+// definition language. The types are sorted by name, so that the SDL of two servers can be diffed.
+func GetSchemaSDL(cred types.Credentials) (string, error) {
+	query, err := json.Marshal(map[string]string{"query": introspectionQuery})
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := SendRequest(SendRequestParams{Endpoint: cred.Endpoint + utils.GQLAPIPath, Token: cred.Token}, query, string(types.Post))
+	if err != nil {
+		return "", err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return "", err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return "", errors.New("Error while introspecting the GraphQL schema: " + resp.Status)
+	}
+
+	var introspection introspectionData
+	if err := json.Unmarshal(bodyBytes, &introspection); err != nil {
+		return "", err
+	}
+	if len(introspection.Errors) > 0 {
+		return "", errors.New(introspection.Errors[0].Message)
+	}
+	if introspection.Data.Schema.QueryType == nil {
+		return "", errors.New("the introspection of the GraphQL schema is disabled on the ChaosCenter")
+	}
+
+	return introspection.Data.Schema.sdl(), nil
+}
+
+func (s introspectionSchema) sdl() string {
+	var blocks []string
+
+	// The schema definition is implied by the conventional root type names
+	if (s.QueryType != nil && s.QueryType.Name != "Query") ||
+		(s.MutationType != nil && s.MutationType.Name != "Mutation") ||
+		(s.SubscriptionType != nil && s.SubscriptionType.Name != "Subscription") {
+		block := "schema {\n"
+		for _, root := range []struct {
+			operation string
+			ref       *introspectionTypeRef
+		}{{"query", s.QueryType}, {"mutation", s.MutationType}, {"subscription", s.SubscriptionType}} {
+			if root.ref != nil {
+				block += "  " + root.operation + ": " + root.ref.Name + "\n"
+			}
+		}
+		blocks = append(blocks, block+"}")
+	}
+
+	directives := s.Directives
+	sort.Slice(directives, func(i, j int) bool { return directives[i].Name < directives[j].Name })
+	for _, directive := range directives {
+		if builtinDirectives[directive.Name] {
+			continue
+		}
+		blocks = append(blocks, sdlDescription(directive.Description, "")+
+			"directive @"+directive.Name+sdlArguments(directive.Args)+" on "+strings.Join(directive.Locations, " | "))
+	}
+
+	typeDefs := s.Types
+	sort.Slice(typeDefs, func(i, j int) bool { return typeDefs[i].Name < typeDefs[j].Name })
+	for _, t := range typeDefs {
+		if strings.HasPrefix(t.Name, "__") || builtinScalars[t.Name] {
+			continue
+		}
+		blocks = append(blocks, t.sdl())
+	}
+
+	return strings.Join(blocks, "\n\n") + "\n"
+}
+
+func (t introspectionType) sdl() string {
+	block := sdlDescription(t.Description, "")
+	switch t.Kind {
+	case "SCALAR":
+		return block + "scalar " + t.Name
+
+	case "UNION":
+		var members []string
+		for _, member := range t.PossibleTypes {
+			members = append(members, member.Name)
+		}
+		return block + "union " + t.Name + " = " + strings.Join(members, " | ")
+
+	case "ENUM":
+		block += "enum " + t.Name + " {\n"
+		for _, value := range t.EnumValues {
+			block += sdlDescription(value.Description, "  ") + "  " + value.Name + sdlDeprecation(value.IsDeprecated, value.DeprecationReason) + "\n"
+		}
+		return block + "}"
+
+	case "INPUT_OBJECT":
+		block += "input " + t.Name + " {\n"
+		for _, field := range t.InputFields {
+			block += sdlDescription(field.Description, "  ") + "  " + sdlInputValue(field) + "\n"
+		}
+		return block + "}"
+
+	default:
+		keyword := "type"
+		if t.Kind == "INTERFACE" {
+			keyword = "interface"
+		}
+		block += keyword + " " + t.Name
+		if len(t.Interfaces) > 0 {
+			var interfaces []string
+			for _, i := range t.Interfaces {
+				interfaces = append(interfaces, i.Name)
+			}
+			block += " implements " + strings.Join(interfaces, " & ")
+		}
+		block += " {\n"
+		for _, field := range t.Fields {
+			block += sdlDescription(field.Description, "  ") + "  " + field.Name + sdlArguments(field.Args) + ": " + field.Type.String() +
+				sdlDeprecation(field.IsDeprecated, field.DeprecationReason) + "\n"
+		}
+		return block + "}"
+	}
+}
+
+// String returns the type reference as written in the SDL, e.g. [String!]!
+func (r introspectionTypeRef) String() string {
+	switch {
+	case r.Kind == "NON_NULL" && r.OfType != nil:
+		return r.OfType.String() + "!"
+	case r.Kind == "LIST" && r.OfType != nil:
+		return "[" + r.OfType.String() + "]"
+	default:
+		return r.Name
+	}
+}
+
+func sdlInputValue(input introspectionInput) string {
+	value := input.Name + ": " + input.Type.String()
+	if input.DefaultValue != nil {
+		value += " = " + *input.DefaultValue
+	}
+	return value
+}
+
+func sdlArguments(args []introspectionInput) string {
+	if len(args) == 0 {
+		return ""
+	}
+	var values []string
+	for _, arg := range args {
+		value := sdlInputValue(arg)
+		if arg.Description != "" {
+			value = strconv.Quote(arg.Description) + " " + value
+		}
+		values = append(values, value)
+	}
+	return "(" + strings.Join(values, ", ") + ")"
+}
+
+func sdlDescription(description string, indent string) string {
+	if description == "" {
+		return ""
+	}
+	if !strings.Contains(description, "\n") && !strings.Contains(description, `"`) {
+		return indent + `"` + description + `"` + "\n"
+	}
+	description = strings.ReplaceAll(description, `"""`, `\"""`)
+	return indent + `"""` + "\n" + indent + strings.ReplaceAll(description, "\n", "\n"+indent) + "\n" + indent + `"""` + "\n"
+}
+
+func sdlDeprecation(isDeprecated bool, reason *string) string {
+	if !isDeprecated {
+		return ""
+	}
+	if reason == nil || *reason == "" || *reason == "No longer supported" {
+		return " @deprecated"
+	}
+	return " @deprecated(reason: " + strconv.Quote(*reason) + ")"
+}
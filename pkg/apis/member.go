@@ -0,0 +1,145 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"errors"
+
+	"github.com/litmuschaos/litmusctl/pkg/types"
+)
+
+// User is a user account of ChaosCenter
+type User struct {
+	ID       string `json:"_id"`
+	Username string `json:"username"`
+	Name     string `json:"name,omitempty"`
+	Email    string `json:"email,omitempty"`
+}
+
+type memberPayload struct {
+	ProjectID string  `json:"project_id"`
+	UserID    string  `json:"user_id"`
+	Role      *string `json:"role,omitempty"`
+}
+
+// ListInvitableUsers lists the users which can be invited to the project
+func ListInvitableUsers(projectID string, cred types.Credentials) ([]User, error) {
+	var users []User
+	err := sendAuthServerRequest("/invite_users/"+projectID, types.Get, nil, &users, cred)
+	return users, err
+}
+
+// SendInvitation invites the user to the project with the given role
+func SendInvitation(projectID string, userID string, role string, cred types.Credentials) (Member, error) {
+	var member Member
+	err := sendAuthServerRequest("/send_invitation", types.Post, memberPayload{
+		ProjectID: projectID,
+		UserID:    userID,
+		Role:      &role,
+	}, &member, cred)
+	return member, err
+}
+
+type projectMembersResponse struct {
+	Data []Member `json:"data"`
+}
+
+// GetProjectMembers lists the members of the project, including the ones with pending invitations
+func GetProjectMembers(projectID string, cred types.Credentials) ([]Member, error) {
+	var data projectMembersResponse
+	err := sendAuthServerRequest("/get_project_members/"+projectID+"/all", types.Get, nil, &data, cred)
+	return data.Data, err
+}
+
+// GetProjectMember returns the member of the project with the given username
+func GetProjectMember(projectID string, username string, cred types.Credentials) (Member, error) {
+	members, err := GetProjectMembers(projectID, cred)
+	if err != nil {
+		return Member{}, err
+	}
+
+	for _, member := range members {
+		if member.UserName == username {
+			return member, nil
+		}
+	}
+
+	return Member{}, errors.New("user " + username + " is not a member of the project")
+}
+
+// UpdateMemberRole changes the role of the member in the project
+func UpdateMemberRole(projectID string, userID string, role string, cred types.Credentials) (authServerResponse, error) {
+	var data authServerResponse
+	err := sendAuthServerRequest("/update_member_role", types.Post, memberPayload{
+		ProjectID: projectID,
+		UserID:    userID,
+		Role:      &role,
+	}, &data, cred)
+	return data, err
+}
+
+// RemoveMember removes the member from the project, or revokes the invitation if it's still pending
+func RemoveMember(projectID string, userID string, cred types.Credentials) (authServerResponse, error) {
+	var data authServerResponse
+	err := sendAuthServerRequest("/remove_invitation", types.Post, memberPayload{
+		ProjectID: projectID,
+		UserID:    userID,
+	}, &data, cred)
+	return data, err
+}
+
+// Invitation is a pending invitation of the user to a project
+type Invitation struct {
+	ProjectID      string `json:"ProjectID"`
+	ProjectName    string `json:"ProjectName"`
+	ProjectOwner   Member `json:"ProjectOwner"`
+	InvitationRole string `json:"InvitationRole"`
+}
+
+type listInvitationsResponse struct {
+	Data []Invitation `json:"data"`
+}
+
+// ListInvitations lists the pending invitations of the user
+func ListInvitations(cred types.Credentials) ([]Invitation, error) {
+	var data listInvitationsResponse
+	err := sendAuthServerRequest("/list_invitations_with_filters/pending", types.Get, nil, &data, cred)
+	return data.Data, err
+}
+
+// AcceptInvitation accepts the invitation of the user to the project
+func AcceptInvitation(projectID string, userID string, cred types.Credentials) (authServerResponse, error) {
+	defer invalidateResponseCache(cred)
+
+	var data authServerResponse
+	err := sendAuthServerRequest("/accept_invitation", types.Post, memberPayload{
+		ProjectID: projectID,
+		UserID:    userID,
+	}, &data, cred)
+	return data, err
+}
+
+// DeclineInvitation declines the invitation of the user to the project
+func DeclineInvitation(projectID string, userID string, cred types.Credentials) (authServerResponse, error) {
+	defer invalidateResponseCache(cred)
+
+	var data authServerResponse
+	err := sendAuthServerRequest("/decline_invitation", types.Post, memberPayload{
+		ProjectID: projectID,
+		UserID:    userID,
+	}, &data, cred)
+	return data, err
+}
@@ -0,0 +1,82 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	types "github.com/litmuschaos/litmusctl/pkg/types"
+)
+
+// PageSize is the number of rows fetched per request when all the rows of a list are fetched,
+// large projects otherwise return thousands of rows in a single response
+const PageSize = 100
+
+// GetAllWorkflows fetches all the workflows matching the request and the tags, a page at a time
+func GetAllWorkflows(in model.ListWorkflowsRequest, tags []string, cred types.Credentials) (WorkflowListData, error) {
+	var workflowList WorkflowListData
+	for page := 0; ; page++ {
+		in.Pagination = &model.Pagination{Page: page, Limit: PageSize}
+		workflows, err := GetWorkflowListByTags(in, tags, cred)
+		if err != nil {
+			return WorkflowListData{}, err
+		}
+
+		details := &workflowList.Data.ListWorkflowDetails
+		details.TotalNoOfWorkflows = workflows.Data.ListWorkflowDetails.TotalNoOfWorkflows
+		details.Workflows = append(details.Workflows, workflows.Data.ListWorkflowDetails.Workflows...)
+		if len(workflows.Data.ListWorkflowDetails.Workflows) < PageSize || len(details.Workflows) >= details.TotalNoOfWorkflows {
+			return workflowList, nil
+		}
+	}
+}
+
+// GetAllWorkflowRuns fetches all the workflow runs matching the request, a page at a time
+func GetAllWorkflowRuns(in model.ListWorkflowRunsRequest, cred types.Credentials) (WorkflowRunsListData, error) {
+	var workflowRunsList WorkflowRunsListData
+	for page := 0; ; page++ {
+		in.Pagination = &model.Pagination{Page: page, Limit: PageSize}
+		workflowRuns, err := GetWorkflowRunsList(in, cred)
+		if err != nil {
+			return WorkflowRunsListData{}, err
+		}
+
+		details := &workflowRunsList.Data.ListWorkflowRunsDetails
+		details.TotalNoOfWorkflowRuns = workflowRuns.Data.ListWorkflowRunsDetails.TotalNoOfWorkflowRuns
+		details.WorkflowRuns = append(details.WorkflowRuns, workflowRuns.Data.ListWorkflowRunsDetails.WorkflowRuns...)
+		if len(workflowRuns.Data.ListWorkflowRunsDetails.WorkflowRuns) < PageSize || len(details.WorkflowRuns) >= details.TotalNoOfWorkflowRuns {
+			return workflowRunsList, nil
+		}
+	}
+}
+
+// ListAllEnvironments fetches all the environments matching the request, a page at a time
+func ListAllEnvironments(projectID string, request ListEnvironmentsRequest, cred types.Credentials) (EnvironmentListData, error) {
+	var environmentList EnvironmentListData
+	for page := 0; ; page++ {
+		request.Pagination = &model.Pagination{Page: page, Limit: PageSize}
+		environments, err := ListEnvironments(projectID, request, cred)
+		if err != nil {
+			return EnvironmentListData{}, err
+		}
+
+		list := &environmentList.Data.ListEnvironments
+		list.TotalNoOfEnvironments = environments.Data.ListEnvironments.TotalNoOfEnvironments
+		list.Environments = append(list.Environments, environments.Data.ListEnvironments.Environments...)
+		if len(environments.Data.ListEnvironments.Environments) < PageSize || len(list.Environments) >= list.TotalNoOfEnvironments {
+			return environmentList, nil
+		}
+	}
+}
@@ -0,0 +1,524 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	types "github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+)
+
+type Probe struct {
+	Name                     string                          `json:"name"`
+	Description              *string                         `json:"description"`
+	Tags                     []string                        `json:"tags"`
+	Type                     types.ProbeType                 `json:"type"`
+	InfrastructureType       string                          `json:"infrastructureType"`
+	KubernetesHTTPProperties *types.KubernetesHTTPProperties `json:"kubernetesHTTPProperties,omitempty"`
+	KubernetesCMDProperties  *types.KubernetesCMDProperties  `json:"kubernetesCMDProperties,omitempty"`
+	PromProperties           *types.PromProperties           `json:"promProperties,omitempty"`
+	K8sProperties            *types.K8sProperties            `json:"k8sProperties,omitempty"`
+	CreatedAt                string                          `json:"createdAt"`
+	UpdatedAt                string                          `json:"updatedAt"`
+	ReferencedBy             *int                            `json:"referencedBy,omitempty"`
+	RecentExecutions         []ProbeRecentExecution          `json:"recentExecutions,omitempty"`
+}
+
+type ProbeRecentExecution struct {
+	FaultName            string               `json:"faultName"`
+	Status               ProbeStatus          `json:"status"`
+	ExecutedByExperiment ExecutedByExperiment `json:"executedByExperiment"`
+}
+
+type ProbeStatus struct {
+	Verdict     string  `json:"verdict"`
+	Description *string `json:"description,omitempty"`
+}
+
+type ExecutedByExperiment struct {
+	ExperimentID   string `json:"experimentID"`
+	ExperimentName string `json:"experimentName"`
+	UpdatedAt      string `json:"updatedAt"`
+}
+
+// PassRate returns the percentage of the recent executions of the probe which passed
+// and the number of executions it is based on
+func (p Probe) PassRate() (float64, int) {
+	if len(p.RecentExecutions) == 0 {
+		return 0, 0
+	}
+
+	var passed int
+	for _, execution := range p.RecentExecutions {
+		if strings.EqualFold(execution.Status.Verdict, "Passed") {
+			passed++
+		}
+	}
+
+	return float64(passed) * 100 / float64(len(p.RecentExecutions)), len(p.RecentExecutions)
+}
+
+type ProbeData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data AddProbeDetails `json:"data"`
+}
+
+type AddProbeDetails struct {
+	AddProbe Probe `json:"addProbe"`
+}
+
+type AddProbeGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		ProjectID string             `json:"projectID"`
+		Request   types.ProbeRequest `json:"request"`
+	} `json:"variables"`
+}
+
+// CreateProbe sends GraphQL API request for creating a resilience probe.
+func CreateProbe(projectID string, request types.ProbeRequest, cred types.Credentials) (ProbeData, error) {
+
+	var gqlReq AddProbeGraphQLRequest
+	var err error
+
+	gqlReq.Query = `mutation addProbe($request: ProbeRequest!, $projectID: ID!) {
+                      addProbe(request: $request, projectID: $projectID) {
+                        name
+                        type
+                        infrastructureType
+                        createdAt
+                      }
+                    }`
+	gqlReq.Variables.ProjectID = projectID
+	gqlReq.Variables.Request = request
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return ProbeData{}, err
+	}
+
+	resp, err := SendRequest(
+		SendRequestParams{
+			Endpoint: cred.Endpoint + utils.GQLAPIPath,
+			Token:    cred.Token,
+		},
+		query,
+		string(types.Post),
+	)
+	if err != nil {
+		return ProbeData{}, err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return ProbeData{}, errors.New("Error in creating Resilience Probe: " + err.Error())
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var createdProbe ProbeData
+		err = json.Unmarshal(bodyBytes, &createdProbe)
+		if err != nil {
+			return ProbeData{}, errors.New("Error in creating Resilience Probe: " + err.Error())
+		}
+
+		if len(createdProbe.Errors) > 0 {
+			return ProbeData{}, errors.New(createdProbe.Errors[0].Message)
+		}
+
+		return createdProbe, nil
+	} else {
+		return ProbeData{}, errors.New("Error while creating the Resilience Probe")
+	}
+}
+
+// probeFields are the fields fetched while listing or describing probes
+const probeFields = `name
+                        description
+                        tags
+                        type
+                        infrastructureType
+                        createdAt
+                        updatedAt
+                        referencedBy
+                        kubernetesHTTPProperties {
+                          probeTimeout interval retry attempt probePollingInterval initialDelay evaluationTimeout stopOnFailure
+                          url
+                          method {
+                            get { criteria responseCode }
+                            post { contentType body bodyPath criteria responseCode }
+                          }
+                          insecureSkipVerify
+                        }
+                        kubernetesCMDProperties {
+                          probeTimeout interval retry attempt probePollingInterval initialDelay evaluationTimeout stopOnFailure
+                          command
+                          comparator { type value criteria }
+                          source
+                        }
+                        promProperties {
+                          probeTimeout interval retry attempt probePollingInterval initialDelay evaluationTimeout stopOnFailure
+                          endpoint
+                          query
+                          queryPath
+                          comparator { type value criteria }
+                        }
+                        k8sProperties {
+                          probeTimeout interval retry attempt probePollingInterval initialDelay evaluationTimeout stopOnFailure
+                          group version resource namespace resourceNames fieldSelector labelSelector operation
+                        }
+                        recentExecutions {
+                          faultName
+                          status { verdict description }
+                          executedByExperiment { experimentID experimentName updatedAt }
+                        }`
+
+type ProbeListData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data ProbeList `json:"data"`
+}
+
+type ProbeList struct {
+	Probes []Probe `json:"listProbes"`
+}
+
+type ListProbesGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		ProjectID  string   `json:"projectID"`
+		ProbeNames []string `json:"probeNames,omitempty"`
+	} `json:"variables"`
+}
+
+// ListProbes sends GraphQL API request for fetching the resilience probes of a project.
+// If probe names are given, only those probes are returned.
+func ListProbes(projectID string, probeNames []string, cred types.Credentials) (ProbeListData, error) {
+
+	var gqlReq ListProbesGraphQLRequest
+	var err error
+
+	gqlReq.Query = `query listProbes($projectID: ID!, $probeNames: [ID!]) {
+                      listProbes(projectID: $projectID, probeNames: $probeNames) {
+                        ` + probeFields + `
+                      }
+                    }`
+	gqlReq.Variables.ProjectID = projectID
+	gqlReq.Variables.ProbeNames = probeNames
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return ProbeListData{}, err
+	}
+
+	resp, err := SendRequest(
+		SendRequestParams{
+			Endpoint: cred.Endpoint + utils.GQLAPIPath,
+			Token:    cred.Token,
+		},
+		query,
+		string(types.Post),
+	)
+	if err != nil {
+		return ProbeListData{}, err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return ProbeListData{}, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var probeList ProbeListData
+		err = json.Unmarshal(bodyBytes, &probeList)
+		if err != nil {
+			return ProbeListData{}, err
+		}
+
+		if len(probeList.Errors) > 0 {
+			return ProbeListData{}, errors.New(probeList.Errors[0].Message)
+		}
+
+		return probeList, nil
+	} else {
+		return ProbeListData{}, errors.New("Error while fetching the Resilience Probes")
+	}
+}
+
+type ProbeReferenceData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data ProbeReferenceDetails `json:"data"`
+}
+
+type ProbeReferenceDetails struct {
+	ProbeReference ProbeReference `json:"getProbeReference"`
+}
+
+type ProbeReference struct {
+	Name             string                    `json:"name"`
+	TotalRuns        int                       `json:"totalRuns"`
+	RecentExecutions []ProbeReferenceExecution `json:"recentExecutions"`
+}
+
+type ProbeReferenceExecution struct {
+	FaultName        string                 `json:"faultName"`
+	Mode             string                 `json:"mode"`
+	ExecutionHistory []ProbeRecentExecution `json:"executionHistory"`
+}
+
+const probeReferenceFields = `name
+                        totalRuns
+                        recentExecutions {
+                          faultName
+                          mode
+                          executionHistory {
+                            faultName
+                            status { verdict description }
+                            executedByExperiment { experimentID experimentName updatedAt }
+                          }
+                        }`
+
+type GetProbeReferenceGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		ProjectID string `json:"projectID"`
+		ProbeName string `json:"probeName"`
+	} `json:"variables"`
+}
+
+// GetProbeReference sends GraphQL API request for fetching the Chaos Scenarios which reference a resilience probe.
+func GetProbeReference(projectID string, probeName string, cred types.Credentials) (ProbeReferenceData, error) {
+
+	var gqlReq GetProbeReferenceGraphQLRequest
+	var err error
+
+	gqlReq.Query = `query getProbeReference($projectID: ID!, $probeName: ID!) {
+                      getProbeReference(projectID: $projectID, probeName: $probeName) {
+                        ` + probeReferenceFields + `
+                      }
+                    }`
+	gqlReq.Variables.ProjectID = projectID
+	gqlReq.Variables.ProbeName = probeName
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return ProbeReferenceData{}, err
+	}
+
+	resp, err := SendRequest(
+		SendRequestParams{
+			Endpoint: cred.Endpoint + utils.GQLAPIPath,
+			Token:    cred.Token,
+		},
+		query,
+		string(types.Post),
+	)
+	if err != nil {
+		return ProbeReferenceData{}, err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return ProbeReferenceData{}, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var probeReference ProbeReferenceData
+		err = json.Unmarshal(bodyBytes, &probeReference)
+		if err != nil {
+			return ProbeReferenceData{}, err
+		}
+
+		if len(probeReference.Errors) > 0 {
+			return ProbeReferenceData{}, errors.New(probeReference.Errors[0].Message)
+		}
+
+		return probeReference, nil
+	} else {
+		return ProbeReferenceData{}, errors.New("Error while fetching the Resilience Probe references")
+	}
+}
+
+type ProbeDescriptionData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data struct {
+		ProbeList
+		ProbeReferenceDetails
+	} `json:"data"`
+}
+
+// DescribeProbe fetches a resilience probe along with the Chaos Scenarios which reference it, batched in a
+// single GraphQL request. No error is returned for a missing probe, its list of probes is empty instead.
+func DescribeProbe(projectID string, probeName string, cred types.Credentials) (ProbeDescriptionData, error) {
+
+	var gqlReq GetProbeReferenceGraphQLRequest
+	var err error
+
+	gqlReq.Query = `query describeProbe($projectID: ID!, $probeName: ID!) {
+                      listProbes(projectID: $projectID, probeNames: [$probeName]) {
+                        ` + probeFields + `
+                      }
+                      getProbeReference(projectID: $projectID, probeName: $probeName) {
+                        ` + probeReferenceFields + `
+                      }
+                    }`
+	gqlReq.Variables.ProjectID = projectID
+	gqlReq.Variables.ProbeName = probeName
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return ProbeDescriptionData{}, err
+	}
+
+	resp, err := SendRequest(
+		SendRequestParams{
+			Endpoint: cred.Endpoint + utils.GQLAPIPath,
+			Token:    cred.Token,
+		},
+		query,
+		string(types.Post),
+	)
+	if err != nil {
+		return ProbeDescriptionData{}, err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return ProbeDescriptionData{}, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var description ProbeDescriptionData
+		err = json.Unmarshal(bodyBytes, &description)
+		if err != nil {
+			return ProbeDescriptionData{}, err
+		}
+
+		for _, gqlErr := range description.Errors {
+			// The reference of a missing probe fails, while the probe is simply not listed
+			if len(description.Data.Probes) == 0 && len(gqlErr.Path) > 0 && gqlErr.Path[0] == "getProbeReference" {
+				continue
+			}
+			return ProbeDescriptionData{}, errors.New(gqlErr.Message)
+		}
+
+		return description, nil
+	} else {
+		return ProbeDescriptionData{}, errors.New("Error while fetching the Resilience Probe")
+	}
+}
+
+// ReferencingScenarios returns the unique Chaos Scenarios which executed the probe, mapped from ID to name
+func (r ProbeReference) ReferencingScenarios() map[string]string {
+	scenarios := make(map[string]string)
+	for _, execution := range r.RecentExecutions {
+		for _, history := range execution.ExecutionHistory {
+			scenarios[history.ExecutedByExperiment.ExperimentID] = history.ExecutedByExperiment.ExperimentName
+		}
+	}
+	return scenarios
+}
+
+type DeleteProbeData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data DeleteProbeDetails `json:"data"`
+}
+
+type DeleteProbeDetails struct {
+	IsDeleted bool `json:"deleteProbe"`
+}
+
+type DeleteProbeGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		ProjectID string `json:"projectID"`
+		ProbeName string `json:"probeName"`
+	} `json:"variables"`
+}
+
+// DeleteProbe sends GraphQL API request for deleting a resilience probe.
+func DeleteProbe(projectID string, probeName string, cred types.Credentials) (DeleteProbeData, error) {
+
+	var gqlReq DeleteProbeGraphQLRequest
+	var err error
+
+	gqlReq.Query = `mutation deleteProbe($probeName: ID!, $projectID: ID!) {
+                      deleteProbe(probeName: $probeName, projectID: $projectID)
+                    }`
+	gqlReq.Variables.ProjectID = projectID
+	gqlReq.Variables.ProbeName = probeName
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return DeleteProbeData{}, err
+	}
+
+	resp, err := SendRequest(
+		SendRequestParams{
+			Endpoint: cred.Endpoint + utils.GQLAPIPath,
+			Token:    cred.Token,
+		},
+		query,
+		string(types.Post),
+	)
+	if err != nil {
+		return DeleteProbeData{}, err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return DeleteProbeData{}, err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		var deletedProbe DeleteProbeData
+		err = json.Unmarshal(bodyBytes, &deletedProbe)
+		if err != nil {
+			return DeleteProbeData{}, err
+		}
+
+		if len(deletedProbe.Errors) > 0 {
+			return DeleteProbeData{}, errors.New(deletedProbe.Errors[0].Message)
+		}
+
+		return deletedProbe, nil
+	} else {
+		return DeleteProbeData{}, errors.New("Error while deleting the Resilience Probe")
+	}
+}
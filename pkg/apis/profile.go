@@ -0,0 +1,53 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// profileOperation returns the category and the name of the request for --profile, the GraphQL
+// requests are named after their operation, or their first field for the anonymous ones
+func profileOperation(req *http.Request) (string, string) {
+	if !utils.Profile {
+		return "", ""
+	}
+	if !isGraphQLRequest(req) {
+		return "rest", req.Method + " " + req.URL.Path
+	}
+
+	operation := graphQLOperation(req)
+	switch {
+	case operation == nil:
+		return "graphql", "unknown operation"
+	case operation.Name != "":
+		return "graphql", operation.Name
+	}
+	for _, selection := range operation.SelectionSet {
+		if field, ok := selection.(*ast.Field); ok {
+			return "graphql", field.Name
+		}
+	}
+	return "graphql", "unknown operation"
+}
+
+func isGraphQLRequest(req *http.Request) bool {
+	return strings.HasSuffix(req.URL.Path, utils.GQLAPIPath)
+}
@@ -44,6 +44,8 @@ type createProjectPayload struct {
 }
 
 func CreateProjectRequest(projectName string, cred types.Credentials) (createProjectResponse, error) {
+	defer invalidateResponseCache(cred)
+
 	payloadBytes, err := json.Marshal(createProjectPayload{
 		ProjectName: projectName,
 	})
@@ -82,18 +84,24 @@ func CreateProjectRequest(projectName string, cred types.Credentials) (createPro
 }
 
 type listProjectResponse struct {
-	Data []struct {
-		ID        string `json:"ID"`
-		Name      string `json:"Name"`
-		CreatedAt string `json:"CreatedAt"`
-	} `json:"data"`
+	Data   []Project `json:"data"`
 	Errors []struct {
 		Message string   `json:"message"`
 		Path    []string `json:"path"`
 	} `json:"errors"`
 }
 
+// ListProject lists the projects of the user, the response is cached for ResponseCacheTTL
 func ListProject(cred types.Credentials) (listProjectResponse, error) {
+	var data listProjectResponse
+	err := cachedResponse(cred, []string{"list_projects"}, &data, func() (err error) {
+		data, err = listProject(cred)
+		return err
+	})
+	return data, err
+}
+
+func listProject(cred types.Credentials) (listProjectResponse, error) {
 
 	resp, err := SendRequest(SendRequestParams{Endpoint: cred.Endpoint + utils.AuthAPIPath + "/list_projects", Token: "Bearer " + cred.Token}, []byte{}, string(types.Get))
 	if err != nil {
@@ -138,9 +146,13 @@ type Data struct {
 }
 
 type Member struct {
-	Role     string `json:"Role"`
-	UserID   string `json:"UserID"`
-	UserName string `json:"UserName"`
+	Role       string `json:"Role"`
+	UserID     string `json:"UserID"`
+	UserName   string `json:"UserName"`
+	Name       string `json:"Name,omitempty"`
+	Email      string `json:"Email,omitempty"`
+	Invitation string `json:"Invitation,omitempty"`
+	JoinedAt   string `json:"JoinedAt,omitempty"`
 }
 
 type Project struct {
@@ -183,3 +195,74 @@ func GetProjectDetails(c types.Credentials) (ProjectDetails, error) {
 		return ProjectDetails{}, errors.New("Unmatched status code:" + string(bodyBytes))
 	}
 }
+
+// authServerResponse is the generic response of the auth server REST endpoints
+type authServerResponse struct {
+	Message          string `json:"message"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// sendAuthServerRequest sends the payload to the given auth server endpoint and
+// decodes the response into out, surfacing the error returned by the auth server
+func sendAuthServerRequest(path string, method types.Method, payload interface{}, out interface{}, cred types.Credentials) error {
+	var payloadBytes = []byte{}
+	if payload != nil {
+		var err error
+		payloadBytes, err = json.Marshal(payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	resp, err := SendRequest(SendRequestParams{Endpoint: cred.Endpoint + utils.AuthAPIPath + path, Token: "Bearer " + cred.Token}, payloadBytes, string(method))
+	if err != nil {
+		return err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return json.Unmarshal(bodyBytes, out)
+	}
+
+	var authErr authServerResponse
+	if err := json.Unmarshal(bodyBytes, &authErr); err == nil && authErr.Error != "" {
+		if authErr.ErrorDescription != "" {
+			return errors.New(authErr.ErrorDescription)
+		}
+		return errors.New(authErr.Error)
+	}
+	return errors.New("Unmatched status code:" + string(bodyBytes))
+}
+
+// DeleteProject deletes the project with the given ID, only the owner of the project is allowed to do so
+func DeleteProject(projectID string, cred types.Credentials) (authServerResponse, error) {
+	defer invalidateResponseCache(cred)
+
+	var data authServerResponse
+	err := sendAuthServerRequest("/delete_project/"+projectID, types.Post, nil, &data, cred)
+	return data, err
+}
+
+type updateProjectNamePayload struct {
+	ProjectID   string `json:"project_id"`
+	ProjectName string `json:"project_name"`
+}
+
+// UpdateProjectName renames the project with the given ID
+func UpdateProjectName(projectID string, projectName string, cred types.Credentials) (authServerResponse, error) {
+	defer invalidateResponseCache(cred)
+
+	var data authServerResponse
+	err := sendAuthServerRequest("/update_project_name", types.Post, updateProjectNamePayload{
+		ProjectID:   projectID,
+		ProjectName: projectName,
+	}, &data, cred)
+	return data, err
+}
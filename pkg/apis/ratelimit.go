@@ -0,0 +1,167 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+)
+
+const (
+	// MaxRateLimitRetries is the number of times a request rate limited by the ChaosCenter is retried
+	MaxRateLimitRetries = 5
+
+	// maxRetryAfter caps the time waited before retrying a rate limited request
+	maxRetryAfter = time.Minute
+)
+
+// RequestsPerSecond throttles the requests sent to the ChaosCenter, set by the --rate-limit flag.
+// Zero leaves the requests unthrottled until the ChaosCenter rate limits them.
+var RequestsPerSecond float64
+
+// throttle spaces out the requests sent to the ChaosCenter. The interval starts from --rate-limit
+// and is doubled every time a request is rate limited, so that the remaining requests of bulk
+// operations go through instead of failing mid-way.
+var throttle struct {
+	sync.Mutex
+	interval time.Duration
+	next     time.Time
+}
+
+// doRequest sends the request, retrying it while the ChaosCenter rate limits it. The time to wait
+// is read from the Retry-After header, with an exponential backoff when the header is missing.
+// Requests failing with transient network or gateway errors are retried too, as long as retrying
+// them can't apply a mutation twice, see shouldRetry.
+func doRequest(req *http.Request) (*http.Response, error) {
+	defer utils.ProfileSpan(profileOperation(req))()
+
+	idempotent := isIdempotent(req)
+	if !idempotent && req.Header.Get(IdempotencyKeyHeader) == "" {
+		req.Header.Set(IdempotencyKeyHeader, utils.NewUUID())
+	}
+
+	rateLimited, transient := 0, 0
+	for {
+		waitForThrottle()
+		utils.SetRequestHeaders(req.Header, req.URL.String())
+
+		resp, err := utils.HTTPClient.Do(req)
+
+		var wait time.Duration
+		switch {
+		case err == nil && isRateLimited(resp):
+			resp.Body.Close()
+			if rateLimited == MaxRateLimitRetries {
+				return nil, errors.New("the ChaosCenter is rate limiting the requests, try again later or lower --rate-limit")
+			}
+			wait = retryAfter(resp, rateLimited)
+			rateLimited++
+			slowDown()
+			if !utils.Quiet {
+				utils.Red.Fprintln(os.Stderr, fmt.Sprintf("⏳ Rate limited by the ChaosCenter, retrying in %s", wait))
+			}
+
+		case transient < MaxTransientRetries && shouldRetry(req, idempotent, resp, err):
+			if resp != nil {
+				resp.Body.Close()
+			}
+			wait = transientBackoff(transient)
+			transient++
+			if !utils.Quiet {
+				utils.Red.Fprintln(os.Stderr, fmt.Sprintf("⏳ The request to the ChaosCenter failed, retrying in %s", wait))
+			}
+
+		case err == nil && transient > 0 && !idempotent:
+			return checkRetriedMutation(resp)
+
+		default:
+			return resp, err
+		}
+
+		if req.Body != nil {
+			if req.GetBody == nil {
+				return nil, errors.New("the request failed and can't be retried")
+			}
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+		time.Sleep(wait)
+	}
+}
+
+// isRateLimited checks whether the response rejects the request because of a rate limit
+func isRateLimited(resp *http.Response) bool {
+	return resp.StatusCode == http.StatusTooManyRequests ||
+		(resp.StatusCode == http.StatusServiceUnavailable && resp.Header.Get("Retry-After") != "")
+}
+
+// retryAfter returns the time to wait before retrying a rate limited request, the Retry-After
+// header holds either a number of seconds or a date
+func retryAfter(resp *http.Response, attempt int) time.Duration {
+	wait := time.Second << uint(attempt)
+	if header := resp.Header.Get("Retry-After"); header != "" {
+		if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+			wait = time.Duration(seconds) * time.Second
+		} else if date, err := http.ParseTime(header); err == nil {
+			wait = time.Until(date)
+		}
+	}
+	if wait < 0 {
+		wait = 0
+	}
+	if wait > maxRetryAfter {
+		wait = maxRetryAfter
+	}
+	return wait
+}
+
+// waitForThrottle waits until the next request can be sent
+func waitForThrottle() {
+	throttle.Lock()
+	if throttle.interval == 0 && RequestsPerSecond > 0 {
+		throttle.interval = time.Duration(float64(time.Second) / RequestsPerSecond)
+	}
+	now := time.Now()
+	wait := throttle.next.Sub(now)
+	if wait < 0 {
+		wait = 0
+	}
+	throttle.next = now.Add(wait + throttle.interval)
+	throttle.Unlock()
+
+	time.Sleep(wait)
+}
+
+// slowDown doubles the interval between the requests after a request was rate limited
+func slowDown() {
+	throttle.Lock()
+	defer throttle.Unlock()
+
+	switch {
+	case throttle.interval == 0:
+		throttle.interval = 200 * time.Millisecond
+	case throttle.interval < 10*time.Second:
+		throttle.interval *= 2
+	}
+}
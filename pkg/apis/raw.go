@@ -0,0 +1,66 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"encoding/json"
+	"io/ioutil"
+
+	types "github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+)
+
+// RawGraphQLRequest is an arbitrary GraphQL operation
+type RawGraphQLRequest struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// RawGraphQLResponse is the raw response of an arbitrary GraphQL operation
+type RawGraphQLResponse struct {
+	StatusCode int
+	Body       []byte
+}
+
+// HasErrors returns whether the response carries GraphQL errors
+func (r RawGraphQLResponse) HasErrors() bool {
+	var response struct {
+		Errors []json.RawMessage `json:"errors"`
+	}
+	return json.Unmarshal(r.Body, &response) == nil && len(response.Errors) > 0
+}
+
+// SendRawGraphQLRequest sends the GraphQL operation as is with the credentials, and returns the raw response
+func SendRawGraphQLRequest(request RawGraphQLRequest, cred types.Credentials) (RawGraphQLResponse, error) {
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return RawGraphQLResponse{}, err
+	}
+
+	resp, err := SendRequest(SendRequestParams{Endpoint: cred.Endpoint + utils.GQLAPIPath, Token: cred.Token}, payload, string(types.Post))
+	if err != nil {
+		return RawGraphQLResponse{}, err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return RawGraphQLResponse{}, err
+	}
+
+	return RawGraphQLResponse{StatusCode: resp.StatusCode, Body: bodyBytes}, nil
+}
@@ -18,6 +18,9 @@ package apis
 import (
 	"bytes"
 	"net/http"
+	"strings"
+
+	"github.com/litmuschaos/litmusctl/pkg/utils"
 )
 
 type SendRequestParams struct {
@@ -33,10 +36,14 @@ func SendRequest(params SendRequestParams, payload []byte, method string) (*http
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", params.Token)
 
-	resp, err := http.DefaultClient.Do(req)
+	resp, err := doRequest(req)
 	if err != nil {
 		return &http.Response{}, err
 	}
+	if strings.HasSuffix(params.Endpoint, utils.GQLAPIPath) && graphQLBlocked(resp) {
+		resp.Body.Close()
+		return &http.Response{}, ErrGraphQLBlocked
+	}
 
 	return resp, nil
 }
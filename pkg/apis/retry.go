@@ -0,0 +1,175 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/parser"
+)
+
+const (
+	// MaxTransientRetries is the number of times a request failing with a transient network or gateway
+	// error is retried
+	MaxTransientRetries = 3
+
+	// IdempotencyKeyHeader carries a token identifying a mutation across its retries, so that the ChaosCenter
+	// can tell a retry from a new request. It's not named Idempotency-Key, as net/http silently replays the
+	// requests carrying that header when their connection is lost.
+	IdempotencyKeyHeader = "Idempotency-Token"
+)
+
+// idempotentRESTPaths are the auth server routes which are safe to retry whatever their method
+var idempotentRESTPaths = map[string]bool{
+	utils.AuthAPIPath + "/login": true,
+}
+
+// duplicateError matches the errors of a mutation which was already applied
+var duplicateError = regexp.MustCompile(`(?i)already exists|duplicate`)
+
+// ErrMutationAlreadyApplied is returned when the retry of a mutation is rejected as a duplicate, which means
+// that the first attempt was applied although its response was lost
+var ErrMutationAlreadyApplied = errors.New("the request was retried after a transient failure and the ChaosCenter rejected the retry as a duplicate, the first attempt was most likely applied")
+
+// graphQLOperation parses the operation sent by the GraphQL request, it's nil when it can't be parsed
+func graphQLOperation(req *http.Request) *ast.OperationDefinition {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+
+	var payload struct {
+		Query         string `json:"query"`
+		OperationName string `json:"operationName"`
+	}
+	data, err := ioutil.ReadAll(body)
+	if err != nil || json.Unmarshal(data, &payload) != nil {
+		return nil
+	}
+	document, parseErr := parser.ParseQuery(&ast.Source{Input: payload.Query})
+	if parseErr != nil || len(document.Operations) == 0 {
+		return nil
+	}
+	if payload.OperationName != "" {
+		return document.Operations.ForName(payload.OperationName)
+	}
+	return document.Operations[0]
+}
+
+// isIdempotent returns whether the request only reads data, and can be retried safely. The GraphQL
+// requests are classified by their operation, the ones which can't be parsed are assumed to mutate.
+func isIdempotent(req *http.Request) bool {
+	switch {
+	case req.Method == http.MethodGet || req.Method == http.MethodHead:
+		return true
+	case isGraphQLRequest(req):
+		operation := graphQLOperation(req)
+		return operation != nil && operation.Operation == ast.Query
+	default:
+		return idempotentRESTPaths[req.URL.Path]
+	}
+}
+
+// isTransient checks whether the request failed because of the network or a gateway, rather than being
+// rejected by the ChaosCenter
+func isTransient(resp *http.Response, err error) bool {
+	if err != nil {
+		var dnsErr *net.DNSError
+		if errors.As(err, &dnsErr) && dnsErr.IsNotFound {
+			return false
+		}
+		var opErr *net.OpError
+		var netErr net.Error
+		return errors.As(err, &opErr) || (errors.As(err, &netErr) && netErr.Timeout()) ||
+			errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// notSent checks whether the request failed before reaching the ChaosCenter, so that even a mutation
+// can be retried
+func notSent(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// shouldRetry decides whether a request failing with a transient error is retried. Reads are always
+// retried, while mutations are only retried when they didn't reach the ChaosCenter, or when it honours
+// their idempotency key by echoing it in the response.
+func shouldRetry(req *http.Request, idempotent bool, resp *http.Response, err error) bool {
+	if req.Context().Err() != nil || !isTransient(resp, err) {
+		return false
+	}
+	if idempotent || notSent(err) {
+		return true
+	}
+	return resp != nil && resp.Header.Get(IdempotencyKeyHeader) == req.Header.Get(IdempotencyKeyHeader)
+}
+
+// transientBackoff returns the time to wait before retrying a request which failed with a transient error
+func transientBackoff(attempt int) time.Duration {
+	return 500 * time.Millisecond << uint(attempt)
+}
+
+// checkRetriedMutation turns the duplicate error returned for a retried mutation into ErrMutationAlreadyApplied,
+// instead of reporting that the resource the user asked to create already exists
+func checkRetriedMutation(resp *http.Response) (*http.Response, error) {
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var response struct {
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+		Error            string `json:"error"`
+		ErrorDescription string `json:"errorDescription"`
+	}
+	if json.Unmarshal(bodyBytes, &response) == nil {
+		messages := []string{response.Error, response.ErrorDescription}
+		for _, e := range response.Errors {
+			messages = append(messages, e.Message)
+		}
+		for _, message := range messages {
+			if duplicateError.MatchString(message) {
+				return nil, ErrMutationAlreadyApplied
+			}
+		}
+	}
+
+	resp.Body = ioutil.NopCloser(bytes.NewReader(bodyBytes))
+	return resp, nil
+}
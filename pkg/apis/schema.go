@@ -0,0 +1,146 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sync"
+
+	types "github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+)
+
+// Schema is the generation of the GraphQL schema spoken by the ChaosCenter
+type Schema string
+
+const (
+	// SchemaV2 is the schema of ChaosCenter 2.x, with Chaos Workflows running on Chaos Delegates
+	SchemaV2 Schema = "v2"
+
+	// SchemaV3 is the schema of ChaosCenter 3.x, with Chaos Experiments running on Chaos Infrastructures
+	SchemaV3 Schema = "v3"
+
+	// schemaV3MinVersion is the first ChaosCenter version speaking the 3.x schema
+	schemaV3MinVersion = "3.0.0-beta1"
+)
+
+var detectedSchemas = struct {
+	sync.Mutex
+	schemas map[string]Schema
+}{schemas: make(map[string]Schema)}
+
+// DetectSchema returns the schema spoken by the ChaosCenter of the endpoint. It's detected from the
+// server version, falling back to introspecting the schema when the version can't be parsed, and is
+// cached for the lifetime of the command. The 2.x schema is assumed when the detection fails.
+func DetectSchema(endpoint string) Schema {
+	detectedSchemas.Lock()
+	defer detectedSchemas.Unlock()
+
+	if schema, ok := detectedSchemas.schemas[endpoint]; ok {
+		return schema
+	}
+
+	schema := SchemaV2
+	if resp, err := GetServerVersion(endpoint); err == nil {
+		serverVersion, parseErr := utils.ParseVersion(resp.Data.GetServerVersion.Value)
+		minVersion, _ := utils.ParseVersion(schemaV3MinVersion)
+		switch {
+		case parseErr == nil && !serverVersion.LessThan(minVersion):
+			schema = SchemaV3
+		case parseErr != nil && hasType(endpoint, "Infra"):
+			schema = SchemaV3
+		}
+	}
+
+	detectedSchemas.schemas[endpoint] = schema
+	return schema
+}
+
+// hasType introspects the GraphQL schema of the ChaosCenter for the given type
+func hasType(endpoint string, name string) bool {
+	query, err := json.Marshal(map[string]string{"query": `{ __type(name: "` + name + `") { name } }`})
+	if err != nil {
+		return false
+	}
+
+	resp, err := SendRequest(SendRequestParams{Endpoint: endpoint + utils.GQLAPIPath}, query, string(types.Post))
+	if err != nil {
+		return false
+	}
+	defer resp.Body.Close()
+
+	var introspection struct {
+		Data struct {
+			Type *struct {
+				Name string `json:"name"`
+			} `json:"__type"`
+		} `json:"data"`
+	}
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil || resp.StatusCode != http.StatusOK || json.Unmarshal(bodyBytes, &introspection) != nil {
+		return false
+	}
+	return introspection.Data.Type != nil
+}
+
+// sendSchemaV3Request sends a GraphQL request to a ChaosCenter speaking the 3.x schema, and decodes
+// the data of the response into out
+func sendSchemaV3Request(query string, variables interface{}, out interface{}, cred types.Credentials) error {
+	return sendGraphQLRequest(query, variables, out, cred)
+}
+
+// sendGraphQLRequest sends a hand-written GraphQL request, for the operations which aren't part of
+// the typed client, and decodes the data of the response into out
+func sendGraphQLRequest(query string, variables interface{}, out interface{}, cred types.Credentials) error {
+	payload, err := json.Marshal(struct {
+		Query     string      `json:"query"`
+		Variables interface{} `json:"variables"`
+	}{Query: query, Variables: variables})
+	if err != nil {
+		return err
+	}
+
+	resp, err := SendRequest(SendRequestParams{Endpoint: cred.Endpoint + utils.GQLAPIPath, Token: cred.Token}, payload, string(types.Post))
+	if err != nil {
+		return err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(resp.Status)
+	}
+
+	var response struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(bodyBytes, &response); err != nil {
+		return err
+	}
+	if len(response.Errors) > 0 {
+		return errors.New(response.Errors[0].Message)
+	}
+	return json.Unmarshal(response.Data, out)
+}
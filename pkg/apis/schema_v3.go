@@ -0,0 +1,355 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	types "github.com/litmuschaos/litmusctl/pkg/types"
+)
+
+// errTagsV2 is returned when the resources are filtered by tags on ChaosCenter 2.x
+var errTagsV2 = errors.New("filtering by tags requires ChaosCenter 3.x, the Chaos Scenarios and Chaos Delegates of ChaosCenter 2.x have no tags")
+
+// The operations of ChaosCenter 3.x are translated from and into the 2.x models, so that the
+// commands work the same way with both schemas: Chaos Workflows are Chaos Experiments, Chaos
+// Delegates are Chaos Infrastructures and experiments are faults.
+
+type infraV3 struct {
+	InfraID      string `json:"infraID"`
+	Name         string `json:"name"`
+	IsActive     bool   `json:"isActive"`
+	IsRegistered bool   `json:"isRegistered"`
+	Version      string `json:"version"`
+	Namespace    string `json:"infraNamespace"`
+	// LastHeartbeat is the time the Chaos Infrastructure last reported, in milliseconds
+	LastHeartbeat string `json:"lastHeartbeat"`
+	EnvironmentID string `json:"environmentID"`
+}
+
+// getAgentListV3 lists the Chaos Infrastructures of the project as Chaos Delegates
+func getAgentListV3(c types.Credentials, pid string, tags []string) (AgentData, error) {
+	var data struct {
+		ListInfras struct {
+			Infras []infraV3 `json:"infras"`
+		} `json:"listInfras"`
+	}
+	request := map[string]interface{}{}
+	if len(tags) > 0 {
+		request["filter"] = map[string]interface{}{"tags": tags}
+	}
+	err := sendSchemaV3Request(`query listInfras($projectID: ID!, $request: ListInfraRequest) {
+                      listInfras(projectID: $projectID, request: $request) {
+                        infras { infraID name isActive isRegistered version infraNamespace lastHeartbeat environmentID }
+                      }
+                    }`, map[string]interface{}{"projectID": pid, "request": request}, &data, c)
+	if err != nil {
+		return AgentData{}, err
+	}
+
+	var agents AgentData
+	for _, infra := range data.ListInfras.Infras {
+		agents.Data.GetAgent = append(agents.Data.GetAgent, AgentDetails{
+			AgentName:     infra.Name,
+			IsActive:      infra.IsActive,
+			IsRegistered:  infra.IsRegistered,
+			ClusterID:     infra.InfraID,
+			Version:       infra.Version,
+			Namespace:     infra.Namespace,
+			LastHeartbeat: infra.LastHeartbeat,
+			EnvironmentID: infra.EnvironmentID,
+		})
+	}
+	return agents, nil
+}
+
+type experimentV3 struct {
+	ExperimentID       string `json:"experimentID"`
+	ExperimentManifest string `json:"experimentManifest"`
+	CronSyntax         string `json:"cronSyntax"`
+	Name               string `json:"name"`
+	Description        string `json:"description"`
+	Weightages         []struct {
+		FaultName string `json:"faultName"`
+		Weightage int    `json:"weightage"`
+	} `json:"weightages"`
+	IsCustomExperiment bool    `json:"isCustomExperiment"`
+	UpdatedAt          string  `json:"updatedAt"`
+	CreatedAt          string  `json:"createdAt"`
+	ProjectID          string  `json:"projectID"`
+	Infra              infraV3 `json:"infra"`
+	IsRemoved          bool    `json:"isRemoved"`
+	UpdatedBy          *struct {
+		Username string `json:"username"`
+	} `json:"updatedBy"`
+}
+
+// secondsV3 translates the 3.x timestamps in milliseconds into the 2.x timestamps in seconds
+func secondsV3(timestamp string) string {
+	milliseconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return timestamp
+	}
+	return strconv.FormatInt(milliseconds/1000, 10)
+}
+
+// sortV3 translates the 2.x sort input, 3.x sorts ascending instead of descending
+func sortV3(field model.WorkflowSortingField, descending *bool) map[string]interface{} {
+	return map[string]interface{}{"field": field, "ascending": descending == nil || !*descending}
+}
+
+// getWorkflowListV3 lists the Chaos Experiments of the project as Chaos Workflows
+func getWorkflowListV3(in model.ListWorkflowsRequest, tags []string, cred types.Credentials) (WorkflowListData, error) {
+	request := map[string]interface{}{
+		"experimentIDs": in.WorkflowIDs,
+		"pagination":    in.Pagination,
+	}
+	if in.Sort != nil {
+		request["sort"] = sortV3(in.Sort.Field, in.Sort.Descending)
+	}
+	filter := map[string]interface{}{}
+	if in.Filter != nil {
+		filter["experimentName"] = in.Filter.WorkflowName
+		filter["infraName"] = in.Filter.ClusterName
+	}
+	if len(tags) > 0 {
+		filter["tags"] = tags
+	}
+	if len(filter) > 0 {
+		request["filter"] = filter
+	}
+
+	var data struct {
+		ListExperiment struct {
+			TotalNoOfExperiments int            `json:"totalNoOfExperiments"`
+			Experiments          []experimentV3 `json:"experiments"`
+		} `json:"listExperiment"`
+	}
+	err := sendSchemaV3Request(`query listExperiment($projectID: ID!, $request: ListExperimentRequest!) {
+                      listExperiment(projectID: $projectID, request: $request) {
+                        totalNoOfExperiments
+                        experiments {
+                          experimentID
+                          experimentManifest
+                          cronSyntax
+                          name
+                          description
+                          weightages { faultName weightage }
+                          isCustomExperiment
+                          updatedAt
+                          createdAt
+                          projectID
+                          infra { infraID name infraType }
+                          isRemoved
+                          updatedBy { username }
+                        }
+                      }
+                    }`, map[string]interface{}{"projectID": in.ProjectID, "request": request}, &data, cred)
+	if err != nil {
+		return WorkflowListData{}, err
+	}
+
+	var workflowList WorkflowListData
+	workflowList.Data.ListWorkflowDetails.TotalNoOfWorkflows = data.ListExperiment.TotalNoOfExperiments
+	for _, experiment := range data.ListExperiment.Experiments {
+		workflow := &model.Workflow{
+			WorkflowID:          experiment.ExperimentID,
+			WorkflowManifest:    experiment.ExperimentManifest,
+			CronSyntax:          experiment.CronSyntax,
+			ClusterName:         experiment.Infra.Name,
+			WorkflowName:        experiment.Name,
+			WorkflowDescription: experiment.Description,
+			IsCustomWorkflow:    experiment.IsCustomExperiment,
+			UpdatedAt:           secondsV3(experiment.UpdatedAt),
+			CreatedAt:           secondsV3(experiment.CreatedAt),
+			ProjectID:           experiment.ProjectID,
+			ClusterID:           experiment.Infra.InfraID,
+			IsRemoved:           experiment.IsRemoved,
+		}
+		for _, weightage := range experiment.Weightages {
+			workflow.Weightages = append(workflow.Weightages, &model.Weightages{ExperimentName: weightage.FaultName, Weightage: weightage.Weightage})
+		}
+		if experiment.UpdatedBy != nil {
+			workflow.LastUpdatedBy = &experiment.UpdatedBy.Username
+		}
+		workflowList.Data.ListWorkflowDetails.Workflows = append(workflowList.Data.ListWorkflowDetails.Workflows, workflow)
+	}
+	return workflowList, nil
+}
+
+// runPhasesV3 maps the phases of the 3.x Chaos Experiment runs to the 2.x Chaos Workflow run phases
+var runPhasesV3 = map[string]string{
+	"Completed":            string(model.WorkflowRunStatusSucceeded),
+	"Completed_With_Error": string(model.WorkflowRunStatusFailed),
+	"Error":                string(model.WorkflowRunStatusFailed),
+	"Timeout":              string(model.WorkflowRunStatusFailed),
+	"Stopped":              string(model.WorkflowRunStatusTerminated),
+}
+
+// getWorkflowRunsListV3 lists the Chaos Experiment runs of the project as Chaos Workflow runs
+func getWorkflowRunsListV3(in model.ListWorkflowRunsRequest, cred types.Credentials) (WorkflowRunsListData, error) {
+	request := map[string]interface{}{
+		"experimentRunIDs": in.WorkflowRunIDs,
+		"experimentIDs":    in.WorkflowIDs,
+		"pagination":       in.Pagination,
+	}
+	if in.Sort != nil {
+		request["sort"] = sortV3(in.Sort.Field, in.Sort.Descending)
+	}
+	if in.Filter != nil {
+		// 3.x filters the runs by infrastructure ID instead of name
+		filter := map[string]interface{}{"experimentName": in.Filter.WorkflowName, "dateRange": in.Filter.DateRange}
+		if in.Filter.WorkflowStatus != nil && *in.Filter.WorkflowStatus != model.WorkflowRunStatusAll {
+			status := string(*in.Filter.WorkflowStatus)
+			switch *in.Filter.WorkflowStatus {
+			case model.WorkflowRunStatusSucceeded:
+				status = "Completed"
+			case model.WorkflowRunStatusFailed:
+				status = "Error"
+			}
+			filter["experimentStatus"] = status
+		}
+		request["filter"] = filter
+	}
+
+	var data struct {
+		ListExperimentRun struct {
+			TotalNoOfExperimentRuns int `json:"totalNoOfExperimentRuns"`
+			ExperimentRuns          []struct {
+				ExperimentRunID string   `json:"experimentRunID"`
+				ExperimentID    string   `json:"experimentID"`
+				ExperimentName  string   `json:"experimentName"`
+				Infra           infraV3  `json:"infra"`
+				ProjectID       string   `json:"projectID"`
+				IsRemoved       *bool    `json:"isRemoved"`
+				UpdatedAt       string   `json:"updatedAt"`
+				Phase           string   `json:"phase"`
+				ResiliencyScore *float64 `json:"resiliencyScore"`
+				FaultsPassed    *int     `json:"faultsPassed"`
+				FaultsFailed    *int     `json:"faultsFailed"`
+				FaultsAwaited   *int     `json:"faultsAwaited"`
+				FaultsStopped   *int     `json:"faultsStopped"`
+				FaultsNa        *int     `json:"faultsNa"`
+				TotalFaults     *int     `json:"totalFaults"`
+				ExecutionData   string   `json:"executionData"`
+				UpdatedBy       *struct {
+					Username string `json:"username"`
+				} `json:"updatedBy"`
+			} `json:"experimentRuns"`
+		} `json:"listExperimentRun"`
+	}
+	err := sendSchemaV3Request(`query listExperimentRun($projectID: ID!, $request: ListExperimentRunRequest!) {
+                      listExperimentRun(projectID: $projectID, request: $request) {
+                        totalNoOfExperimentRuns
+                        experimentRuns {
+                          experimentRunID
+                          experimentID
+                          experimentName
+                          infra { infraID name infraType }
+                          projectID
+                          isRemoved
+                          updatedAt
+                          phase
+                          resiliencyScore
+                          faultsPassed
+                          faultsFailed
+                          faultsAwaited
+                          faultsStopped
+                          faultsNa
+                          totalFaults
+                          executionData
+                          updatedBy { username }
+                        }
+                      }
+                    }`, map[string]interface{}{"projectID": in.ProjectID, "request": request}, &data, cred)
+	if err != nil {
+		return WorkflowRunsListData{}, err
+	}
+
+	var runsList WorkflowRunsListData
+	runsList.Data.ListWorkflowRunsDetails.TotalNoOfWorkflowRuns = data.ListExperimentRun.TotalNoOfExperimentRuns
+	for _, run := range data.ListExperimentRun.ExperimentRuns {
+		phase, ok := runPhasesV3[run.Phase]
+		if !ok {
+			phase = run.Phase
+		}
+		workflowRun := &model.WorkflowRun{
+			WorkflowRunID:      run.ExperimentRunID,
+			WorkflowID:         run.ExperimentID,
+			ClusterName:        run.Infra.Name,
+			LastUpdated:        secondsV3(run.UpdatedAt),
+			ProjectID:          run.ProjectID,
+			ClusterID:          run.Infra.InfraID,
+			WorkflowName:       run.ExperimentName,
+			Phase:              phase,
+			ResiliencyScore:    run.ResiliencyScore,
+			ExperimentsPassed:  run.FaultsPassed,
+			ExperimentsFailed:  run.FaultsFailed,
+			ExperimentsAwaited: run.FaultsAwaited,
+			ExperimentsStopped: run.FaultsStopped,
+			ExperimentsNa:      run.FaultsNa,
+			TotalExperiments:   run.TotalFaults,
+			ExecutionData:      run.ExecutionData,
+			IsRemoved:          run.IsRemoved,
+		}
+		if run.UpdatedBy != nil {
+			workflowRun.ExecutedBy = run.UpdatedBy.Username
+		}
+		runsList.Data.ListWorkflowRunsDetails.WorkflowRuns = append(runsList.Data.ListWorkflowRunsDetails.WorkflowRuns, workflowRun)
+	}
+	return runsList, nil
+}
+
+// deleteChaosWorkflowV3 deletes the Chaos Experiment of the Chaos Workflow ID
+func deleteChaosWorkflowV3(projectID string, workflowID *string, cred types.Credentials) (DeleteChaosWorkflowData, error) {
+	var data struct {
+		DeleteChaosExperiment bool `json:"deleteChaosExperiment"`
+	}
+	err := sendSchemaV3Request(`mutation deleteChaosExperiment($projectID: ID!, $experimentID: String!) {
+                      deleteChaosExperiment(projectID: $projectID, experimentID: $experimentID)
+                    }`, map[string]interface{}{"projectID": projectID, "experimentID": workflowID}, &data, cred)
+	if err != nil {
+		return DeleteChaosWorkflowData{}, err
+	}
+
+	var deleted DeleteChaosWorkflowData
+	deleted.Data.IsDeleted = data.DeleteChaosExperiment
+	return deleted, nil
+}
+
+// reRunWorkflowV3 runs the Chaos Experiment of the Chaos Workflow ID
+func reRunWorkflowV3(projectID string, workflowID string, cred types.Credentials) error {
+	var data struct {
+		RunChaosExperiment struct {
+			NotifyID string `json:"notifyID"`
+		} `json:"runChaosExperiment"`
+	}
+	return sendSchemaV3Request(`mutation runChaosExperiment($experimentID: String!, $projectID: ID!) {
+                      runChaosExperiment(experimentID: $experimentID, projectID: $projectID) { notifyID }
+                    }`, map[string]interface{}{"projectID": projectID, "experimentID": workflowID}, &data, cred)
+}
+
+// terminateWorkflowRunV3 stops the Chaos Experiment run of the Chaos Workflow run ID
+func terminateWorkflowRunV3(projectID string, workflowID string, workflowRunID string, cred types.Credentials) error {
+	var data struct {
+		StopExperimentRuns bool `json:"stopExperimentRuns"`
+	}
+	return sendSchemaV3Request(`mutation stopExperimentRuns($projectID: ID!, $experimentID: String!, $experimentRunID: String) {
+                      stopExperimentRuns(projectID: $projectID, experimentID: $experimentID, experimentRunID: $experimentRunID)
+                    }`, map[string]interface{}{"projectID": projectID, "experimentID": workflowID, "experimentRunID": workflowRunID}, &data, cred)
+}
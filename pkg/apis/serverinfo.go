@@ -0,0 +1,180 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"time"
+
+	types "github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+)
+
+// Auth modes of the ChaosCenter
+const (
+	LocalAuthMode   = "local"
+	DexAuthMode     = "dex"
+	UnknownAuthMode = "unknown"
+)
+
+// ServerInfo is the build and configuration of the ChaosCenter, as reported by its APIs
+type ServerInfo struct {
+	Endpoint       string   `json:"endpoint"`
+	Version        string   `json:"version"`
+	BuildCommit    string   `json:"buildCommit,omitempty"`
+	Schema         Schema   `json:"schema"`
+	AuthMode       string   `json:"authMode"`
+	AuthStatus     string   `json:"authStatus,omitempty"`
+	Features       []string `json:"features"`
+	GraphQLBlocked bool     `json:"graphQLBlocked,omitempty"`
+}
+
+// serverFeatures maps the GraphQL operations to the features of the ChaosCenter they belong to
+var serverFeatures = map[string]string{
+	"listClusters":      "chaos-delegates",
+	"listInfras":        "chaos-infrastructures",
+	"listWorkflows":     "chaos-workflows",
+	"listExperiment":    "chaos-experiments",
+	"listEnvironments":  "environments",
+	"listProbes":        "resilience-probes",
+	"listHubStatus":     "chaoshub",
+	"listChaosHub":      "chaoshub",
+	"getGitOpsDetails":  "gitops",
+	"listImageRegistry": "image-registry",
+	"listDataSource":    "observability",
+}
+
+// GetServerInfo queries the version of the ChaosCenter, introspects its GraphQL schema for the
+// enabled features, and probes the auth server for its status and auth mode. When the GraphQL API
+// is blocked, only the auth server is probed.
+func GetServerInfo(cred types.Credentials) (ServerInfo, error) {
+	info := ServerInfo{
+		Endpoint: cred.Endpoint,
+		AuthMode: UnknownAuthMode,
+		Features: []string{},
+	}
+
+	version, err := GetServerVersion(cred.Endpoint)
+	switch {
+	case errors.Is(err, ErrGraphQLBlocked):
+		info.GraphQLBlocked = true
+	case err != nil:
+		return ServerInfo{}, err
+	default:
+		info.Version = version.Data.GetServerVersion.Value
+		info.Schema = DetectSchema(cred.Endpoint)
+
+		features := make(map[string]bool)
+		for _, operation := range schemaOperations(cred) {
+			if feature, ok := serverFeatures[operation]; ok {
+				features[feature] = true
+			}
+		}
+		for feature := range features {
+			info.Features = append(info.Features, feature)
+		}
+		sort.Strings(info.Features)
+	}
+
+	// The redirects of the dex login are not followed, only its presence matters
+	client := &http.Client{
+		Transport:     utils.Transport,
+		Timeout:       10 * time.Second,
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	if resp, err := probeAuthServer(client, cred.Endpoint+utils.AuthAPIPath+"/dex/login"); err == nil {
+		resp.Body.Close()
+		switch {
+		case resp.StatusCode == http.StatusNotFound:
+			info.AuthMode = LocalAuthMode
+		case resp.StatusCode < http.StatusBadRequest:
+			info.AuthMode = DexAuthMode
+		}
+	}
+
+	if resp, err := probeAuthServer(client, cred.Endpoint+utils.AuthAPIPath+"/status"); err == nil {
+		defer resp.Body.Close()
+		var status map[string]interface{}
+		if bodyBytes, err := ioutil.ReadAll(resp.Body); err == nil && json.Unmarshal(bodyBytes, &status) == nil {
+			if value, ok := status["status"].(string); ok {
+				info.AuthStatus = value
+			}
+			// The build commit is only reported by the ChaosCenter builds exposing it
+			for _, key := range []string{"buildCommit", "gitCommit", "commit"} {
+				if value, ok := status[key].(string); ok && value != "" {
+					info.BuildCommit = value
+					break
+				}
+			}
+		}
+	}
+
+	return info, nil
+}
+
+// probeAuthServer sends a GET request to the auth server
+func probeAuthServer(client *http.Client, url string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	utils.SetRequestHeaders(req.Header, url)
+	return client.Do(req)
+}
+
+// schemaOperations introspects the names of the GraphQL queries and mutations of the ChaosCenter.
+// Nothing is returned when the introspection is disabled.
+func schemaOperations(cred types.Credentials) []string {
+	query := `{"query":"{ __schema { queryType { fields { name } } mutationType { fields { name } } } }"}`
+	resp, err := SendRequest(SendRequestParams{Endpoint: cred.Endpoint + utils.GQLAPIPath, Token: cred.Token}, []byte(query), string(types.Post))
+	if err != nil {
+		return nil
+	}
+	defer resp.Body.Close()
+
+	type fields struct {
+		Fields []struct {
+			Name string `json:"name"`
+		} `json:"fields"`
+	}
+	var introspection struct {
+		Data struct {
+			Schema struct {
+				QueryType    *fields `json:"queryType"`
+				MutationType *fields `json:"mutationType"`
+			} `json:"__schema"`
+		} `json:"data"`
+	}
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil || resp.StatusCode != http.StatusOK || json.Unmarshal(bodyBytes, &introspection) != nil {
+		return nil
+	}
+
+	var operations []string
+	for _, operationType := range []*fields{introspection.Data.Schema.QueryType, introspection.Data.Schema.MutationType} {
+		if operationType == nil {
+			continue
+		}
+		for _, field := range operationType.Fields {
+			operations = append(operations, field.Name)
+		}
+	}
+	return operations
+}
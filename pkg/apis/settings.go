@@ -0,0 +1,188 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	types "github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+)
+
+type ProjectSettingsData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data ProjectSettings `json:"data"`
+}
+
+// ProjectSettings are the project-level settings of ChaosCenter
+type ProjectSettings struct {
+	GitOps          model.GitConfigResponse       `json:"getGitOpsDetails"`
+	ImageRegistries []model.ImageRegistryResponse `json:"listImageRegistry"`
+}
+
+type ProjectSettingsGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		ProjectID string `json:"projectID"`
+	} `json:"variables"`
+}
+
+// GetProjectSettings sends GraphQL API request for fetching the GitOps and image registry settings of a project.
+func GetProjectSettings(projectID string, cred types.Credentials) (ProjectSettingsData, error) {
+
+	var gqlReq ProjectSettingsGraphQLRequest
+	var err error
+
+	gqlReq.Query = `query getProjectSettings($projectID: String!) {
+                      getGitOpsDetails(projectID: $projectID) {
+                        enabled
+                        projectID
+                        branch
+                        repoURL
+                        authType
+                      }
+                      listImageRegistry(projectID: $projectID) {
+                        imageRegistryID
+                        projectID
+                        isDefault
+                        imageRegistryInfo {
+                          isDefault
+                          imageRegistryName
+                          imageRepoName
+                          imageRegistryType
+                          secretName
+                          secretNamespace
+                          enableRegistry
+                        }
+                        updatedAt
+                        createdAt
+                        isRemoved
+                      }
+                    }`
+	gqlReq.Variables.ProjectID = projectID
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return ProjectSettingsData{}, err
+	}
+
+	var settings ProjectSettingsData
+	err = sendProjectSettingsRequest(query, &settings, cred)
+	if err != nil {
+		return ProjectSettingsData{}, err
+	}
+
+	if len(settings.Errors) > 0 {
+		return ProjectSettingsData{}, errors.New(settings.Errors[0].Message)
+	}
+
+	return settings, nil
+}
+
+type ImageRegistryData struct {
+	Errors []struct {
+		Message string   `json:"message"`
+		Path    []string `json:"path"`
+	} `json:"errors"`
+	Data struct {
+		CreateImageRegistry *model.ImageRegistryResponse `json:"createImageRegistry"`
+		UpdateImageRegistry *model.ImageRegistryResponse `json:"updateImageRegistry"`
+	} `json:"data"`
+}
+
+type ImageRegistryGraphQLRequest struct {
+	Query     string `json:"query"`
+	Variables struct {
+		ProjectID         string                   `json:"projectID"`
+		ImageRegistryID   string                   `json:"imageRegistryID,omitempty"`
+		ImageRegistryInfo model.ImageRegistryInput `json:"imageRegistryInfo"`
+	} `json:"variables"`
+}
+
+// SaveImageRegistry sends GraphQL API request for configuring the image registry of a project.
+// The image registry is updated when imageRegistryID is set, otherwise a new one is created.
+func SaveImageRegistry(projectID string, imageRegistryID string, imageRegistry model.ImageRegistryInput, cred types.Credentials) (ImageRegistryData, error) {
+
+	var gqlReq ImageRegistryGraphQLRequest
+	var err error
+
+	if imageRegistryID == "" {
+		gqlReq.Query = `mutation createImageRegistry($projectID: String!, $imageRegistryInfo: ImageRegistryInput!) {
+                      createImageRegistry(projectID: $projectID, imageRegistryInfo: $imageRegistryInfo) {
+                        imageRegistryID
+                      }
+                    }`
+	} else {
+		gqlReq.Query = `mutation updateImageRegistry($imageRegistryID: String!, $projectID: String!, $imageRegistryInfo: ImageRegistryInput!) {
+                      updateImageRegistry(imageRegistryID: $imageRegistryID, projectID: $projectID, imageRegistryInfo: $imageRegistryInfo) {
+                        imageRegistryID
+                      }
+                    }`
+	}
+	gqlReq.Variables.ProjectID = projectID
+	gqlReq.Variables.ImageRegistryID = imageRegistryID
+	gqlReq.Variables.ImageRegistryInfo = imageRegistry
+
+	query, err := json.Marshal(gqlReq)
+	if err != nil {
+		return ImageRegistryData{}, err
+	}
+
+	var imageRegistryData ImageRegistryData
+	err = sendProjectSettingsRequest(query, &imageRegistryData, cred)
+	if err != nil {
+		return ImageRegistryData{}, err
+	}
+
+	if len(imageRegistryData.Errors) > 0 {
+		return ImageRegistryData{}, errors.New(imageRegistryData.Errors[0].Message)
+	}
+
+	return imageRegistryData, nil
+}
+
+func sendProjectSettingsRequest(query []byte, out interface{}, cred types.Credentials) error {
+	resp, err := SendRequest(
+		SendRequestParams{
+			Endpoint: cred.Endpoint + utils.GQLAPIPath,
+			Token:    cred.Token,
+		},
+		query,
+		string(types.Post),
+	)
+	if err != nil {
+		return err
+	}
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	defer resp.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode == http.StatusOK {
+		return json.Unmarshal(bodyBytes, out)
+	} else {
+		return errors.New("Error while fetching the project settings: " + string(bodyBytes))
+	}
+}
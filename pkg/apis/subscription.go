@@ -0,0 +1,227 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	types "github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+)
+
+// PollInterval is the interval the workflow runs are polled at when the ChaosCenter can't stream them
+const PollInterval = 5 * time.Second
+
+// errSubscriptionUnavailable is returned when the ChaosCenter doesn't accept the WebSocket
+// subscription, e.g. because the gateway in front of it doesn't forward WebSockets
+var errSubscriptionUnavailable = errors.New("the GraphQL subscriptions of the ChaosCenter are not available")
+
+// subscriptionMessage is a message of the graphql-ws protocol used by the ChaosCenter subscriptions
+type subscriptionMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// WatchWorkflowRuns calls onRun with the latest workflow runs of the project, and then with the runs
+// whenever they're updated, until onRun returns false or the context is done. The runs are streamed over
+// the WebSocket subscription of the ChaosCenter, and polled every PollInterval where it isn't available.
+func WatchWorkflowRuns(ctx context.Context, projectID string, cred types.Credentials, onRun func(*model.WorkflowRun) bool) error {
+	if DetectSchema(cred.Endpoint) == SchemaV2 {
+		err := subscribeWorkflowRuns(ctx, projectID, cred, onRun)
+		if err != errSubscriptionUnavailable {
+			return err
+		}
+	}
+	return pollWorkflowRuns(ctx, projectID, cred, onRun)
+}
+
+// subscribeWorkflowRuns streams the workflow runs over the getWorkflowEvents subscription
+func subscribeWorkflowRuns(ctx context.Context, projectID string, cred types.Credentials, onRun func(*model.WorkflowRun) bool) error {
+	dialer := websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: 10 * time.Second,
+		Subprotocols:     []string{"graphql-ws"},
+		TLSClientConfig:  utils.Transport.TLSClientConfig,
+	}
+	url := "ws" + strings.TrimPrefix(cred.Endpoint+utils.GQLAPIPath, "http")
+	header := http.Header{"Authorization": []string{cred.Token}}
+	utils.SetRequestHeaders(header, url)
+	conn, _, err := dialer.DialContext(ctx, url, header)
+	if err != nil {
+		return errSubscriptionUnavailable
+	}
+	defer conn.Close()
+
+	// Unblock the reads when the context is done
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	initPayload, _ := json.Marshal(map[string]string{"Authorization": cred.Token})
+	if err := conn.WriteJSON(subscriptionMessage{Type: "connection_init", Payload: initPayload}); err != nil {
+		return errSubscriptionUnavailable
+	}
+	var ack subscriptionMessage
+	if err := conn.ReadJSON(&ack); err != nil || ack.Type != "connection_ack" {
+		return errSubscriptionUnavailable
+	}
+
+	startPayload, err := json.Marshal(map[string]interface{}{
+		"query": `subscription getWorkflowEvents($projectID: String!) {
+                      getWorkflowEvents(projectID: $projectID) {
+                        workflowRunID
+                        workflowID
+                        clusterName
+                        lastUpdated
+                        projectID
+                        clusterID
+                        workflowName
+                        clusterType
+                        phase
+                        resiliencyScore
+                        experimentsPassed
+                        experimentsFailed
+                        experimentsAwaited
+                        experimentsStopped
+                        experimentsNa
+                        totalExperiments
+                        isRemoved
+                        executedBy
+                      }
+                    }`,
+		"variables": map[string]string{"projectID": projectID},
+	})
+	if err != nil {
+		return err
+	}
+	if err := conn.WriteJSON(subscriptionMessage{ID: "1", Type: "start", Payload: startPayload}); err != nil {
+		return errSubscriptionUnavailable
+	}
+
+	// The runs updated before the subscription started are fetched once
+	if ok, err := reportLatestWorkflowRuns(projectID, cred, make(map[string]string), onRun); err != nil || !ok {
+		return err
+	}
+
+	for {
+		var message subscriptionMessage
+		if err := conn.ReadJSON(&message); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+
+		switch message.Type {
+		case "data":
+			var payload struct {
+				Data struct {
+					GetWorkflowEvents model.WorkflowRun `json:"getWorkflowEvents"`
+				} `json:"data"`
+				Errors []struct {
+					Message string `json:"message"`
+				} `json:"errors"`
+			}
+			if err := json.Unmarshal(message.Payload, &payload); err != nil {
+				return err
+			}
+			if len(payload.Errors) > 0 {
+				return errors.New(payload.Errors[0].Message)
+			}
+			if !onRun(&payload.Data.GetWorkflowEvents) {
+				_ = conn.WriteJSON(subscriptionMessage{ID: "1", Type: "stop"})
+				return nil
+			}
+		case "error", "connection_error":
+			var payload struct {
+				Message string `json:"message"`
+			}
+			_ = json.Unmarshal(message.Payload, &payload)
+			return errors.New("subscription failed: " + payload.Message)
+		case "complete":
+			return nil
+		}
+	}
+}
+
+// pollWorkflowRuns polls the latest workflow runs of the project
+func pollWorkflowRuns(ctx context.Context, projectID string, cred types.Credentials, onRun func(*model.WorkflowRun) bool) error {
+	lastUpdated := make(map[string]string)
+	ticker := time.NewTicker(PollInterval)
+	defer ticker.Stop()
+	for {
+		if ok, err := reportLatestWorkflowRuns(projectID, cred, lastUpdated, onRun); err != nil || !ok {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// reportLatestWorkflowRuns calls onRun with the latest workflow runs which were updated since they were
+// last reported, it returns false when onRun stops the watch
+func reportLatestWorkflowRuns(projectID string, cred types.Credentials, lastUpdated map[string]string, onRun func(*model.WorkflowRun) bool) (bool, error) {
+	descending := true
+	workflowRuns, err := GetWorkflowRunsList(model.ListWorkflowRunsRequest{
+		ProjectID:  projectID,
+		Pagination: &model.Pagination{Limit: PageSize},
+		Sort:       &model.WorkflowRunSortInput{Field: model.WorkflowSortingFieldTime, Descending: &descending},
+	}, cred)
+	if err != nil {
+		return false, err
+	}
+
+	// The runs are reported from the oldest to the latest update
+	runs := workflowRuns.Data.ListWorkflowRunsDetails.WorkflowRuns
+	for i := len(runs) - 1; i >= 0; i-- {
+		version := runs[i].LastUpdated + runs[i].Phase
+		if lastUpdated[runs[i].WorkflowRunID] == version {
+			continue
+		}
+		lastUpdated[runs[i].WorkflowRunID] = version
+		if !onRun(runs[i]) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// IsWorkflowRunFinished returns whether the workflow run reached a final phase
+func IsWorkflowRunFinished(run *model.WorkflowRun) bool {
+	switch model.WorkflowRunStatus(run.Phase) {
+	case model.WorkflowRunStatusSucceeded, model.WorkflowRunStatusFailed, model.WorkflowRunStatusTerminated:
+		return true
+	}
+	return run.Phase == "Error"
+}
@@ -0,0 +1,125 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/litmuschaos/litmusctl/pkg/types"
+)
+
+// APIToken is a long-lived token issued to a user of ChaosCenter 3.x, e.g. for automations
+type APIToken struct {
+	UserID    string    `json:"user_id"`
+	Name      string    `json:"name"`
+	Token     string    `json:"token"`
+	ExpiresAt tokenTime `json:"expires_at"`
+	CreatedAt tokenTime `json:"created_at"`
+}
+
+// ID identifies the token without disclosing it, it's the end of the signature of the JWT
+func (t APIToken) ID() string {
+	if len(t.Token) <= 8 {
+		return t.Token
+	}
+	return t.Token[len(t.Token)-8:]
+}
+
+// tokenTime is a unix timestamp, sent as a number or a string depending on the ChaosCenter version
+type tokenTime int64
+
+func (t *tokenTime) UnmarshalJSON(data []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	switch v := value.(type) {
+	case float64:
+		*t = tokenTime(v)
+	case string:
+		seconds, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return err
+		}
+		*t = tokenTime(seconds)
+	}
+	return nil
+}
+
+// Time returns the timestamp, zero when it isn't set
+func (t tokenTime) Time() time.Time {
+	if t == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(t), 0)
+}
+
+type createTokenPayload struct {
+	UserID              string `json:"user_id"`
+	Name                string `json:"name"`
+	DaysUntilExpiration int    `json:"days_until_expiration"`
+}
+
+type createTokenResponse struct {
+	AccessToken string `json:"accessToken"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// CreateAPIToken issues an API token of the user, which expires after the given number of days
+func CreateAPIToken(userID string, name string, days int, cred types.Credentials) (string, error) {
+	var data createTokenResponse
+	err := sendAuthServerRequest("/create_token", types.Post, createTokenPayload{
+		UserID:              userID,
+		Name:                name,
+		DaysUntilExpiration: days,
+	}, &data, cred)
+	return data.AccessToken, err
+}
+
+type listTokensResponse struct {
+	APITokens []APIToken `json:"apiTokens"`
+}
+
+// ListAPITokens lists the API tokens of the user
+func ListAPITokens(userID string, cred types.Credentials) ([]APIToken, error) {
+	var data listTokensResponse
+	err := sendAuthServerRequest("/token/"+userID, types.Get, nil, &data, cred)
+	return data.APITokens, err
+}
+
+type removeTokenPayload struct {
+	UserID string `json:"user_id"`
+	Token  string `json:"token"`
+}
+
+// RevokeAPIToken revokes the API token of the user, the ChaosCenter rejects it from then on
+func RevokeAPIToken(userID string, token string, cred types.Credentials) (authServerResponse, error) {
+	var data authServerResponse
+	err := sendAuthServerRequest("/remove_token", types.Post, removeTokenPayload{
+		UserID: userID,
+		Token:  token,
+	}, &data, cred)
+	return data, err
+}
+
+// RevokeSession revokes the login token of the credentials, the ChaosCenter rejects it from then on
+func RevokeSession(cred types.Credentials) (authServerResponse, error) {
+	var data authServerResponse
+	err := sendAuthServerRequest("/logout", types.Post, nil, &data, cred)
+	return data, err
+}
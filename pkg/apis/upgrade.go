@@ -47,18 +47,25 @@ type ClusterDetails struct {
 	AgentNamespace *string `json:"agentNamespace"`
 }
 
-func UpgradeAgent(c context.Context, cred types.Credentials, projectID string, clusterID string, kubeconfig string) (string, error) {
+// GetAgentManifest returns the current manifest of a connected Chaos Delegate
+func GetAgentManifest(cred types.Credentials, projectID string, clusterID string) (string, error) {
+	_, manifest, err := getAgentManifest(cred, projectID, clusterID)
+	return manifest, err
+}
+
+// getAgentManifest fetches the details of the Chaos Delegate, followed by its manifest
+func getAgentManifest(cred types.Credentials, projectID string, clusterID string) (ClusterDetails, string, error) {
 
 	// Query to fetch agent details from server
 	query := `{"query":"query {\n getAgentDetails(clusterID : \"` + clusterID + `\", \n projectID : \"` + projectID + `\"){\n agentNamespace accessKey clusterID \n}}"}`
 	resp, err := SendRequest(SendRequestParams{Endpoint: cred.Endpoint + utils.GQLAPIPath, Token: cred.Token}, []byte(query), string(types.Post))
 	if err != nil {
-		return "", err
+		return ClusterDetails{}, "", err
 	}
 
 	bodyBytes, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return "", err
+		return ClusterDetails{}, "", err
 	}
 
 	defer resp.Body.Close()
@@ -67,101 +74,140 @@ func UpgradeAgent(c context.Context, cred types.Credentials, projectID string, c
 	if resp.StatusCode == http.StatusOK {
 		err = json.Unmarshal(bodyBytes, &agent)
 		if err != nil {
-			return "", err
+			return ClusterDetails{}, "", err
 		}
 		if len(agent.Errors) > 0 {
-			return "", errors.New(agent.Errors[0].Message)
+			return ClusterDetails{}, "", errors.New(agent.Errors[0].Message)
 		}
 	} else {
-		return "", errors.New(resp.Status)
+		return ClusterDetails{}, "", errors.New(resp.Status)
 	}
 
 	// Query to fetch upgraded manifest from the server
 	query = `{"query":"query {\n getManifest(projectID : \"` + projectID + `\",\n clusterID : \"` + agent.Data.GetAgentDetails.ClusterID + `\",\n accessKey :\"` + agent.Data.GetAgentDetails.AccessKey + `\")}"}`
 	resp, err = SendRequest(SendRequestParams{Endpoint: cred.Endpoint + utils.GQLAPIPath, Token: cred.Token}, []byte(query), string(types.Post))
 	if err != nil {
-		return "", err
+		return ClusterDetails{}, "", err
 	}
 
 	bodyBytes, err = ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return "", err
+		return ClusterDetails{}, "", err
 	}
 
 	defer resp.Body.Close()
 
 	// Checks if status code is OK(200)
-	if resp.StatusCode == http.StatusOK {
-		var manifest manifestData
-		err = json.Unmarshal(bodyBytes, &manifest)
-		if err != nil {
-			return "", err
-		}
+	if resp.StatusCode != http.StatusOK {
+		return ClusterDetails{}, "", errors.New("Unmatched status code:" + string(bodyBytes))
+	}
 
-		if len(manifest.Errors) > 0 {
-			return "", errors.New(manifest.Errors[0].Message)
-		}
+	var manifest manifestData
+	err = json.Unmarshal(bodyBytes, &manifest)
+	if err != nil {
+		return ClusterDetails{}, "", err
+	}
 
-		// To write the manifest data into a temporary file
-		err = ioutil.WriteFile("chaos-delegate-manifest.yaml", []byte(manifest.Data.GetManifest), 0644)
-		if err != nil {
-			return "", err
-		}
+	if len(manifest.Errors) > 0 {
+		return ClusterDetails{}, "", errors.New(manifest.Errors[0].Message)
+	}
 
-		// Fetching agent-config from the subscriber
-		configData, err := k8s.GetConfigMap(c, "agent-config", *agent.Data.GetAgentDetails.AgentNamespace)
-		if err != nil {
-			return "", err
-		}
-		var configMapString string
-
-		metadata := new(bytes.Buffer)
-		fmt.Fprintf(metadata, "\n%s: %s\n%s: %s\n%s: \n  %s: %s\n  %s: %s\n%s:\n", "apiVersion", "v1",
-			"kind", "ConfigMap", "metadata", "name", "agent-config", "namespace", *agent.Data.GetAgentDetails.AgentNamespace, "data")
-
-		for k, v := range configData {
-			b := new(bytes.Buffer)
-			if k == "COMPONENTS" {
-				fmt.Fprintf(b, "  %s: |\n    %s", k, v)
-			} else if k == "START_TIME" || k == "IS_CLUSTER_CONFIRMED" {
-				fmt.Fprintf(b, "  %s: \"%s\"\n", k, v)
-			} else {
-				fmt.Fprintf(b, "  %s: %s\n", k, v)
-			}
-			configMapString = configMapString + b.String()
+	return agent.Data.GetAgentDetails, manifest.Data.GetManifest, nil
+}
 
-		}
+func UpgradeAgent(c context.Context, cred types.Credentials, projectID string, clusterID string, kubeconfig string) (string, error) {
+	agent, manifest, err := getAgentManifest(cred, projectID, clusterID)
+	if err != nil {
+		return "", err
+	}
 
-		yamlOutput, err := k8s.ApplyYaml(k8s.ApplyYamlPrams{
-			Token:    cred.Token,
-			Endpoint: cred.Endpoint,
-			YamlPath: "chaos-delegate-manifest.yaml",
-		}, kubeconfig, true)
+	manifestFile, err := writeManifestFile(manifest)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(manifestFile)
 
-		if err != nil {
-			return "", err
+	// Fetching agent-config from the subscriber
+	configData, err := k8s.GetConfigMap(c, "agent-config", *agent.AgentNamespace, &kubeconfig)
+	if err != nil {
+		return "", err
+	}
+	var configMapString string
+
+	metadata := new(bytes.Buffer)
+	fmt.Fprintf(metadata, "\n%s: %s\n%s: %s\n%s: \n  %s: %s\n  %s: %s\n%s:\n", "apiVersion", "v1",
+		"kind", "ConfigMap", "metadata", "name", "agent-config", "namespace", *agent.AgentNamespace, "data")
+
+	for k, v := range configData {
+		b := new(bytes.Buffer)
+		if k == "COMPONENTS" {
+			fmt.Fprintf(b, "  %s: |\n    %s", k, v)
+		} else if k == "START_TIME" || k == "IS_CLUSTER_CONFIRMED" {
+			fmt.Fprintf(b, "  %s: \"%s\"\n", k, v)
+		} else {
+			fmt.Fprintf(b, "  %s: %s\n", k, v)
 		}
-		utils.White.Print("\n", yamlOutput)
+		configMapString = configMapString + b.String()
 
-		err = os.Remove("chaos-delegate-manifest.yaml")
-		if err != nil {
-			return "Error removing Chaos Delegate manifest: ", err
-		}
+	}
 
-		// Creating a backup for current agent-config in the SUBSCRIBER
-		home, err := homedir.Dir()
-		cobra.CheckErr(err)
+	yamlOutput, err := k8s.ApplyYaml(c, k8s.ApplyYamlPrams{
+		Token:    cred.Token,
+		Endpoint: cred.Endpoint,
+		YamlPath: manifestFile,
+	}, kubeconfig, true)
 
-		configMapString = metadata.String() + configMapString
-		err = ioutil.WriteFile(home+"/backupAgentConfig.yaml", []byte(configMapString), 0644)
-		if err != nil {
-			return "Error creating backup for agent config: ", err
-		}
+	if err != nil {
+		return "", err
+	}
+	utils.White.Print("\n", yamlOutput)
 
-		utils.White_B.Print("\n ** A backup of agent-config configmap has been saved in your system's home directory as backupAgentConfig.yaml **\n")
+	// Creating a backup for current agent-config in the SUBSCRIBER
+	home, err := homedir.Dir()
+	cobra.CheckErr(err)
 
-		return "Manifest applied successfully", nil
-	} else {
-		return "GQL error: ", errors.New("Unmatched status code:" + string(bodyBytes))
+	configMapString = metadata.String() + configMapString
+	err = ioutil.WriteFile(home+"/backupAgentConfig.yaml", []byte(configMapString), 0644)
+	if err != nil {
+		return "Error creating backup for agent config: ", err
+	}
+
+	utils.White_B.Print("\n ** A backup of agent-config configmap has been saved in your system's home directory as backupAgentConfig.yaml **\n")
+
+	return "Manifest applied successfully", nil
+}
+
+// DiffAgentUpgrade returns the unified diff of the resources of the Chaos Delegate against the manifest of
+// the upgrade, without applying it. It's empty when the upgrade changes nothing.
+func DiffAgentUpgrade(c context.Context, cred types.Credentials, projectID string, clusterID string, kubeconfig string) (string, error) {
+	_, manifest, err := getAgentManifest(cred, projectID, clusterID)
+	if err != nil {
+		return "", err
+	}
+
+	manifestFile, err := writeManifestFile(manifest)
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(manifestFile)
+
+	return k8s.DiffYaml(c, manifestFile, kubeconfig)
+}
+
+// writeManifestFile writes the manifest to a temporary file, and returns its path
+func writeManifestFile(manifest string) (string, error) {
+	file, err := ioutil.TempFile("", "chaos-delegate-manifest-*.yaml")
+	if err != nil {
+		return "", err
+	}
+
+	_, err = file.Write([]byte(manifest))
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(file.Name())
+		return "", err
 	}
+	return file.Name(), nil
 }
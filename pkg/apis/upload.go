@@ -0,0 +1,96 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+)
+
+// LargeUploadSize is the size of the requests above which the upload progress is reported
+const LargeUploadSize = 1 << 20
+
+// CompressUploads compresses the uploads with gzip, set by the --compress flag of the commands
+// uploading manifests. It's opt-in as the ChaosCenter has to be configured to accept compressed bodies,
+// e.g. by its ingress.
+var CompressUploads bool
+
+// SendUpload sends a request carrying a large payload, like a Chaos Scenario manifest. The payload
+// is streamed from memory with a known length, so that the proxies in front of the ChaosCenter don't
+// have to buffer a chunked body, compressed with --compress, and its progress is shown on stderr.
+func SendUpload(params SendRequestParams, payload []byte, description string) (*http.Response, error) {
+	var encoding string
+	if CompressUploads {
+		var compressed bytes.Buffer
+		writer := gzip.NewWriter(&compressed)
+		if _, err := writer.Write(payload); err != nil {
+			return &http.Response{}, err
+		}
+		if err := writer.Close(); err != nil {
+			return &http.Response{}, err
+		}
+		payload, encoding = compressed.Bytes(), "gzip"
+	}
+
+	req, err := http.NewRequest(http.MethodPost, params.Endpoint, newUploadBody(payload, description))
+	if err != nil {
+		return &http.Response{}, err
+	}
+	// The body is rewound for the retries of doRequest
+	req.GetBody = func() (io.ReadCloser, error) {
+		return newUploadBody(payload, description), nil
+	}
+	req.ContentLength = int64(len(payload))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", params.Token)
+	if encoding != "" {
+		req.Header.Set("Content-Encoding", encoding)
+	}
+
+	resp, err := doRequest(req)
+	if err != nil {
+		return &http.Response{}, err
+	}
+	if strings.HasSuffix(params.Endpoint, utils.GQLAPIPath) && graphQLBlocked(resp) {
+		resp.Body.Close()
+		return &http.Response{}, ErrGraphQLBlocked
+	}
+	if resp.StatusCode == http.StatusRequestEntityTooLarge {
+		resp.Body.Close()
+		message := "the " + description + " of " + utils.HumanizeSize(len(payload)) + " is larger than the ChaosCenter accepts, raise the request body limit of its ingress"
+		if !CompressUploads {
+			message += ", or compress it with --compress if the ChaosCenter accepts compressed requests"
+		}
+		return &http.Response{}, errors.New(message)
+	}
+
+	return resp, nil
+}
+
+// newUploadBody returns the body of the payload, the progress is only reported for the large payloads
+func newUploadBody(payload []byte, description string) io.ReadCloser {
+	if len(payload) < LargeUploadSize {
+		return ioutil.NopCloser(bytes.NewReader(payload))
+	}
+	return ioutil.NopCloser(utils.NewProgressReader(bytes.NewReader(payload), int64(len(payload)), "⏳ Uploading the "+description))
+}
@@ -0,0 +1,94 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package apis
+
+import (
+	"strings"
+
+	"github.com/litmuschaos/litmusctl/pkg/types"
+)
+
+type createUserPayload struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+	Name     string `json:"name,omitempty"`
+	Role     string `json:"role"`
+}
+
+type createdUser struct {
+	ID       string `json:"_id"`
+	UserID   string `json:"userID"`
+	Username string `json:"username"`
+	Name     string `json:"name"`
+}
+
+// CreateUser creates a user account of ChaosCenter with the user role, only the admin is allowed to do so
+func CreateUser(username string, password string, name string, cred types.Credentials) (User, error) {
+	var data createdUser
+	err := sendAuthServerRequest("/create_user", types.Post, createUserPayload{
+		Username: username,
+		Password: password,
+		Name:     name,
+		Role:     "user",
+	}, &data, cred)
+	if err != nil {
+		return User{}, err
+	}
+
+	// ChaosCenter 3.x names the ID of the user userID
+	user := User{ID: data.ID, Username: data.Username, Name: data.Name}
+	if user.ID == "" {
+		user.ID = data.UserID
+	}
+	return user, nil
+}
+
+type userStatePayload struct {
+	Username     string `json:"username"`
+	IsDeactivate bool   `json:"is_deactivate"`
+}
+
+// DeactivateUser deactivates the user account, it can't log in anymore
+func DeactivateUser(username string, cred types.Credentials) (authServerResponse, error) {
+	var data authServerResponse
+	err := sendAuthServerRequest("/update/state", types.Post, userStatePayload{
+		Username:     username,
+		IsDeactivate: true,
+	}, &data, cred)
+	return data, err
+}
+
+// ServiceAccountPrefix is the prefix of the usernames of the service accounts, the users created
+// for automations like CI systems, which authenticate with API tokens only
+const ServiceAccountPrefix = "sa-"
+
+// ServiceAccountUsername returns the username of the service account, with or without its prefix
+func ServiceAccountUsername(name string) string {
+	if strings.HasPrefix(name, ServiceAccountPrefix) {
+		return name
+	}
+	return ServiceAccountPrefix + name
+}
+
+// IsServiceAccount returns whether the username is the one of a service account
+func IsServiceAccount(username string) bool {
+	return strings.HasPrefix(username, ServiceAccountPrefix)
+}
+
+// GetServiceAccount returns the service account with the given name among the members of the project
+func GetServiceAccount(projectID string, name string, cred types.Credentials) (Member, error) {
+	return GetProjectMember(projectID, ServiceAccountUsername(name), cred)
+}
@@ -16,12 +16,14 @@ limitations under the License.
 package apis
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"io/ioutil"
 	"net/http"
 
 	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	"github.com/litmuschaos/litmusctl/pkg/apis/gql"
 	types "github.com/litmuschaos/litmusctl/pkg/types"
 	"github.com/litmuschaos/litmusctl/pkg/utils"
 )
@@ -66,13 +68,13 @@ func CreateWorkflow(requestData model.ChaosWorkFlowRequest, cred types.Credentia
 		return ChaosWorkflowCreationData{}, err
 	}
 
-	resp, err := SendRequest(
+	resp, err := SendUpload(
 		SendRequestParams{
 			Endpoint: cred.Endpoint + utils.GQLAPIPath,
 			Token:    cred.Token,
 		},
 		query,
-		string(types.Post),
+		"Chaos Scenario manifest",
 	)
 	if err != nil {
 		return ChaosWorkflowCreationData{}, err
@@ -117,84 +119,29 @@ type WorkflowList struct {
 	ListWorkflowDetails model.ListWorkflowsResponse `json:"listWorkflows"`
 }
 
-type GetChaosWorkFlowsGraphQLRequest struct {
-	Query     string `json:"query"`
-	Variables struct {
-		GetChaosWorkFlowsRequest model.ListWorkflowsRequest `json:"request"`
-	} `json:"variables"`
-}
-
 // GetWorkflowList sends GraphQL API request for fetching a list of workflows.
 func GetWorkflowList(in model.ListWorkflowsRequest, cred types.Credentials) (WorkflowListData, error) {
+	return GetWorkflowListByTags(in, nil, cred)
+}
 
-	var gqlReq GetChaosWorkFlowsGraphQLRequest
-	var err error
-
-	gqlReq.Query = `query listWorkflows($request: ListWorkflowsRequest!) {
-                      listWorkflows(request: $request) {
-                        totalNoOfWorkflows
-                        workflows {
-                          workflowID
-                          workflowManifest
-                          cronSyntax
-                          clusterName
-                          workflowName
-                          workflowDescription
-                          weightages {
-                            experimentName
-                            weightage
-                          }
-                          isCustomWorkflow
-                          updatedAt
-                          createdAt
-                          projectID
-                          clusterID
-                          clusterType
-                          isRemoved
-                          lastUpdatedBy
-                        }
-                      }
-                    }`
-	gqlReq.Variables.GetChaosWorkFlowsRequest = in
-
-	query, err := json.Marshal(gqlReq)
-	if err != nil {
-		return WorkflowListData{}, err
+// GetWorkflowListByTags fetches the list of workflows carrying all the given tags, the tags are
+// filtered by the server along with the filter of the request
+func GetWorkflowListByTags(in model.ListWorkflowsRequest, tags []string, cred types.Credentials) (WorkflowListData, error) {
+	if DetectSchema(cred.Endpoint) == SchemaV3 {
+		return getWorkflowListV3(in, tags, cred)
 	}
-
-	resp, err := SendRequest(
-		SendRequestParams{
-			Endpoint: cred.Endpoint + utils.GQLAPIPath,
-			Token:    cred.Token,
-		},
-		query,
-		string(types.Post),
-	)
-	if err != nil {
-		return WorkflowListData{}, err
+	if len(tags) > 0 {
+		return WorkflowListData{}, errTagsV2
 	}
 
-	bodyBytes, err := ioutil.ReadAll(resp.Body)
-	defer resp.Body.Close()
+	resp, err := gql.ListWorkflows(context.Background(), graphQLClient(cred.Endpoint, cred.Token), in)
 	if err != nil {
-		return WorkflowListData{}, err
+		return WorkflowListData{}, graphQLError(err)
 	}
 
-	if resp.StatusCode == http.StatusOK {
-		var workflowList WorkflowListData
-		err = json.Unmarshal(bodyBytes, &workflowList)
-		if err != nil {
-			return WorkflowListData{}, err
-		}
-
-		if len(workflowList.Errors) > 0 {
-			return WorkflowListData{}, errors.New(workflowList.Errors[0].Message)
-		}
-
-		return workflowList, nil
-	} else {
-		return WorkflowListData{}, errors.New("Error while fetching the Chaos Scenarios")
-	}
+	var workflowList WorkflowListData
+	workflowList.Data.ListWorkflowDetails = resp.ListWorkflows
+	return workflowList, nil
 }
 
 type WorkflowRunsListData struct {
@@ -209,84 +156,20 @@ type WorkflowRunsList struct {
 	ListWorkflowRunsDetails model.ListWorkflowRunsResponse `json:"listWorkflowRuns"`
 }
 
-type GetChaosWorkFlowRunsGraphQLRequest struct {
-	Query     string `json:"query"`
-	Variables struct {
-		GetChaosWorkFlowRunsRequest model.ListWorkflowRunsRequest `json:"request"`
-	} `json:"variables"`
-}
-
 // GetWorkflowRunsList sends GraphQL API request for fetching a list of workflow runs.
 func GetWorkflowRunsList(in model.ListWorkflowRunsRequest, cred types.Credentials) (WorkflowRunsListData, error) {
-
-	var gqlReq GetChaosWorkFlowRunsGraphQLRequest
-	var err error
-
-	gqlReq.Query = `query listWorkflowRuns($request: ListWorkflowRunsRequest!) {
-                      listWorkflowRuns(request: $request) {
-                        totalNoOfWorkflowRuns
-                        workflowRuns {
-                          workflowRunID
-                          workflowID
-                          clusterName
-                          workflowName
-                          projectID
-                          clusterID
-                          clusterType
-                          isRemoved
-                          lastUpdated
-                          phase
-                          resiliencyScore
-                          experimentsPassed
-                          experimentsFailed
-                          experimentsAwaited
-                          experimentsStopped
-                          experimentsNa
-                          totalExperiments
-                          executedBy
-                        }
-                      }
-                    }`
-	gqlReq.Variables.GetChaosWorkFlowRunsRequest = in
-
-	query, err := json.Marshal(gqlReq)
-	if err != nil {
-		return WorkflowRunsListData{}, err
+	if DetectSchema(cred.Endpoint) == SchemaV3 {
+		return getWorkflowRunsListV3(in, cred)
 	}
 
-	resp, err := SendRequest(
-		SendRequestParams{
-			Endpoint: cred.Endpoint + utils.GQLAPIPath,
-			Token:    cred.Token,
-		},
-		query,
-		string(types.Post),
-	)
+	resp, err := gql.ListWorkflowRuns(context.Background(), graphQLClient(cred.Endpoint, cred.Token), in)
 	if err != nil {
-		return WorkflowRunsListData{}, err
+		return WorkflowRunsListData{}, graphQLError(err)
 	}
 
-	bodyBytes, err := ioutil.ReadAll(resp.Body)
-	defer resp.Body.Close()
-	if err != nil {
-		return WorkflowRunsListData{}, err
-	}
-
-	if resp.StatusCode == http.StatusOK {
-		var workflowRunsList WorkflowRunsListData
-		err = json.Unmarshal(bodyBytes, &workflowRunsList)
-		if err != nil {
-			return WorkflowRunsListData{}, err
-		}
-
-		if len(workflowRunsList.Errors) > 0 {
-			return WorkflowRunsListData{}, errors.New(workflowRunsList.Errors[0].Message)
-		}
-
-		return workflowRunsList, nil
-	} else {
-		return WorkflowRunsListData{}, errors.New("Error while fetching the Chaos Scenario runs")
-	}
+	var workflowRunsList WorkflowRunsListData
+	workflowRunsList.Data.ListWorkflowRunsDetails = resp.ListWorkflowRuns
+	return workflowRunsList, nil
 }
 
 type DeleteChaosWorkflowData struct {
@@ -301,71 +184,48 @@ type DeleteChaosWorkflowDetails struct {
 	IsDeleted bool `json:"deleteChaosWorkflow"`
 }
 
-type DeleteChaosWorkflowGraphQLRequest struct {
-	Query     string `json:"query"`
-	Variables struct {
-		ProjectID     string  `json:"projectID"`
-		WorkflowID    *string `json:"workflowID"`
-		WorkflowRunID *string `json:"workflowRunID"`
-	} `json:"variables"`
-}
-
 // DeleteChaosWorkflow sends GraphQL API request for deleting a given Chaos Workflow.
 func DeleteChaosWorkflow(projectID string, workflowID *string, cred types.Credentials) (DeleteChaosWorkflowData, error) {
-
-	var gqlReq DeleteChaosWorkflowGraphQLRequest
-	var err error
-
-	gqlReq.Query = `mutation deleteChaosWorkflow($projectID: String!, $workflowID: String, $workflowRunID: String) {
-                      deleteChaosWorkflow(
-                        projectID: $projectID
-                        workflowID: $workflowID
-                        workflowRunID: $workflowRunID
-                      )
-                    }`
-	gqlReq.Variables.ProjectID = projectID
-	gqlReq.Variables.WorkflowID = workflowID
-	var workflow_run_id string = ""
-	gqlReq.Variables.WorkflowRunID = &workflow_run_id
-
-	query, err := json.Marshal(gqlReq)
-	if err != nil {
-		return DeleteChaosWorkflowData{}, err
+	if DetectSchema(cred.Endpoint) == SchemaV3 {
+		return deleteChaosWorkflowV3(projectID, workflowID, cred)
 	}
 
-	resp, err := SendRequest(
-		SendRequestParams{
-			Endpoint: cred.Endpoint + utils.GQLAPIPath,
-			Token:    cred.Token,
-		},
-		query,
-		string(types.Post),
-	)
+	resp, err := gql.DeleteChaosWorkflow(context.Background(), graphQLClient(cred.Endpoint, cred.Token), projectID, workflowID, "")
 	if err != nil {
-		return DeleteChaosWorkflowData{}, err
+		return DeleteChaosWorkflowData{}, graphQLError(err)
 	}
 
-	bodyBytes, err := ioutil.ReadAll(resp.Body)
-	defer resp.Body.Close()
-	if err != nil {
-		return DeleteChaosWorkflowData{}, err
+	var deletedWorkflow DeleteChaosWorkflowData
+	deletedWorkflow.Data.IsDeleted = resp.DeleteChaosWorkflow
+	return deletedWorkflow, nil
+}
+
+// ReRunWorkflow starts a new run of the Chaos Workflow
+func ReRunWorkflow(projectID string, workflowID string, cred types.Credentials) error {
+	if DetectSchema(cred.Endpoint) == SchemaV3 {
+		return reRunWorkflowV3(projectID, workflowID, cred)
 	}
 
-	if resp.StatusCode == http.StatusOK {
-		var deletedWorkflow DeleteChaosWorkflowData
-		err = json.Unmarshal(bodyBytes, &deletedWorkflow)
-		if err != nil {
-			return DeleteChaosWorkflowData{}, err
-		}
+	var data struct {
+		ReRunChaosWorkFlow string `json:"reRunChaosWorkFlow"`
+	}
+	return sendGraphQLRequest(`mutation reRunChaosWorkFlow($projectID: String!, $workflowID: String!) {
+                      reRunChaosWorkFlow(projectID: $projectID, workflowID: $workflowID)
+                    }`, map[string]interface{}{"projectID": projectID, "workflowID": workflowID}, &data, cred)
+}
 
-		if len(deletedWorkflow.Errors) > 0 {
-			return DeleteChaosWorkflowData{}, errors.New(deletedWorkflow.Errors[0].Message)
-		}
+// TerminateWorkflowRun stops the run of the Chaos Workflow, and removes it from the Chaos Delegate
+func TerminateWorkflowRun(projectID string, workflowID string, workflowRunID string, cred types.Credentials) error {
+	if DetectSchema(cred.Endpoint) == SchemaV3 {
+		return terminateWorkflowRunV3(projectID, workflowID, workflowRunID, cred)
+	}
 
-		return deletedWorkflow, nil
-	} else {
-		return DeleteChaosWorkflowData{}, errors.New("Error while deleting the Chaos Scenario")
+	var data struct {
+		TerminateChaosWorkflow bool `json:"terminateChaosWorkflow"`
 	}
+	return sendGraphQLRequest(`mutation terminateChaosWorkflow($projectID: String!, $workflowID: String, $workflowRunID: String) {
+                      terminateChaosWorkflow(projectID: $projectID, workflowID: $workflowID, workflowRunID: $workflowRunID)
+                    }`, map[string]interface{}{"projectID": projectID, "workflowID": workflowID, "workflowRunID": workflowRunID}, &data, cred)
 }
 
 type ServerVersionResponse struct {
@@ -387,33 +247,12 @@ type GetServerVersionData struct {
 
 // GetServerVersion fetches the GQL server version
 func GetServerVersion(endpoint string) (ServerVersionResponse, error) {
-	query := `{"query":"query{\n getServerVersion{\n key value\n }\n}"}`
-	resp, err := SendRequest(
-		SendRequestParams{
-			Endpoint: endpoint + utils.GQLAPIPath,
-		},
-		[]byte(query),
-		string(types.Post),
-	)
-	if err != nil {
-		return ServerVersionResponse{}, err
-	}
-	bodyBytes, err := ioutil.ReadAll(resp.Body)
-	defer resp.Body.Close()
+	resp, err := gql.GetServerVersion(context.Background(), graphQLClient(endpoint, ""))
 	if err != nil {
-		return ServerVersionResponse{}, err
-	}
-	if resp.StatusCode == http.StatusOK {
-		var version ServerVersionResponse
-		err = json.Unmarshal(bodyBytes, &version)
-		if err != nil {
-			return ServerVersionResponse{}, err
-		}
-		if len(version.Errors) > 0 {
-			return ServerVersionResponse{}, errors.New(version.Errors[0].Message)
-		}
-		return version, nil
-	} else {
-		return ServerVersionResponse{}, errors.New(resp.Status)
+		return ServerVersionResponse{}, graphQLError(err)
 	}
+
+	var version ServerVersionResponse
+	version.Data.GetServerVersion = GetServerVersionData{Key: resp.GetServerVersion.Key, Value: resp.GetServerVersion.Value}
+	return version, nil
 }
@@ -0,0 +1,150 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// ApiCmd represents the api command
+var ApiCmd = &cobra.Command{
+	Use: "api",
+	Short: `Execute an arbitrary GraphQL operation against the ChaosCenter and print the raw response.
+		Examples:
+		#run a query read from a file, with its variables read from another one
+		litmusctl api --query-file q.graphql --vars vars.json
+
+		#run an inline query
+		litmusctl api --query '{ getServerVersion { key value } }'
+
+		#pick one of the operations of the file
+		litmusctl api --query-file operations.graphql --operation-name listWorkflows --vars vars.json
+
+		#read the query from the standard input
+		cat q.graphql | litmusctl api --query-file -
+
+		Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		// Fetch user credentials
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		var request apis.RawGraphQLRequest
+		request.Query, err = readQuery(cmd)
+		utils.PrintError(err)
+
+		request.OperationName, err = cmd.Flags().GetString("operation-name")
+		utils.PrintError(err)
+
+		request.Variables, err = readVariables(cmd)
+		utils.PrintError(err)
+
+		response, err := apis.SendRawGraphQLRequest(request, credentials)
+		utils.PrintError(err)
+
+		// The response is printed as is, only indented when it's JSON
+		var indented bytes.Buffer
+		if json.Indent(&indented, bytes.TrimSpace(response.Body), "", "  ") == nil {
+			fmt.Println(indented.String())
+		} else {
+			fmt.Println(string(response.Body))
+		}
+
+		switch {
+		case response.StatusCode < 200 || response.StatusCode > 299:
+			utils.Red.Fprintln(os.Stderr, "\n❌ The operation failed with status "+strconv.Itoa(response.StatusCode))
+			os.Exit(1)
+		case response.HasErrors():
+			utils.Red.Fprintln(os.Stderr, "\n❌ The operation returned errors")
+			os.Exit(1)
+		}
+	},
+}
+
+// readQuery reads the GraphQL operation from --query or --query-file, "-" reading it from the standard input
+func readQuery(cmd *cobra.Command) (string, error) {
+	query, err := cmd.Flags().GetString("query")
+	if err != nil {
+		return "", err
+	}
+	queryFile, err := cmd.Flags().GetString("query-file")
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case query != "" && queryFile != "":
+		return "", errors.New("only one of --query and --query-file can be set")
+	case query != "":
+		return query, nil
+	case queryFile == "":
+		return "", errors.New("either --query or --query-file is required")
+	}
+
+	var data []byte
+	if queryFile == "-" {
+		data, err = ioutil.ReadAll(os.Stdin)
+	} else {
+		data, err = ioutil.ReadFile(queryFile)
+	}
+	if err != nil {
+		return "", err
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return "", errors.New("the query is empty")
+	}
+	return string(data), nil
+}
+
+// readVariables reads the variables of the operation from the JSON file set by --vars
+func readVariables(cmd *cobra.Command) (map[string]interface{}, error) {
+	varsFile, err := cmd.Flags().GetString("vars")
+	if err != nil || varsFile == "" {
+		return nil, err
+	}
+
+	data, err := ioutil.ReadFile(varsFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var variables map[string]interface{}
+	if err := json.Unmarshal(data, &variables); err != nil {
+		return nil, errors.New("invalid variables in " + varsFile + ", they should be a JSON object: " + err.Error())
+	}
+	return variables, nil
+}
+
+func init() {
+	ApiCmd.Flags().StringP("query-file", "f", "", "Set the path of the file containing the GraphQL operation, - reads it from the standard input")
+	ApiCmd.Flags().String("query", "", "Set the GraphQL operation inline")
+	ApiCmd.Flags().String("vars", "", "Set the path of the JSON file containing the variables of the operation")
+	ApiCmd.Flags().String("operation-name", "", "Set the operation to execute when the document contains several of them")
+}
@@ -0,0 +1,71 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package api
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// schemaCmd represents the api schema command
+var schemaCmd = &cobra.Command{
+	Use: "schema",
+	Short: `Export the GraphQL schema of the ChaosCenter in the schema definition language.
+		Examples:
+		#write the schema to a file
+		litmusctl api schema -o schema.graphql
+
+		#compare the schema with the one exported before an upgrade of the ChaosCenter
+		litmusctl api schema | diff schema.graphql -
+
+		Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		// Fetch user credentials
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		sdl, err := apis.GetSchemaSDL(credentials)
+		utils.PrintError(err)
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		if output == "" || output == "-" {
+			fmt.Print(sdl)
+			return
+		}
+
+		err = ioutil.WriteFile(output, []byte(sdl), 0644)
+		utils.PrintError(err)
+		utils.White_B.Println("🚀 GraphQL schema written to " + output)
+	},
+}
+
+func init() {
+	ApiCmd.AddCommand(schemaCmd)
+
+	schemaCmd.Flags().StringP("output", "o", "", "Set the path of the file to write the schema to, it's printed to the standard output by default")
+	// The output format stored in the account defaults isn't a path
+	utils.PrintError(schemaCmd.Flags().SetAnnotation("output", utils.NoAccountDefaultsAnnotation, []string{"true"}))
+}
@@ -0,0 +1,87 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package auth
+
+import (
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// revokeCmd represents the revoke command
+var revokeCmd = &cobra.Command{
+	Use: "revoke <token-id>",
+	Short: `Revoke a session of your account
+	Example:
+	#revoke a leaked API token
+	litmusctl auth revoke 9fK2xQ1a
+
+	#revoke it without confirmation, e.g. from automation
+	litmusctl auth revoke 9fK2xQ1a --yes
+
+	Note: To see the sessions and their token IDs, apply litmusctl auth sessions
+	Revoking the current login session logs litmusctl out, log in again with litmusctl config set-account.
+	The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		sessions, err := listSessions(credentials)
+		utils.PrintError(err)
+
+		var session *Session
+		for i := range sessions {
+			if sessions[i].ID == args[0] {
+				session = &sessions[i]
+			}
+		}
+		if session == nil {
+			utils.Red.Println("⛔ No session with the token ID " + args[0] + ". To see the sessions, apply litmusctl auth sessions")
+			os.Exit(1)
+		}
+
+		affected := "Session/" + session.ID + " (" + session.Kind + " " + session.Name + ")"
+		if session.Current {
+			affected += ", the session of litmusctl, which is logged out"
+		}
+		utils.ConfirmDestructive(cmd, "revoked", []string{affected})
+
+		if session.Kind == LoginSession {
+			_, err = apis.RevokeSession(credentials)
+		} else {
+			_, err = apis.RevokeAPIToken(session.userID, session.token, credentials)
+		}
+		if err != nil {
+			utils.Red.Println("\n❌ Error in revoking the session: " + err.Error())
+			os.Exit(1)
+		}
+
+		utils.White_B.Println("\n🚀 Session " + session.ID + " (" + session.Kind + " " + session.Name + ") successfully revoked.")
+		if session.Current {
+			utils.White_B.Println("\nIt was the session of litmusctl, log in again with litmusctl config set-account.")
+		}
+	},
+}
+
+func init() {
+	AuthCmd.AddCommand(revokeCmd)
+
+	utils.AddYesFlag(revokeCmd)
+}
@@ -0,0 +1,142 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package auth
+
+import (
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/config"
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+const (
+	// LoginSession is the kind of the session of litmusctl, logged in with a password
+	LoginSession = "login"
+
+	// APITokenSession is the kind of the API tokens issued to the account
+	APITokenSession = "api-token"
+)
+
+// Session is a token of the account, as listed, without the token itself
+type Session struct {
+	ID        string    `json:"id"`
+	Kind      string    `json:"kind"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Current   bool      `json:"current"`
+
+	token  string
+	userID string
+}
+
+// sessionsCmd represents the sessions command
+var sessionsCmd = &cobra.Command{
+	Use:   "sessions",
+	Short: "Display the active sessions of your account",
+	Long: `Display the active sessions of your account: the API tokens issued to it, and the login session of litmusctl.
+The sessions are identified by the last characters of their token, which is not printed. Login sessions opened elsewhere, e.g. in the browser, aren't tracked by ChaosCenter and aren't listed.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		sessions, err := listSessions(credentials)
+		utils.PrintError(err)
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(sessions)
+
+		case "yaml":
+			utils.PrintInYamlFormat(sessions)
+
+		case "":
+			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+			utils.White_B.Fprintln(writer, "TOKEN ID\tKIND\tNAME\tCREATED AT\tEXPIRES AT")
+			for _, session := range sessions {
+				createdAt, expiresAt := "-", "-"
+				if !session.CreatedAt.IsZero() {
+					createdAt = session.CreatedAt.String()
+				}
+				if !session.ExpiresAt.IsZero() {
+					expiresAt = session.ExpiresAt.String()
+				}
+				name := session.Name
+				if session.Current {
+					name += " (current)"
+				}
+
+				utils.White.Fprintln(writer, session.ID+"\t"+session.Kind+"\t"+name+"\t"+createdAt+"\t"+expiresAt)
+			}
+			writer.Flush()
+		}
+	},
+}
+
+// listSessions returns the login session of the credentials, followed by the unexpired API tokens of the account
+func listSessions(credentials types.Credentials) ([]Session, error) {
+	current := apis.APIToken{Token: credentials.Token}
+	session := Session{ID: current.ID(), Kind: LoginSession, Name: credentials.Username, Current: true, token: credentials.Token}
+	if issuedAt, err := config.JWTIssuedAt(credentials.Token); err == nil {
+		session.CreatedAt = issuedAt
+	}
+	if expiresAt, err := config.JWTExpiry(credentials.Token); err == nil {
+		session.ExpiresAt = expiresAt
+	}
+
+	userDetails, err := apis.GetProjectDetails(credentials)
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := apis.ListAPITokens(userDetails.Data.ID, credentials)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := []Session{session}
+	for _, token := range tokens {
+		expiresAt := token.ExpiresAt.Time()
+		if !expiresAt.IsZero() && expiresAt.Before(time.Now()) {
+			continue
+		}
+		sessions = append(sessions, Session{
+			ID:        token.ID(),
+			Kind:      APITokenSession,
+			Name:      token.Name,
+			CreatedAt: token.CreatedAt.Time(),
+			ExpiresAt: expiresAt,
+			// litmusctl may be running with an API token
+			Current: token.Token == credentials.Token,
+			token:   token.Token,
+			userID:  userDetails.Data.ID,
+		})
+	}
+	return sessions, nil
+}
+
+func init() {
+	AuthCmd.AddCommand(sessionsCmd)
+
+	sessionsCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
@@ -0,0 +1,142 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package check
+
+import (
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/config"
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// endpointCmd represents the check endpoint command
+var endpointCmd = &cobra.Command{
+	Use:   "endpoint",
+	Short: "Check the reachability of the auth and GraphQL servers of the ChaosCenter",
+	Long: `Check the reachability of the auth and GraphQL servers of the ChaosCenter, and report the TLS validity, latency and server version.
+Failures are reported as DNS, Connection, Timeout, TLS, Auth or HTTP failures. The command exits with 1 when a check fails.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		account, err := cmd.Flags().GetString("account")
+		utils.PrintError(err)
+
+		timeout, err := cmd.Flags().GetDuration("timeout")
+		utils.PrintError(err)
+
+		credentials, err := accountCredentials(cmd, strings.TrimRight(account, "/"))
+		utils.PrintError(err)
+
+		health := apis.CheckEndpoint(credentials, timeout)
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(health)
+
+		case "yaml":
+			utils.PrintInYamlFormat(health)
+
+		case "":
+			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+			utils.White_B.Fprintln(writer, "CHECK\tURL\tSTATUS\tLATENCY\tTLS EXPIRY\tDETAILS")
+			for _, check := range health.Checks {
+				status := "OK"
+				if check.Skipped {
+					status = "SKIPPED"
+				} else if !check.Healthy {
+					status = string(check.Failure) + " failure"
+				}
+				var tlsExpiry string
+				if check.TLSExpiry != nil {
+					tlsExpiry = check.TLSExpiry.Format("2006-01-02")
+				}
+				utils.White.Fprintln(writer, check.Name+"\t"+check.URL+"\t"+status+"\t"+check.Latency+"\t"+tlsExpiry+"\t"+check.Message)
+			}
+			writer.Flush()
+
+			if !health.Healthy {
+				utils.Red.Println("\n❌ The ChaosCenter at " + health.Endpoint + " is not healthy")
+				os.Exit(1)
+			}
+			utils.White_B.Println("\n✅ The ChaosCenter at " + health.Endpoint + " is healthy")
+		}
+
+		if !health.Healthy {
+			os.Exit(1)
+		}
+	},
+}
+
+// accountCredentials returns the credentials of the given account, the current account when it's
+// empty. Endpoints which are not in the config file are checked without credentials.
+func accountCredentials(cmd *cobra.Command, account string) (types.Credentials, error) {
+	if account == "" {
+		return utils.GetCredentials(cmd)
+	}
+
+	configFilePath := utils.GetLitmusConfigPath(cmd)
+	if !config.FileExists(configFilePath) {
+		return types.Credentials{Endpoint: account}, nil
+	}
+
+	obj, err := config.YamltoObject(configFilePath)
+	if err != nil {
+		return types.Credentials{}, err
+	}
+
+	for _, act := range obj.Accounts {
+		if act.Endpoint != account || len(act.Users) == 0 {
+			continue
+		}
+
+		// Prefer the current user, when the account is used by several users
+		user := act.Users[0]
+		for _, u := range act.Users {
+			if u.Username == obj.CurrentUser {
+				user = u
+			}
+		}
+
+		token := user.Token
+		if config.IsEncrypted(token) {
+			passphrase, err := utils.ConfigPassphrase()
+			if err != nil {
+				return types.Credentials{}, err
+			}
+			if token, err = config.DecryptToken(token, passphrase); err != nil {
+				return types.Credentials{}, err
+			}
+		}
+		return types.Credentials{Username: user.Username, Token: token, Endpoint: account}, nil
+	}
+
+	return types.Credentials{Endpoint: account}, nil
+}
+
+func init() {
+	CheckCmd.AddCommand(endpointCmd)
+
+	endpointCmd.Flags().String("account", "", "Set the endpoint of the account to check, the current account by default")
+	endpointCmd.Flags().Duration("timeout", 10*time.Second, "Set the timeout of each check")
+	endpointCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
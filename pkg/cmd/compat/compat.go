@@ -0,0 +1,35 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package compat
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// CompatCmd represents the compat command
+var CompatCmd = &cobra.Command{
+	Use: "compat",
+	Short: `Plan the upgrades of litmusctl, the ChaosCenter and the Chaos Delegates with the compatibility matrix.
+		Examples:
+		#plan the upgrade of the ChaosCenter of the current account to 3.1.0
+		litmusctl compat plan --target-chaoscenter 3.1.0
+
+		#include the Chaos Delegates of a project in the plan
+		litmusctl compat plan --target-chaoscenter 3.1.0 --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+		Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+}
@@ -0,0 +1,211 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package compat
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// upgradeStep is a single upgrade of the plan, the steps have to be done in order
+type upgradeStep struct {
+	Step      int    `json:"step"`
+	Component string `json:"component"`
+	Name      string `json:"name,omitempty"`
+	From      string `json:"from"`
+	To        string `json:"to"`
+	How       string `json:"how"`
+}
+
+// upgradePlan is the ordered list of upgrades needed to reach the target ChaosCenter version
+type upgradePlan struct {
+	Endpoint      string        `json:"endpoint"`
+	CLIVersion    string        `json:"cliVersion"`
+	ServerVersion string        `json:"serverVersion"`
+	Target        string        `json:"target"`
+	Steps         []upgradeStep `json:"steps"`
+	Notes         []string      `json:"notes,omitempty"`
+}
+
+// planCmd represents the compat plan command
+var planCmd = &cobra.Command{
+	Use:   "plan",
+	Short: "Plan the upgrades needed to move the ChaosCenter to a target version",
+	Long: `Plan the upgrades needed to move the ChaosCenter of the current account to a target version.
+The compatibility matrix decides whether litmusctl has to be upgraded before or after the ChaosCenter,
+and the Chaos Delegates of the project passed with --project-id are upgraded last.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		target, err := cmd.Flags().GetString("target-chaoscenter")
+		utils.PrintError(err)
+		if target == "" {
+			utils.Red.Println("⛔ --target-chaoscenter flag is empty")
+			os.Exit(1)
+		}
+		if _, err := utils.ParseVersion(target); err != nil {
+			utils.Red.Println("⛔ Invalid --target-chaoscenter version " + target + ": " + err.Error())
+			os.Exit(1)
+		}
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		serverResp, err := apis.GetServerVersion(credentials.Endpoint)
+		utils.PrintError(err)
+
+		plan := upgradePlan{
+			Endpoint:      credentials.Endpoint,
+			CLIVersion:    os.Getenv("CLIVersion"),
+			ServerVersion: serverResp.Data.GetServerVersion.Value,
+			Target:        target,
+		}
+
+		var delegates []apis.AgentDetails
+		if projectID != "" {
+			agents, err := apis.GetAgentList(credentials, projectID)
+			utils.PrintError(err)
+			delegates = agents.Data.GetAgent
+		} else {
+			plan.Notes = append(plan.Notes, "Pass --project-id to include the Chaos Delegates of a project in the plan")
+		}
+
+		utils.PrintError(planUpgrade(&plan, projectID, delegates))
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(plan)
+
+		case "yaml":
+			utils.PrintInYamlFormat(plan)
+
+		case "":
+			if len(plan.Steps) == 0 {
+				utils.White_B.Println("✅ Nothing to upgrade, the ChaosCenter at " + plan.Endpoint + " is already at " + plan.Target)
+			} else {
+				writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+				utils.White_B.Fprintln(writer, "STEP\tCOMPONENT\tFROM\tTO\tHOW")
+				for _, step := range plan.Steps {
+					component := step.Component
+					if step.Name != "" {
+						component += " " + step.Name
+					}
+					utils.White.Fprintln(writer, strconv.Itoa(step.Step)+"\t"+component+"\t"+step.From+"\t"+step.To+"\t"+step.How)
+				}
+				writer.Flush()
+			}
+			for _, note := range plan.Notes {
+				utils.White.Println("\nNote: " + note)
+			}
+		}
+	},
+}
+
+// planUpgrade adds the upgrade steps to the plan. litmusctl is upgraded first when a release supports
+// both the current and the target ChaosCenter versions, otherwise right after the ChaosCenter, so that
+// it can upgrade the Chaos Delegates.
+func planUpgrade(plan *upgradePlan, projectID string, delegates []apis.AgentDetails) error {
+	targetCLIVersions := utils.CompatibleCLIVersions(plan.Target)
+	if len(targetCLIVersions) == 0 {
+		return errors.New("ChaosCenter " + plan.Target + " is not supported by any litmusctl release in the compatibility matrix")
+	}
+	latestCLIVersion := targetCLIVersions[len(targetCLIVersions)-1]
+
+	cliStep := upgradeStep{Component: "litmusctl", From: plan.CLIVersion}
+	upgradeCLI := plan.CLIVersion != "" && !utils.IsCompatible(plan.CLIVersion, plan.Target)
+	if plan.CLIVersion == "" {
+		plan.Notes = append(plan.Notes, "litmusctl is a development build, install a release matching "+latestCLIVersion+" to work with ChaosCenter "+plan.Target)
+	}
+	cliFirst := false
+	if upgradeCLI {
+		cliStep.To, cliStep.How = latestCLIVersion, "Install a litmusctl release matching "+latestCLIVersion
+		// A release supporting both ChaosCenter versions can be used throughout the upgrade
+		for _, current := range utils.CompatibleCLIVersions(plan.ServerVersion) {
+			for _, cliVersion := range targetCLIVersions {
+				if current == cliVersion {
+					cliStep.To, cliStep.How = cliVersion, "Install a litmusctl release matching "+cliVersion
+					cliFirst = true
+				}
+			}
+		}
+	}
+
+	var steps []upgradeStep
+	if upgradeCLI && cliFirst {
+		steps = append(steps, cliStep)
+	}
+	if !sameVersion(plan.ServerVersion, plan.Target) {
+		steps = append(steps, upgradeStep{
+			Component: "ChaosCenter",
+			From:      plan.ServerVersion,
+			To:        plan.Target,
+			How:       "Upgrade the ChaosCenter with its Helm chart or manifests of " + plan.Target,
+		})
+	}
+	if upgradeCLI && !cliFirst {
+		steps = append(steps, cliStep)
+	}
+
+	for _, delegate := range delegates {
+		if !delegate.IsRegistered || sameVersion(delegate.Version, plan.Target) {
+			continue
+		}
+		steps = append(steps, upgradeStep{
+			Component: "Chaos Delegate",
+			Name:      delegate.AgentName,
+			From:      delegate.Version,
+			To:        plan.Target,
+			How:       "litmusctl upgrade chaos-delegate --project-id=" + projectID + " --chaos-delegate-id=" + delegate.ClusterID,
+		})
+	}
+
+	for i := range steps {
+		steps[i].Step = i + 1
+	}
+	plan.Steps = steps
+	return nil
+}
+
+// sameVersion returns whether both versions are the same, ignoring the formatting differences
+func sameVersion(a string, b string) bool {
+	va, err := utils.ParseVersion(a)
+	if err != nil {
+		return a == b
+	}
+	vb, err := utils.ParseVersion(b)
+	if err != nil {
+		return false
+	}
+	return !va.LessThan(vb) && !vb.LessThan(va)
+}
+
+func init() {
+	CompatCmd.AddCommand(planCmd)
+
+	planCmd.Flags().String("target-chaoscenter", "", "Set the ChaosCenter version to upgrade to, e.g. 3.1.0")
+	planCmd.Flags().String("project-id", "", "Set the ID of the project whose Chaos Delegates are included in the plan")
+	planCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
@@ -0,0 +1,167 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/config"
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// credentialAudit describes a single credential stored in the litmusconfig
+type credentialAudit struct {
+	Endpoint  string     `json:"endpoint" yaml:"endpoint"`
+	Username  string     `json:"username" yaml:"username"`
+	Current   bool       `json:"current" yaml:"current"`
+	Role      string     `json:"role,omitempty" yaml:"role,omitempty"`
+	Encrypted bool       `json:"encrypted" yaml:"encrypted"`
+	IssuedAt  *time.Time `json:"issuedAt,omitempty" yaml:"issuedAt,omitempty"`
+	Expiry    *time.Time `json:"expiry,omitempty" yaml:"expiry,omitempty"`
+	Reachable bool       `json:"reachable" yaml:"reachable"`
+	Findings  []string   `json:"findings,omitempty" yaml:"findings,omitempty"`
+}
+
+// auditCmd represents the audit command
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "List the credentials stored in the litmusconfig with their scope, age and expiry",
+	Long: `List the credentials stored in the litmusconfig with their scope, age and expiry.
+Expired, plain text and missing tokens are flagged, as well as accounts whose endpoints are unreachable.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		configFilePath := configFileToChange(cmd)
+
+		timeout, err := cmd.Flags().GetDuration("timeout")
+		utils.PrintError(err)
+
+		obj, err := config.YamltoObject(configFilePath)
+		utils.PrintError(err)
+
+		reachability := checkReachability(obj.Accounts, timeout)
+
+		var audits []credentialAudit
+		for _, account := range obj.Accounts {
+			for _, user := range account.Users {
+				audit := credentialAudit{
+					Endpoint:  account.Endpoint,
+					Username:  user.Username,
+					Current:   obj.CurrentAccount == account.Endpoint && obj.CurrentUser == user.Username,
+					Encrypted: config.IsEncrypted(user.Token),
+					Reachable: reachability[account.Endpoint] == "",
+				}
+
+				switch {
+				case user.Token == "":
+					audit.Findings = append(audit.Findings, "no token is stored")
+				case !audit.Encrypted:
+					audit.Findings = append(audit.Findings, "the token is stored in plain text")
+					if claims, err := config.JWTClaims(user.Token); err == nil {
+						audit.Role, _ = claims["role"].(string)
+					}
+					if issuedAt, err := config.JWTIssuedAt(user.Token); err == nil {
+						audit.IssuedAt = &issuedAt
+					}
+				}
+
+				if expiry, err := config.TokenExpiry(user); err == nil {
+					audit.Expiry = &expiry
+					if user.Token != "" && time.Now().After(expiry) {
+						audit.Findings = append(audit.Findings, "the token has expired")
+					}
+				}
+				if !audit.Reachable {
+					audit.Findings = append(audit.Findings, "the endpoint is unreachable ("+reachability[account.Endpoint]+")")
+				}
+
+				audits = append(audits, audit)
+			}
+		}
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(audits)
+
+		case "yaml":
+			utils.PrintInYamlFormat(audits)
+
+		case "":
+			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+			utils.White_B.Fprintln(writer, "CURRENT\tENDPOINT\tUSERNAME\tROLE\tAGE\tEXPIRY\tFINDINGS")
+			for _, audit := range audits {
+				var current, age, expiry string
+				if audit.Current {
+					current = "*"
+				}
+				if audit.IssuedAt != nil {
+					age = time.Since(*audit.IssuedAt).Round(time.Minute).String()
+				}
+				if audit.Expiry != nil {
+					expiry = config.HumanizeExpiry(*audit.Expiry)
+				}
+				utils.White.Fprintln(writer, current+"\t"+audit.Endpoint+"\t"+audit.Username+"\t"+audit.Role+"\t"+age+"\t"+expiry+"\t"+strings.Join(audit.Findings, "; "))
+			}
+			writer.Flush()
+		}
+	},
+}
+
+// checkReachability checks the GraphQL server of every endpoint concurrently, and returns
+// the failure of the unreachable ones
+func checkReachability(accounts []types.Account, timeout time.Duration) map[string]string {
+	var (
+		mutex        sync.Mutex
+		wg           sync.WaitGroup
+		reachability = make(map[string]string)
+	)
+	for _, account := range accounts {
+		wg.Add(1)
+		go func(endpoint string) {
+			defer wg.Done()
+			health := apis.CheckEndpoint(types.Credentials{Endpoint: endpoint}, timeout)
+
+			var failure string
+			for _, check := range health.Checks {
+				if !check.Healthy {
+					failure = fmt.Sprintf("%s failure", check.Failure)
+				}
+			}
+
+			mutex.Lock()
+			reachability[endpoint] = failure
+			mutex.Unlock()
+		}(account.Endpoint)
+	}
+	wg.Wait()
+	return reachability
+}
+
+func init() {
+	ConfigCmd.AddCommand(auditCmd)
+
+	auditCmd.Flags().Duration("timeout", 5*time.Second, "Set the timeout of the reachability check of each endpoint")
+	auditCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
@@ -25,17 +25,47 @@ var ConfigCmd = &cobra.Command{
 	Short: `It manages multiple ChaosCenter accounts within a system. 
 		Examples(s)
 		#set a new account
-		litmusctl config set-account  --endpoint "" --password "" --username ""
+		litmusctl config set-account  --server "" --password "" --username ""
 
 		#use an existing account from the config file
-		litmusctl config use-account  --endpoint "" --username ""
+		litmusctl config use-account  --server "" --username ""
+
+		#set the default project of the current account
+		litmusctl config set-project ""
+
+		#set the default flags of the current account
+		litmusctl config set-defaults output=json namespace=litmus
+
+		#opt in to the anonymized usage telemetry
+		litmusctl config set telemetry=on
 
 		#get all accounts in the config file
 		litmusctl config get-accounts
+
+		#get all accounts in the config file with their default project and token expiry
+		litmusctl config get-contexts
 		
 		#view the config file
 		litmusctl config view
+
+		#audit the credentials stored in the config file
+		litmusctl config audit
+
+		#export the config file without the tokens, to share it with the team
+		litmusctl config export --redact --file team.yaml
+
+		#import the accounts of an exported config file
+		litmusctl config import team.yaml
+
+		#upgrade the config file to the current schema version
+		litmusctl config migrate
+
+		#check the config file for errors
+		litmusctl config validate
+
+		#encrypt the tokens stored in the config file with a passphrase
+		litmusctl config encrypt
 		
-		Note: The default location of the config file is $HOME/.litmusconfig, and can be overridden by a --config flag
+		Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
 		`,
 }
@@ -0,0 +1,110 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/config"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// encryptCmd represents the encrypt command
+var encryptCmd = &cobra.Command{
+	Use:   "encrypt",
+	Short: "Encrypt the tokens stored in the litmusconfig file with a passphrase",
+	Long: `Encrypt the tokens stored in the litmusconfig file with a passphrase.
+The passphrase is read from the LITMUSCTL_PASSPHRASE environment variable, or prompted for. The tokens are decrypted transparently by every command, which asks for the passphrase when the environment variable is not set.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		configFilePath := configFileToChange(cmd)
+
+		passphrase, err := newPassphrase()
+		utils.PrintError(err)
+
+		count, err := config.EncryptFile(configFilePath, passphrase)
+		utils.PrintError(err)
+
+		if count == 0 {
+			utils.White_B.Println("👍 All the tokens of the config file are already encrypted")
+			return
+		}
+		utils.White_B.Println(fmt.Sprintf("🔒 %d token(s) encrypted", count))
+	},
+}
+
+// decryptCmd represents the decrypt command
+var decryptCmd = &cobra.Command{
+	Use:   "decrypt",
+	Short: "Store the tokens of the litmusconfig file in plain text again",
+	Run: func(cmd *cobra.Command, args []string) {
+		configFilePath := configFileToChange(cmd)
+
+		passphrase, err := utils.ConfigPassphrase()
+		utils.PrintError(err)
+
+		count, err := config.DecryptFile(configFilePath, passphrase)
+		utils.PrintError(err)
+
+		if count == 0 {
+			utils.White_B.Println("👍 No token of the config file is encrypted")
+			return
+		}
+		utils.White_B.Println(fmt.Sprintf("🔓 %d token(s) decrypted", count))
+	},
+}
+
+// configFileToChange returns the path of the config file, exiting when it doesn't exist
+func configFileToChange(cmd *cobra.Command) string {
+	configFilePath := utils.GetLitmusConfigPath(cmd)
+
+	if !config.FileExists(configFilePath) {
+		utils.Red.Println("File reading error open ", configFilePath, ": no such file or directory. Use --config or -c flag to point the configfile")
+		os.Exit(1)
+	}
+	return configFilePath
+}
+
+// newPassphrase returns the passphrase from the environment, or prompts for it twice to avoid typos
+func newPassphrase() (string, error) {
+	if passphrase := os.Getenv(utils.PassphraseEnv); passphrase != "" {
+		return passphrase, nil
+	}
+
+	passphrase, err := utils.ConfigPassphrase()
+	if err != nil {
+		return "", err
+	}
+
+	utils.White_B.Fprint(os.Stderr, "Confirm passphrase: ")
+	confirmation, err := term.ReadPassword(int(os.Stdin.Fd()))
+	utils.White_B.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+	if string(confirmation) != passphrase {
+		return "", errors.New("passphrases do not match")
+	}
+	return passphrase, nil
+}
+
+func init() {
+	ConfigCmd.AddCommand(encryptCmd)
+	ConfigCmd.AddCommand(decryptCmd)
+}
@@ -0,0 +1,96 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/litmuschaos/litmusctl/pkg/config"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v2"
+)
+
+// exportCmd represents the export command
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the litmusconfig, optionally without the tokens",
+	Long: `Export the litmusconfig, optionally without the tokens.
+With --redact, the exported config only holds the endpoints, usernames and default projects, and can be shared with the team members, who import it with config import and log in with config set-account.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		configFilePath := configFileToChange(cmd)
+
+		redact, err := cmd.Flags().GetBool("redact")
+		utils.PrintError(err)
+
+		obj, err := config.YamltoObject(configFilePath)
+		utils.PrintError(err)
+
+		if redact {
+			obj = config.Redact(obj)
+		}
+
+		data, err := yaml.Marshal(obj)
+		utils.PrintError(err)
+
+		file, err := cmd.Flags().GetString("file")
+		utils.PrintError(err)
+
+		if file == "" {
+			fmt.Print(string(data))
+			return
+		}
+		utils.PrintError(ioutil.WriteFile(file, data, 0600))
+		utils.White_B.Println("🚀 The config was exported to " + file)
+	},
+}
+
+// importCmd represents the import command
+var importCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import the accounts of an exported litmusconfig",
+	Long: `Import the accounts of an exported litmusconfig into the litmusconfig.
+Existing accounts keep their tokens, accounts without a token have to log in with config set-account.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		configFilePath := utils.GetLitmusConfigPath(cmd)
+
+		imported, err := config.YamltoObject(args[0])
+		utils.PrintError(err)
+
+		added, err := config.ImportConfig(imported, configFilePath)
+		utils.PrintError(err)
+
+		utils.White_B.Println(fmt.Sprintf("🚀 %d account(s) imported into %s", added, configFilePath))
+		for _, account := range imported.Accounts {
+			for _, user := range account.Users {
+				if user.Token == "" {
+					utils.White_B.Println("👉 Run litmusctl config set-account to log in to the imported accounts")
+					return
+				}
+			}
+		}
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(exportCmd)
+	ConfigCmd.AddCommand(importCmd)
+
+	exportCmd.Flags().Bool("redact", false, "Set to remove the tokens from the exported config")
+	exportCmd.Flags().String("file", "", "Set the file to export the config to, stdout by default")
+}
@@ -0,0 +1,108 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/litmuschaos/litmusctl/pkg/config"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// accountContext is a single user of an account in the litmusconfig
+type accountContext struct {
+	Current        bool       `json:"current" yaml:"current"`
+	Endpoint       string     `json:"endpoint" yaml:"endpoint"`
+	Username       string     `json:"username" yaml:"username"`
+	DefaultProject string     `json:"defaultProject,omitempty" yaml:"defaultProject,omitempty"`
+	TokenExpiry    *time.Time `json:"tokenExpiry,omitempty" yaml:"tokenExpiry,omitempty"`
+	Expired        bool       `json:"expired" yaml:"expired"`
+}
+
+// getContextsCmd represents the get-contexts command
+var getContextsCmd = &cobra.Command{
+	Use:   "get-contexts",
+	Short: "Display the accounts defined in the litmusconfig with their default project and token expiry",
+	Long:  `Display the accounts defined in the litmusconfig with their default project and token expiry, the current one is marked with *`,
+	Run: func(cmd *cobra.Command, args []string) {
+		configFilePath := utils.GetLitmusConfigPath(cmd)
+
+		obj, err := config.YamltoObject(configFilePath)
+		utils.PrintError(err)
+
+		var contexts []accountContext
+		for _, account := range obj.Accounts {
+			for _, user := range account.Users {
+				context := accountContext{
+					Current:        obj.CurrentUser == user.Username && obj.CurrentAccount == account.Endpoint,
+					Endpoint:       account.Endpoint,
+					Username:       user.Username,
+					DefaultProject: user.DefaultProject,
+				}
+
+				// An account whose expiry can't be read is still listed, with an unknown expiry
+				if expiry, err := config.TokenExpiry(user); err == nil {
+					context.TokenExpiry = &expiry
+					context.Expired = time.Now().After(expiry)
+				}
+				contexts = append(contexts, context)
+			}
+		}
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(contexts)
+
+		case "yaml":
+			utils.PrintInYamlFormat(contexts)
+
+		case "":
+			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+			utils.White_B.Fprintln(writer, "CURRENT\tENDPOINT\tUSERNAME\tDEFAULT PROJECT\tTOKEN EXPIRY")
+			for _, context := range contexts {
+				var current string
+				if context.Current {
+					current = "*"
+				}
+
+				expiry := "unknown"
+				if context.TokenExpiry != nil {
+					expiry = context.TokenExpiry.Format(time.RFC3339)
+					if context.Expired {
+						expiry += " (expired)"
+					} else {
+						expiry += " (in " + time.Until(*context.TokenExpiry).Round(time.Minute).String() + ")"
+					}
+				}
+
+				utils.White.Fprintln(writer, current+"\t"+context.Endpoint+"\t"+context.Username+"\t"+context.DefaultProject+"\t"+expiry)
+			}
+			writer.Flush()
+		}
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(getContextsCmd)
+
+	getContextsCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
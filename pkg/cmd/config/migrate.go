@@ -0,0 +1,57 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/config"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// migrateCmd represents the migrate command
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Upgrade the litmusconfig file to the current schema version",
+	Long: `Upgrade the litmusconfig file to the current schema version.
+Older config files are migrated in memory by every command, and persisted on the next change of the config. This command persists the migration right away.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		configFilePath := utils.GetLitmusConfigPath(cmd)
+
+		exists := config.FileExists(configFilePath)
+		if !exists {
+			utils.Red.Println("File reading error open ", configFilePath, ": no such file or directory. Use --config or -c flag to point the configfile")
+			os.Exit(1)
+		}
+
+		from, migrated, err := config.MigrateFile(configFilePath)
+		utils.PrintError(err)
+
+		if !migrated {
+			utils.White_B.Println("👍 The config file is already at version " + config.APIVersion)
+			return
+		}
+		if from == "" {
+			from = "unversioned"
+		}
+		utils.White_B.Println("🚀 The config file was migrated from " + from + " to " + config.APIVersion)
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(migrateCmd)
+}
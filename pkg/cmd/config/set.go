@@ -0,0 +1,51 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/litmuschaos/litmusctl/pkg/config"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// setCmd represents the set command
+var setCmd = &cobra.Command{
+	Use:   "set [preference=value]",
+	Short: "Set a preference of litmusctl",
+	Long: `Set a preference of litmusctl. Supported preferences:
+  telemetry=on|off  Send anonymized usage telemetry (command name, duration and error type), off by default`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		configFilePath := configFileToChange(cmd)
+
+		parts := strings.SplitN(args[0], "=", 2)
+		if len(parts) != 2 {
+			utils.PrintError(errors.New("invalid preference " + args[0] + ", expected preference=value"))
+		}
+
+		err := config.SetPreference(parts[0], parts[1], configFilePath)
+		utils.PrintError(err)
+
+		utils.White_B.Println("🚀 " + parts[0] + " is set to " + parts[1])
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(setCmd)
+}
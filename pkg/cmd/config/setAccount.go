@@ -16,6 +16,7 @@ limitations under the License.
 package config
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
@@ -37,7 +38,7 @@ var setAccountCmd = &cobra.Command{
 	Short: `Sets an account entry in litmusconfig.
 		Examples(s)
 		#set a new account
-		litmusctl config set-account  --endpoint "" --password "" --username ""
+		litmusctl config set-account  --server "" --password "" --username ""
 		`,
 	Run: func(cmd *cobra.Command, args []string) {
 		configFilePath := utils.GetLitmusConfigPath(cmd)
@@ -47,8 +48,7 @@ var setAccountCmd = &cobra.Command{
 			err       error
 		)
 
-		authInput.Endpoint, err = cmd.Flags().GetString("endpoint")
-		utils.PrintError(err)
+		authInput.Endpoint = getServer(cmd)
 
 		authInput.Username, err = cmd.Flags().GetString("username")
 		utils.PrintError(err)
@@ -57,13 +57,8 @@ var setAccountCmd = &cobra.Command{
 		utils.PrintError(err)
 
 		if authInput.Endpoint == "" {
-			utils.White_B.Print("\nHost endpoint where litmus is installed: ")
-			fmt.Scanln(&authInput.Endpoint)
-
-			for authInput.Endpoint == "" {
-				utils.Red.Println("\n⛔ Host URL can't be empty!!")
-				os.Exit(1)
-			}
+			authInput.Endpoint, err = utils.PromptInput("Host endpoint where litmus is installed", "", utils.NotEmpty("Host URL"))
+			utils.PrintError(err)
 
 			ep := strings.TrimRight(authInput.Endpoint, "/")
 			newUrl, err := url.Parse(ep)
@@ -72,14 +67,31 @@ var setAccountCmd = &cobra.Command{
 			authInput.Endpoint = newUrl.String()
 		}
 
-		if authInput.Username == "" {
-			utils.White_B.Print("\nUsername [Default: ", utils.DefaultUsername, "]: ")
-			fmt.Scanln(&authInput.Username)
-			if authInput.Username == "" {
-				authInput.Username = utils.DefaultUsername
+		// Fall back to the credentials of the endpoint in the netrc file, before prompting for them
+		if authInput.Username == "" || authInput.Password == "" {
+			entry, found, err := config.NetrcCredentials(authInput.Endpoint)
+			utils.PrintError(err)
+
+			if found && (authInput.Username == "" || entry.Login == "" || entry.Login == authInput.Username) {
+				if authInput.Username == "" {
+					authInput.Username = entry.Login
+				}
+				if authInput.Password == "" {
+					authInput.Password = entry.Password
+				}
+				machine := entry.Machine
+				if machine == "" {
+					machine = "the default machine"
+				}
+				utils.White_B.Fprintln(os.Stderr, "Using the credentials of "+machine+" from the netrc file")
 			}
 		}
 
+		if authInput.Username == "" {
+			authInput.Username, err = utils.PromptInput("Username", utils.DefaultUsername, nil)
+			utils.PrintError(err)
+		}
+
 		if authInput.Password == "" {
 			utils.White_B.Print("\nPassword: ")
 			pass, err := term.ReadPassword(0)
@@ -131,8 +143,8 @@ var setAccountCmd = &cobra.Command{
 				accounts = append(accounts, account)
 
 				var litmuCtlConfig = types.LitmuCtlConfig{
-					APIVersion:     "v1",
-					Kind:           "Config",
+					APIVersion:     config.APIVersion,
+					Kind:           config.Kind,
 					CurrentAccount: authInput.Endpoint,
 					CurrentUser:    claims["username"].(string),
 					Accounts:       accounts,
@@ -147,6 +159,17 @@ var setAccountCmd = &cobra.Command{
 				err = config.ConfigSyntaxCheck(configFilePath)
 				utils.PrintError(err)
 
+				// Keep the tokens encrypted at rest once the config file is encrypted
+				obj, err := config.YamltoObject(configFilePath)
+				utils.PrintError(err)
+				if config.HasEncryptedTokens(obj) {
+					passphrase, err := utils.ConfigPassphrase()
+					utils.PrintError(err)
+
+					account.Users[0].Token, err = config.EncryptToken(account.Users[0].Token, passphrase)
+					utils.PrintError(err)
+				}
+
 				var updateLitmusCtlConfig = types.UpdateLitmusCtlConfig{
 					Account:        account,
 					CurrentAccount: authInput.Endpoint,
@@ -159,29 +182,20 @@ var setAccountCmd = &cobra.Command{
 			utils.White_B.Printf("\naccount.username/%s configured", claims["username"].(string))
 
 			serverResp, err := apis.GetServerVersion(authInput.Endpoint)
-			var isCompatible bool
-			if err != nil {
+			if errors.Is(err, apis.ErrGraphQLBlocked) {
+				// The account only needs the auth server, the project and user commands work over its REST API
+				utils.Red.Println("\n⚠️  The GraphQL API of the ChaosCenter is not reachable, the compatibility check was skipped. Only the project, user and invitation commands work through this endpoint.")
+			} else if err != nil {
 				utils.Red.Println("\nError: ", err)
 			} else {
-				compatibilityArr := utils.CompatibilityMatrix[os.Getenv("CLIVersion")]
-				for _, v := range compatibilityArr {
-					if v == serverResp.Data.GetServerVersion.Value {
-						isCompatible = true
-						break
-					}
-				}
-
+				isCompatible := utils.IsCompatible(os.Getenv("CLIVersion"), serverResp.Data.GetServerVersion.Value)
 				if !isCompatible {
 					utils.Red.Println("\n🚫 ChaosCenter version: " + serverResp.Data.GetServerVersion.Value + " is not compatible with the installed LitmusCTL version: " + os.Getenv("CLIVersion"))
-					utils.White_B.Println("Compatible ChaosCenter versions are: ")
-					utils.White_B.Print("[ ")
-					for _, v := range compatibilityArr {
-						utils.White_B.Print("'" + v + "' ")
-					}
-					utils.White_B.Print("]\n")
+					utils.White_B.Println("Compatible ChaosCenter versions are: ", utils.CompatibleVersions(os.Getenv("CLIVersion")))
 				} else {
 					utils.White_B.Println("\n✅  Installed versions of ChaosCenter and LitmusCTL are compatible! ")
 				}
+				utils.PrintDeprecationWarning(serverResp.Data.GetServerVersion.Value)
 			}
 
 		} else {
@@ -193,7 +207,27 @@ var setAccountCmd = &cobra.Command{
 func init() {
 	ConfigCmd.AddCommand(setAccountCmd)
 
-	setAccountCmd.Flags().StringP("endpoint", "e", "", "Account endpoint. Mandatory")
+	addServerFlag(setAccountCmd, "Account endpoint. Mandatory")
 	setAccountCmd.Flags().StringP("username", "u", "", "Account username. Mandatory")
 	setAccountCmd.Flags().StringP("password", "p", "", "Account password. Mandatory")
 }
+
+// addServerFlag registers the --server flag of the account endpoint. Its former name --endpoint is kept
+// as a deprecated alias, it shadows the global --endpoint, which the config commands don't use.
+func addServerFlag(cmd *cobra.Command, usage string) {
+	cmd.Flags().StringP("server", "s", "", usage)
+	cmd.Flags().StringP("endpoint", "e", "", usage)
+	utils.PrintError(cmd.Flags().MarkDeprecated("endpoint", "use --server instead"))
+}
+
+// getServer reads the account endpoint of --server, or of the deprecated --endpoint
+func getServer(cmd *cobra.Command) string {
+	name := "server"
+	if cmd.Flags().Changed("endpoint") {
+		// --endpoint is the deprecated name of --server
+		name = "endpoint"
+	}
+	server, err := cmd.Flags().GetString(name)
+	utils.PrintError(err)
+	return server
+}
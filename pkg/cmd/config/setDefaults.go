@@ -0,0 +1,111 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/litmuschaos/litmusctl/pkg/config"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// setDefaultsCmd represents the set-defaults command
+var setDefaultsCmd = &cobra.Command{
+	Use:   "set-defaults [flag=value]...",
+	Short: "Set the default flags of an account",
+	Long: `Set the default flags of an account, which are applied to every command while the account is the current one, unless the flags are passed on the command line.
+Supported flags are output, installation-mode, namespace (of the Chaos Delegate), skipSSL, and sort-by, order and limit of the lists.
+A sort-by default is only applied to the lists which can be sorted by it.`,
+	Example: `  litmusctl config set-defaults output=json installation-mode=namespace namespace=litmus skipSSL=true
+  litmusctl config set-defaults sort-by=created order=desc limit=50
+  litmusctl config set-defaults --unset skipSSL`,
+	Run: func(cmd *cobra.Command, args []string) {
+		configFilePath := configFileToChange(cmd)
+
+		endpoint, err := cmd.Flags().GetString("account")
+		utils.PrintError(err)
+
+		unset, err := cmd.Flags().GetStringSlice("unset")
+		utils.PrintError(err)
+
+		if endpoint == "" {
+			obj, err := config.YamltoObject(configFilePath)
+			utils.PrintError(err)
+			endpoint = obj.CurrentAccount
+		}
+
+		defaults := make(map[string]string)
+		for _, arg := range args {
+			parts := strings.SplitN(arg, "=", 2)
+			if len(parts) != 2 {
+				utils.PrintError(errors.New("invalid default " + arg + ", expected flag=value"))
+			}
+			defaults[parts[0]] = parts[1]
+		}
+		if len(defaults) == 0 && len(unset) == 0 {
+			utils.PrintError(errors.New("no defaults to set, pass them as flag=value"))
+		}
+
+		for _, name := range append(unset, keys(defaults)...) {
+			if !isAccountDefaultFlag(name) {
+				utils.PrintError(errors.New("unsupported default " + name + ", supported flags are " + strings.Join(utils.AccountDefaultFlags, ", ")))
+			}
+		}
+		if value, ok := defaults["skipSSL"]; ok && value != "true" && value != "false" {
+			utils.PrintError(errors.New("invalid default skipSSL=" + value + ", expected true or false"))
+		}
+		if value, ok := defaults["order"]; ok && value != "asc" && value != "desc" {
+			utils.PrintError(errors.New("invalid default order=" + value + ", expected asc or desc"))
+		}
+		if value, ok := defaults["limit"]; ok {
+			if limit, err := strconv.Atoi(value); err != nil || limit < 1 {
+				utils.PrintError(errors.New("invalid default limit=" + value + ", expected a number greater than 0"))
+			}
+		}
+
+		err = config.SetAccountDefaults(endpoint, defaults, unset, configFilePath)
+		utils.PrintError(err)
+
+		utils.White_B.Println("🚀 The defaults of the account " + endpoint + " were updated")
+	},
+}
+
+func isAccountDefaultFlag(name string) bool {
+	for _, flag := range utils.AccountDefaultFlags {
+		if flag == name {
+			return true
+		}
+	}
+	return false
+}
+
+func keys(m map[string]string) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+func init() {
+	ConfigCmd.AddCommand(setDefaultsCmd)
+
+	setDefaultsCmd.Flags().String("account", "", "Set the endpoint of the account, the current account by default")
+	setDefaultsCmd.Flags().StringSlice("unset", nil, "Set the defaults to remove")
+}
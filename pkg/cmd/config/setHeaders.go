@@ -0,0 +1,72 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"errors"
+
+	"github.com/litmuschaos/litmusctl/pkg/config"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// setHeadersCmd represents the set-headers command
+var setHeadersCmd = &cobra.Command{
+	Use:   "set-headers [\"Name: value\"]...",
+	Short: "Set the headers sent with the requests to the ChaosCenter of an account",
+	Long: `Set the headers sent with every request to the ChaosCenter of an account, e.g. the token required by an authenticating reverse proxy in front of it.
+The headers are only sent to the endpoint of the account, and the headers passed with --header take precedence over them.`,
+	Example: `  litmusctl config set-headers "X-Org-Token: abc" "X-Tenant: chaos"
+  litmusctl config set-headers --account https://preview.litmuschaos.io "X-Org-Token: abc"
+  litmusctl config set-headers --unset X-Org-Token`,
+	Run: func(cmd *cobra.Command, args []string) {
+		configFilePath := configFileToChange(cmd)
+
+		endpoint, err := cmd.Flags().GetString("account")
+		utils.PrintError(err)
+
+		unset, err := cmd.Flags().GetStringSlice("unset")
+		utils.PrintError(err)
+
+		if endpoint == "" {
+			obj, err := config.YamltoObject(configFilePath)
+			utils.PrintError(err)
+			endpoint = obj.CurrentAccount
+		}
+
+		headers := make(map[string]string)
+		for _, arg := range args {
+			name, value, err := utils.ParseHeader(arg)
+			utils.PrintError(err)
+			headers[name] = value
+		}
+		if len(headers) == 0 && len(unset) == 0 {
+			utils.PrintError(errors.New("no headers to set, pass them as \"Name: value\""))
+		}
+
+		err = config.SetAccountHeaders(endpoint, headers, unset, configFilePath)
+		utils.PrintError(err)
+
+		utils.White_B.Println("🚀 The headers of the account " + endpoint + " were updated")
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(setHeadersCmd)
+
+	setHeadersCmd.Flags().String("account", "", "Set the endpoint of the account, the current account by default")
+	setHeadersCmd.Flags().StringSlice("unset", nil, "Set the names of the headers to remove")
+}
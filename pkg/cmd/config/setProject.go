@@ -0,0 +1,91 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/config"
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// setProjectCmd represents the set-project command
+var setProjectCmd = &cobra.Command{
+	Use:   "set-project <project-id>",
+	Short: "Sets the default project of the current account in a litmusconfig file",
+	Long: `Sets the default project of the current account in a litmusconfig file.
+The default project is used by all the commands when the --project-id flag is not passed.
+To remove the default project, apply litmusctl config set-project --unset`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		configFilePath := utils.GetLitmusConfigPath(cmd)
+
+		unset, err := cmd.Flags().GetBool("unset")
+		utils.PrintError(err)
+
+		if !unset && len(args) == 0 {
+			utils.Red.Println("⛔ Project ID can't be empty!!")
+			os.Exit(1)
+		}
+
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		current := types.Current{
+			CurrentAccount: credentials.Endpoint,
+			CurrentUser:    credentials.Username,
+		}
+
+		if unset {
+			err = config.SetDefaultProject(current, "", configFilePath)
+			utils.PrintError(err)
+
+			utils.White_B.Println("\n✅ Default project removed for " + credentials.Username + "@" + credentials.Endpoint)
+			return
+		}
+
+		projectID := args[0]
+
+		// Make sure the user is a member of the project before storing it
+		userDetails, err := apis.GetProjectDetails(credentials)
+		utils.PrintError(err)
+
+		var projectName string
+		for _, project := range userDetails.Data.Projects {
+			if project.ID == projectID {
+				projectName = project.Name
+			}
+		}
+		if projectName == "" {
+			utils.Red.Println("⛔ No project found with ID: " + projectID + ". To see the projects, apply litmusctl get projects")
+			os.Exit(1)
+		}
+
+		err = config.SetDefaultProject(current, projectID, configFilePath)
+		utils.PrintError(err)
+
+		utils.White_B.Println("\n✅ Default project set to " + projectName + " (" + projectID + ") for " + credentials.Username + "@" + credentials.Endpoint)
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(setProjectCmd)
+
+	setProjectCmd.Flags().Bool("unset", false, "Set to true to remove the default project of the current account")
+}
@@ -16,7 +16,6 @@ limitations under the License.
 package config
 
 import (
-	"fmt"
 	"os"
 
 	"github.com/litmuschaos/litmusctl/pkg/config"
@@ -33,30 +32,19 @@ var useAccountCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		configFilePath := utils.GetLitmusConfigPath(cmd)
 
-		endpoint, err := cmd.Flags().GetString("endpoint")
-		utils.PrintError(err)
-
+		var err error
+		endpoint := getServer(cmd)
 		if endpoint == "" {
-			utils.White_B.Print("\nHost endpoint where litmus is installed: ")
-			fmt.Scanln(&endpoint)
-
-			for endpoint == "" {
-				utils.Red.Println("\n⛔ Host URL can't be empty!!")
-				os.Exit(1)
-			}
+			endpoint, err = utils.PromptInput("Host endpoint where litmus is installed", "", utils.NotEmpty("Host URL"))
+			utils.PrintError(err)
 		}
 
 		username, err := cmd.Flags().GetString("username")
 		utils.PrintError(err)
 
 		if username == "" {
-			utils.White_B.Print("\nUsername: ")
-			fmt.Scanln(&username)
-
-			for username == "" {
-				utils.Red.Println("\n⛔ Username cannot be empty!!")
-				os.Exit(1)
-			}
+			username, err = utils.PromptInput("Username", "", utils.NotEmpty("Username"))
+			utils.PrintError(err)
 		}
 
 		if username == "" || endpoint == "" {
@@ -94,5 +82,5 @@ var useAccountCmd = &cobra.Command{
 func init() {
 	ConfigCmd.AddCommand(useAccountCmd)
 	useAccountCmd.Flags().StringP("username", "u", "", "Help message for toggle")
-	useAccountCmd.Flags().StringP("endpoint", "e", "", "Help message for toggle")
+	addServerFlag(useAccountCmd, "Account endpoint")
 }
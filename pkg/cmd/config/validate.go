@@ -0,0 +1,68 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/config"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// validateCmd represents the validate command
+var validateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check the litmusconfig file for errors",
+	Long: `Check the litmusconfig file for syntax errors, unknown fields, duplicate accounts and users, invalid values and a current account which doesn't exist.
+The command exits with 1 when the config file is invalid.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		configFilePath := configFileToChange(cmd)
+
+		validationErrors, err := config.Validate(configFilePath)
+		utils.PrintError(err)
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			if validationErrors == nil {
+				validationErrors = []config.ValidationError{}
+			}
+			utils.PrintInJsonFormat(validationErrors)
+
+		case "":
+			if len(validationErrors) == 0 {
+				utils.White_B.Println("✅ The config file " + configFilePath + " is valid")
+				return
+			}
+			for _, validationError := range validationErrors {
+				utils.Red.Println("❌ " + configFilePath + ": " + validationError.Error())
+			}
+		}
+
+		if len(validationErrors) > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	ConfigCmd.AddCommand(validateCmd)
+
+	validateCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson")
+}
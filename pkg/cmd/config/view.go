@@ -46,6 +46,21 @@ var viewCmd = &cobra.Command{
 
 		//Printing the config map
 		fmt.Print(string(data))
+
+		// Annotate the time to expiry of the tokens, as YAML comments to keep the output valid
+		obj, err := config.YamltoObject(configFilePath)
+		if err != nil {
+			return
+		}
+		for _, account := range obj.Accounts {
+			for _, user := range account.Users {
+				expiry, err := config.TokenExpiry(user)
+				if err != nil {
+					continue
+				}
+				fmt.Println("# " + user.Username + "@" + account.Endpoint + ": token " + config.HumanizeExpiry(expiry))
+			}
+		}
 	},
 }
 
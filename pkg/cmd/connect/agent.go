@@ -16,9 +16,11 @@ limitations under the License.
 package connect
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/litmuschaos/litmusctl/pkg/agent"
 	"github.com/litmuschaos/litmusctl/pkg/apis"
@@ -40,9 +42,11 @@ var agentCmd = &cobra.Command{
 	#connect a Chaos Delegate within a project
 	litmusctl connect chaos-delegate --name="new-chaos-delegate" --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --non-interactive
 
-	Note: The default location of the config file is $HOME/.litmusconfig, and can be overridden by a --config flag
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
 `,
 	Run: func(cmd *cobra.Command, args []string) {
+		utils.QuietMessages()
+
 		credentials, err := utils.GetCredentials(cmd)
 		utils.PrintError(err)
 
@@ -51,6 +55,37 @@ var agentCmd = &cobra.Command{
 
 		kubeconfig, err := cmd.Flags().GetString("kubeconfig")
 		utils.PrintError(err)
+		utils.PrintError(k8s.LoadKubeconfig(&kubeconfig))
+		utils.PrintError(k8s.Impersonate(cmd))
+
+		saveManifest, err := cmd.Flags().GetString("save-manifest")
+		utils.PrintError(err)
+
+		adopt, err := cmd.Flags().GetBool("adopt-existing")
+		utils.PrintError(err)
+
+		dryRun, err := cmd.Flags().GetString("dry-run")
+		utils.PrintError(err)
+		if dryRun != "" && dryRun != "server" {
+			utils.Red.Println("⛔ Invalid --dry-run value " + dryRun + ", supported value is server")
+			os.Exit(1)
+		}
+
+		channel, err := cmd.Flags().GetString("channel")
+		utils.PrintError(err)
+		if _, ok := utils.ChannelImageTags[channel]; !ok && channel != utils.StableChannel {
+			utils.Red.Println("⛔ Invalid --channel value " + channel + ", supported values are stable/ci/edge")
+			os.Exit(1)
+		}
+
+		nsLabels, err := cmd.Flags().GetStringToString("namespace-labels")
+		utils.PrintError(err)
+
+		nsAnnotations, err := cmd.Flags().GetStringToString("namespace-annotations")
+		utils.PrintError(err)
+
+		ctx, cancel := utils.CommandContext(cmd)
+		defer cancel()
 
 		var newAgent types.Agent
 
@@ -167,6 +202,10 @@ var agentCmd = &cobra.Command{
 
 			newAgent.Namespace, err = cmd.Flags().GetString("namespace")
 			utils.PrintError(err)
+			if err := k8s.ValidateNsName(newAgent.Namespace); err != nil {
+				utils.Red.Println("⛔ " + err.Error())
+				os.Exit(1)
+			}
 
 			newAgent.ServiceAccount, err = cmd.Flags().GetString("service-account")
 			utils.PrintError(err)
@@ -188,7 +227,7 @@ var agentCmd = &cobra.Command{
 
 			// Check if user has sufficient permissions based on mode
 			utils.White_B.Print("\n🏃 Running prerequisites check....")
-			agent.ValidateSAPermissions(newAgent.Namespace, newAgent.Mode, &kubeconfig)
+			agent.ValidateSAPermissions(ctx, newAgent.Namespace, newAgent.Mode, &kubeconfig)
 
 			agents, err := apis.GetAgentList(credentials, newAgent.ProjectId)
 			utils.PrintError(err)
@@ -220,56 +259,142 @@ var agentCmd = &cobra.Command{
 
 			// Check if user has sufficient permissions based on mode
 			utils.White_B.Print("\n🏃 Running prerequisites check....")
-			agent.ValidateSAPermissions(newAgent.Namespace, modeType, &kubeconfig)
-			newAgent, err = agent.GetAgentDetails(modeType, newAgent.ProjectId, credentials, &kubeconfig)
+			agent.ValidateSAPermissions(ctx, newAgent.Namespace, modeType, &kubeconfig)
+			newAgent, err = agent.GetAgentDetails(ctx, modeType, newAgent.ProjectId, credentials, &kubeconfig)
 			utils.PrintError(err)
 
-			newAgent.ServiceAccount, newAgent.SAExists = k8s.ValidSA(newAgent.Namespace, &kubeconfig)
+			newAgent.ServiceAccount, newAgent.SAExists, err = k8s.ValidSA(ctx, newAgent.Namespace, &kubeconfig)
+			utils.PrintError(err)
 			newAgent.Mode = modeType
 		}
 
-		agent.Summary(newAgent, &kubeconfig)
+		agent.ValidateClusterCompatibility(ctx, newAgent.Mode, &kubeconfig)
+		existingInstallation := agent.CheckExistingInstallation(ctx, adopt, &kubeconfig)
+
+		if newAgent.SAExists {
+			agent.ValidateExistingSA(ctx, newAgent, &kubeconfig)
+		}
+
+		agent.Summary(ctx, newAgent, &kubeconfig)
 
 		if !nonInteractive {
 			agent.ConfirmInstallation()
 		}
 
-		agent, err := apis.ConnectAgent(newAgent, credentials)
+		// The namespace is created by litmusctl when it needs labels or annotations,
+		// otherwise it's part of the Chaos Delegate manifest
+		if !newAgent.NsExists && (len(nsLabels) > 0 || len(nsAnnotations) > 0) && dryRun == "" {
+			err = k8s.CreateNs(ctx, newAgent.Namespace, nsLabels, nsAnnotations, &kubeconfig)
+			if err != nil {
+				utils.Red.Println("\n❌ Failed to create the namespace " + newAgent.Namespace + ": " + err.Error())
+				os.Exit(1)
+			}
+			utils.White_B.Println("\n🚀 Namespace " + newAgent.Namespace + " created.")
+			newAgent.NsExists = true
+		}
+
+		// A server dry-run needs the manifest of a registered Chaos Delegate, and the namespace of the
+		// Chaos Delegate for the objects of the manifest. Both are made temporarily, and removed
+		// afterwards, even when the dry-run fails or is interrupted.
+		var temporary []*utils.Cleanup
+		removeTemporary := func() {
+			for i := len(temporary) - 1; i >= 0; i-- {
+				temporary[i].Run()
+			}
+		}
+		if dryRun != "" {
+			utils.White_B.Println("\n🧪 The Chaos Delegate is registered temporarily to get its manifest, and removed after the " + dryRun + " dry-run.")
+		}
+		if dryRun != "" && !newAgent.NsExists {
+			err = k8s.CreateNs(ctx, newAgent.Namespace, nsLabels, nsAnnotations, &kubeconfig)
+			if err != nil {
+				utils.Red.Println("\n❌ Failed to create the namespace " + newAgent.Namespace + ": " + err.Error())
+				os.Exit(1)
+			}
+			utils.White_B.Println("🧪 Namespace " + newAgent.Namespace + " created temporarily for the " + dryRun + " dry-run.")
+			temporary = append(temporary, utils.OnInterrupt(func() {
+				// The command context may be done already, so the namespace is deleted with a new one
+				if err := k8s.DeleteNs(context.Background(), newAgent.Namespace, &kubeconfig); err != nil {
+					utils.Red.Println("\n❌ Failed to delete the temporary namespace " + newAgent.Namespace + ": " + err.Error())
+				}
+			}))
+		}
+
+		connectedAgent, err := apis.ConnectAgent(newAgent, credentials)
 		if err != nil {
+			removeTemporary()
 			utils.Red.Println("\n❌ Chaos Delegate connection failed: " + err.Error() + "\n")
 			os.Exit(1)
 		}
+		if dryRun != "" && connectedAgent.Data.UserAgentReg.ClusterID != "" {
+			clusterID := connectedAgent.Data.UserAgentReg.ClusterID
+			temporary = append(temporary, utils.OnInterrupt(func() {
+				if _, err := apis.DisconnectAgent(newAgent.ProjectId, []*string{&clusterID}, credentials); err != nil {
+					utils.Red.Println("\n❌ Failed to remove the temporary registration of the Chaos Delegate " + clusterID + ": " + err.Error())
+				}
+			}))
+		}
 
-		if agent.Data.UserAgentReg.Token == "" {
-			utils.Red.Println("\n❌ failed to get the agent registration token: " + "\n")
+		if connectedAgent.Data.UserAgentReg.Token == "" {
+			removeTemporary()
+			utils.Red.Print("\n❌ failed to get the agent registration token: \n\n")
 			os.Exit(1)
 		}
 
-		path := fmt.Sprintf("%s/%s/%s.yaml", credentials.Endpoint, utils.ChaosYamlPath, agent.Data.UserAgentReg.Token)
+		path := fmt.Sprintf("%s/%s/%s.yaml", credentials.Endpoint, utils.ChaosYamlPath, connectedAgent.Data.UserAgentReg.Token)
 		utils.White_B.Print("Applying YAML:\n", path)
 
 		// Print error message in case Data field is null in response
-		if (agent.Data == apis.AgentConnect{}) {
-			utils.White_B.Print("\n🚫 Chaos Delegate connection failed: " + agent.Errors[0].Message + "\n")
+		if (connectedAgent.Data == apis.AgentConnect{}) {
+			removeTemporary()
+			utils.White_B.Print("\n🚫 Chaos Delegate connection failed: " + connectedAgent.Errors[0].Message + "\n")
 			os.Exit(1)
 		}
 
 		//Apply agent connection yaml
-		yamlOutput, err := k8s.ApplyYaml(k8s.ApplyYamlPrams{
-			Token:    agent.Data.UserAgentReg.Token,
-			Endpoint: credentials.Endpoint,
-			YamlPath: utils.ChaosYamlPath,
+		yamlOutput, err := k8s.ApplyYaml(ctx, k8s.ApplyYamlPrams{
+			Token:        connectedAgent.Data.UserAgentReg.Token,
+			Endpoint:     credentials.Endpoint,
+			YamlPath:     utils.ChaosYamlPath,
+			SaveManifest: saveManifest,
+			ServerSide:   existingInstallation,
+			Adopt:        adopt,
+			DryRun:       dryRun,
+			Channel:      channel,
 		}, kubeconfig, false)
+
+		removeTemporary()
+
 		if err != nil {
 			utils.Red.Print("\n❌ Failed in applying connection yaml: \n" + err.Error() + "\n")
 			utils.White_B.Print("\n Error:  \n" + err.Error())
+			if existingInstallation && strings.Contains(err.Error(), "conflict") && !adopt {
+				utils.White.Println("\n👉 The above fields are owned by the existing Litmus installation. Set --adopt-existing to take over the ones of the objects in the namespace of the Chaos Delegate.")
+			}
 			os.Exit(1)
 		}
 
 		utils.White_B.Print("\n", yamlOutput)
 
+		if dryRun != "" {
+			utils.White_B.Println("\n🚀 The Chaos Delegate manifest passed the " + dryRun + " dry-run, and its temporary changes were removed.")
+			return
+		}
+
 		// Watch subscriber pod status
-		k8s.WatchPod(k8s.WatchPodParams{Namespace: newAgent.Namespace, Label: utils.ChaosAgentLabel}, &kubeconfig)
+		podParams := k8s.WatchPodParams{Namespace: newAgent.Namespace, Labels: []string{utils.ChaosAgentLabel}, FieldSelectors: k8s.ActivePodFieldSelectors}
+		err = k8s.WatchPod(ctx, podParams, &kubeconfig)
+		if err != nil {
+			utils.Red.Println("\n❌ Chaos Delegate is not running: " + err.Error())
+			// The command context may be done already, so the events are fetched with a new one
+			agent.PrintPodEvents(context.Background(), podParams, &kubeconfig)
+			os.Exit(1)
+		}
+
+		if utils.Quiet {
+			utils.PrintIDs(connectedAgent.Data.UserAgentReg.ClusterID)
+			return
+		}
 
 		utils.White_B.Println("\n🚀 Chaos Delegate connection successful!! 🎉")
 		utils.White_B.Println("👉 Litmus Chaos Delegates can be accessed here: " + fmt.Sprintf("%s/%s", credentials.Endpoint, utils.ChaosAgentPath))
@@ -280,7 +405,13 @@ func init() {
 	ConnectCmd.AddCommand(agentCmd)
 
 	agentCmd.Flags().BoolP("non-interactive", "n", false, "Set it to true for non interactive mode | Note: Always set the boolean flag as --non-interactive=Boolean")
-	agentCmd.Flags().StringP("kubeconfig", "k", "", "Set to pass kubeconfig file if it is not in the default location ($HOME/.kube/config)")
+	agentCmd.Flags().StringP("kubeconfig", "k", "", "Set to pass kubeconfig file if it is not in the default location ($HOME/.kube/config). The in-cluster config is used when running inside a pod without a kubeconfig. Pass - to read it from stdin, or set the base64 encoded kubeconfig in KUBECONFIG_DATA")
+	k8s.AddImpersonationFlags(agentCmd)
+	agentCmd.Flags().String("save-manifest", "", "Set a path to keep a copy of the Chaos Delegate manifest, it's removed after applying it otherwise")
+	agentCmd.Flags().Bool("adopt-existing", false, "Set to take over the fields of the objects in the namespace of the Chaos Delegate owned by an existing Litmus installation in the cluster, when they conflict with the Chaos Delegate manifest. The cluster-scoped objects, e.g. the CRDs, are never taken over")
+	agentCmd.Flags().String("dry-run", "", "Set to server to validate the Chaos Delegate manifest against the admission and validation of the cluster. The Chaos Delegate is registered temporarily to get its manifest, and its namespace is created if missing, both are removed afterwards, even if the dry-run fails or is interrupted")
+	agentCmd.Flags().String("channel", utils.StableChannel, "Set the release channel of the Chaos Delegate images | Supported=stable/ci/edge. ci and edge are unreleased builds, for testing unreleased ChaosCenter builds only")
+	agentCmd.Flags().Duration("timeout", 0, "Set the maximum time to wait for the Chaos Delegate to be connected, e.g. 5m. No limit by default")
 	agentCmd.Flags().String("tolerations", "", "Set the tolerations for Chaos Delegate components | Format: '[{\"key\":\"key1\",\"value\":\"value1\",\"operator\":\"Exist\",\"effect\":\"NoSchedule\",\"tolerationSeconds\":30}]'")
 
 	agentCmd.Flags().String("project-id", "", "Set the project-id to install Chaos Delegate for the particular project. To see the projects, apply litmusctl get projects")
@@ -291,6 +422,8 @@ func init() {
 	agentCmd.Flags().String("chaos-delegate-type", "external", "Set the chaos-delegate-type to external for external Chaos Delegates | Supported=external/internal")
 	agentCmd.Flags().String("node-selector", "", "Set the node-selector for Chaos Delegate components | Format: \"key1=value1,key2=value2\")")
 	agentCmd.Flags().String("namespace", "litmus", "Set the namespace for the Chaos Delegate installation")
+	agentCmd.Flags().StringToString("namespace-labels", nil, "Set the labels of the namespace, when it's created for the Chaos Delegate | Format: \"key1=value1,key2=value2\"")
+	agentCmd.Flags().StringToString("namespace-annotations", nil, "Set the annotations of the namespace, when it's created for the Chaos Delegate | Format: \"key1=value1,key2=value2\"")
 	agentCmd.Flags().String("service-account", "litmus", "Set the service account to be used by the Chaos Delegate")
 	agentCmd.Flags().Bool("skip-ssl", false, "Set whether Chaos Delegate will skip ssl/tls check (can be used for self-signed certs, if cert is not provided in portal)")
 	agentCmd.Flags().Bool("ns-exists", false, "Set the --ns-exists=false if the namespace mentioned in the --namespace flag is not existed else set it to --ns-exists=true | Note: Always set the boolean flag as --ns-exists=Boolean")
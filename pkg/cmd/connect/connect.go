@@ -30,6 +30,9 @@ var ConnectCmd = &cobra.Command{
 		#connect a chaos-delegate within a project
 		litmusctl connect chaos-delegate --name="new-chaos-delegate" --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --non-interactive
 
-		Note: The default location of the config file is $HOME/.litmusconfig, and can be overridden by a --config flag
+		#connect a ChaosHub to a project
+		litmusctl connect chaos-hub --name="my-hub" --repo-url="https://github.com/litmuschaos/chaos-charts" --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+		Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
 	`,
 }
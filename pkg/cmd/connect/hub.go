@@ -0,0 +1,113 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package connect
+
+import (
+	"os"
+
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/hub"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// hubCmd represents the chaos-hub command
+var hubCmd = &cobra.Command{
+	Use: "chaos-hub",
+	Short: `Connect a ChaosHub to a project.
+	Example(s):
+	#connect a public ChaosHub
+	litmusctl connect chaos-hub --name="my-hub" --repo-url="https://github.com/litmuschaos/chaos-charts" --branch="master" --project-id=""
+
+	#connect a private ChaosHub using a personal access token
+	litmusctl connect chaos-hub --name="my-hub" --repo-url="https://github.com/org/private-charts" --auth-type=token --token-file=./token --project-id=""
+
+	#connect a private ChaosHub using an SSH private key
+	litmusctl connect chaos-hub --name="my-hub" --repo-url="git@github.com:org/private-charts.git" --auth-type=ssh --ssh-private-key-file=$HOME/.ssh/id_rsa --project-id=""
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+`,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.QuietMessages()
+
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		var request model.CreateChaosHubRequest
+
+		request.ProjectID, err = cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if request.ProjectID == "" {
+			request.ProjectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		request.HubName, err = cmd.Flags().GetString("name")
+		utils.PrintError(err)
+		if request.HubName == "" {
+			utils.Red.Println("Error: --name flag is empty")
+			os.Exit(1)
+		}
+
+		request.RepoURL, err = cmd.Flags().GetString("repo-url")
+		utils.PrintError(err)
+		if request.RepoURL == "" {
+			utils.Red.Println("Error: --repo-url flag is empty")
+			os.Exit(1)
+		}
+
+		request.RepoBranch, err = cmd.Flags().GetString("branch")
+		utils.PrintError(err)
+
+		hubCredentials, err := hub.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		request.IsPrivate = hubCredentials.IsPrivate
+		request.AuthType = hubCredentials.AuthType
+		request.Token = hubCredentials.Token
+		request.UserName = hubCredentials.UserName
+		request.Password = hubCredentials.Password
+		request.SSHPrivateKey = hubCredentials.SSHPrivateKey
+		request.SSHPublicKey = hubCredentials.SSHPublicKey
+
+		chaosHub, err := apis.AddChaosHub(request, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ ChaosHub connection failed: " + err.Error())
+			os.Exit(1)
+		}
+
+		if utils.Quiet {
+			utils.PrintIDs(chaosHub.Data.AddChaosHub.ID)
+			return
+		}
+
+		utils.White_B.Println("\n🚀 ChaosHub/" + chaosHub.Data.AddChaosHub.HubName + " successfully connected 🎉")
+	},
+}
+
+func init() {
+	ConnectCmd.AddCommand(hubCmd)
+
+	hubCmd.Flags().String("project-id", "", "Set the project-id to connect the ChaosHub to the particular project. To see the projects, apply litmusctl get projects")
+	hubCmd.Flags().String("name", "", "Set the ChaosHub name")
+	hubCmd.Flags().String("repo-url", "", "Set the URL of the git repository of the ChaosHub")
+	hubCmd.Flags().String("branch", "master", "Set the branch of the git repository of the ChaosHub")
+	hub.AddCredentialFlags(hubCmd)
+}
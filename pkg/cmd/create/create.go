@@ -27,9 +27,18 @@ var CreateCmd = &cobra.Command{
 		#create a project
 		litmusctl create project --name new-proj
 
+		#invite a user to a project
+		litmusctl create project-member --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --user="john" --role=editor
+
 		#create a Chaos Scenario from a file
 		litmusctl create chaos-scenario -f chaos-scenario.yaml --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --chaos-delegate-id="d861b650-1549-4574-b2ba-ab754058dd04"
 
-		Note: The default location of the config file is $HOME/.litmusconfig, and can be overridden by a --config flag
+		#create a Resilience Probe from a file
+		litmusctl create probe -f probe.yaml --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+		#create an environment for Chaos Infrastructures
+		litmusctl create environment --name="prod" --type=production --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+		Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
 	`,
 }
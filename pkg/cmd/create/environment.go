@@ -0,0 +1,127 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package create
+
+import (
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// environmentCmd represents the environment command
+var environmentCmd = &cobra.Command{
+	Use: "environment",
+	Short: `Create an environment for the Chaos Infrastructures of a project
+	Example:
+	#create a production environment
+	litmusctl create environment --name="prod" --type=production --tags="team=sre" --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.QuietMessages()
+
+		// Fetch user credentials
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		// Handle blank input for project ID
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		var request types.CreateEnvironmentRequest
+
+		request.Name, err = cmd.Flags().GetString("name")
+		utils.PrintError(err)
+
+		if request.Name == "" {
+			request.Name, err = utils.PromptInput("Enter the environment name", "", utils.NotEmpty("Environment name"))
+			utils.PrintError(err)
+		}
+
+		request.EnvironmentID, err = cmd.Flags().GetString("id")
+		utils.PrintError(err)
+
+		if request.EnvironmentID == "" {
+			request.EnvironmentID = environmentID(request.Name)
+		}
+
+		envType, err := cmd.Flags().GetString("type")
+		utils.PrintError(err)
+
+		switch strings.ToLower(envType) {
+		case "production", "prod":
+			request.Type = types.ProductionEnvironment
+		case "non-production", "non_prod", "nonprod":
+			request.Type = types.NonProductionEnvironment
+		default:
+			utils.Red.Println("⛔ Invalid environment type " + envType + ", supported types are production/non-production")
+			os.Exit(1)
+		}
+
+		description, err := cmd.Flags().GetString("description")
+		utils.PrintError(err)
+
+		if description != "" {
+			request.Description = &description
+		}
+
+		request.Tags, err = cmd.Flags().GetStringSlice("tags")
+		utils.PrintError(err)
+
+		// Make API call
+		createdEnvironment, err := apis.CreateEnvironment(projectID, request, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Environment/" + request.Name + " failed to be created: " + err.Error())
+			os.Exit(1)
+		}
+
+		if utils.Quiet {
+			utils.PrintIDs(createdEnvironment.Data.CreateEnvironment.EnvironmentID)
+			return
+		}
+
+		utils.White_B.Println("\n🚀 Environment/" + createdEnvironment.Data.CreateEnvironment.Name + " with ID " + createdEnvironment.Data.CreateEnvironment.EnvironmentID + " successfully created 🎉")
+	},
+}
+
+// environmentID derives the ID of an environment from its name, the same way ChaosCenter does
+func environmentID(name string) string {
+	return strings.Trim(regexp.MustCompile(`[^a-z0-9]+`).ReplaceAllString(strings.ToLower(name), "_"), "_")
+}
+
+func init() {
+	CreateCmd.AddCommand(environmentCmd)
+
+	environmentCmd.Flags().String("project-id", "", "Set the project-id to create the environment for the particular project. To see the projects, apply litmusctl get projects")
+	environmentCmd.Flags().String("name", "", "Set the name of the environment")
+	environmentCmd.Flags().String("id", "", "Set the ID of the environment, derived from the name if not set")
+	environmentCmd.Flags().String("type", "non-production", "Set the type of the environment. One of:\nproduction|non-production")
+	environmentCmd.Flags().String("description", "", "Set the description of the environment")
+	environmentCmd.Flags().StringSlice("tags", []string{}, "Set the tags of the environment. For example: team=sre,region=us-east-1")
+}
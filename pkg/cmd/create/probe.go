@@ -0,0 +1,94 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package create
+
+import (
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// probeCmd represents the probe command
+var probeCmd = &cobra.Command{
+	Use: "probe",
+	Short: `Create a Resilience Probe
+	Example:
+	#create a Resilience Probe from a file
+	litmusctl create probe -f probe.yaml --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+	Supported probe types: httpProbe, cmdProbe, promProbe, k8sProbe
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.QuietMessages()
+
+		// Fetch user credentials
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		probeManifest, err := cmd.Flags().GetString("file")
+		utils.PrintError(err)
+
+		if probeManifest == "" {
+			utils.Red.Println("⛔ --file flag is empty. Please provide the Resilience Probe manifest.")
+			os.Exit(1)
+		}
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		// Handle blank input for project ID
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		// Parse probe manifest and populate probeRequest
+		var probeRequest types.ProbeRequest
+		err = utils.ParseProbeManifest(probeManifest, &probeRequest)
+		if err != nil {
+			utils.Red.Println("❌ Error parsing Resilience Probe manifest: " + err.Error())
+			os.Exit(1)
+		}
+
+		// Make API call
+		createdProbe, err := apis.CreateProbe(projectID, probeRequest, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Resilience Probe/" + probeRequest.Name + " failed to be created: " + err.Error())
+			os.Exit(1)
+		}
+
+		if utils.Quiet {
+			utils.PrintIDs(createdProbe.Data.AddProbe.Name)
+			return
+		}
+
+		utils.White_B.Println("\n🚀 Resilience Probe/" + createdProbe.Data.AddProbe.Name + " of type " + string(createdProbe.Data.AddProbe.Type) + " successfully created 🎉")
+	},
+}
+
+func init() {
+	CreateCmd.AddCommand(probeCmd)
+
+	probeCmd.Flags().String("project-id", "", "Set the project-id to create Resilience Probe for the particular project. To see the projects, apply litmusctl get projects")
+	probeCmd.Flags().StringP("file", "f", "", "The manifest file for the Resilience Probe")
+}
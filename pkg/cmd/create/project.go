@@ -16,8 +16,6 @@ limitations under the License.
 package create
 
 import (
-	"fmt"
-
 	"github.com/litmuschaos/litmusctl/pkg/apis"
 	"github.com/litmuschaos/litmusctl/pkg/utils"
 
@@ -32,9 +30,11 @@ var projectCmd = &cobra.Command{
 	#create a project
 	litmusctl create project --name new-proj
 
-	Note: The default location of the config file is $HOME/.litmusconfig, and can be overridden by a --config flag
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
 	`,
 	Run: func(cmd *cobra.Command, args []string) {
+		utils.QuietMessages()
+
 		credentials, err := utils.GetCredentials(cmd)
 		utils.PrintError(err)
 
@@ -42,12 +42,16 @@ var projectCmd = &cobra.Command{
 		utils.PrintError(err)
 
 		if projectName == "" {
-			utils.White_B.Print("\nEnter a project name: ")
-			fmt.Scanln(&projectName)
+			projectName, err = utils.PromptInput("Enter a project name", "", utils.NotEmpty("Project name"))
+			utils.PrintError(err)
 		}
 
-		_, err = apis.CreateProjectRequest(projectName, credentials)
+		project, err := apis.CreateProjectRequest(projectName, credentials)
 		utils.PrintError(err)
+
+		if utils.Quiet {
+			utils.PrintIDs(project.Data.ID)
+		}
 	},
 }
 
@@ -0,0 +1,102 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package create
+
+import (
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// projectMemberCmd represents the project-member command
+var projectMemberCmd = &cobra.Command{
+	Use: "project-member",
+	Short: `Invite a user to a project
+	Example:
+	#invite a user to a project as an editor
+	litmusctl create project-member --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --user="john" --role=editor
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.QuietMessages()
+
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		username, err := cmd.Flags().GetString("user")
+		utils.PrintError(err)
+
+		if username == "" {
+			username, err = utils.PromptInput("Enter the username", "", utils.NotEmpty("Username"))
+			utils.PrintError(err)
+		}
+
+		roleFlag, err := cmd.Flags().GetString("role")
+		utils.PrintError(err)
+
+		role, err := utils.GetMemberRole(roleFlag)
+		utils.PrintError(err)
+
+		// Resolve the username to the user ID expected by the invitation API
+		users, err := apis.ListInvitableUsers(projectID, credentials)
+		utils.PrintError(err)
+
+		var userID string
+		for _, user := range users {
+			if user.Username == username {
+				userID = user.ID
+			}
+		}
+		if userID == "" {
+			utils.Red.Println("⛔ User " + username + " doesn't exist or is already a member of the project")
+			os.Exit(1)
+		}
+
+		_, err = apis.SendInvitation(projectID, userID, role, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Error in inviting " + username + ": " + err.Error())
+			os.Exit(1)
+		}
+
+		if utils.Quiet {
+			utils.PrintIDs(username)
+			return
+		}
+
+		utils.White_B.Println("\n🚀 " + username + " successfully invited to the project as " + role + ".")
+	},
+}
+
+func init() {
+	CreateCmd.AddCommand(projectMemberCmd)
+
+	projectMemberCmd.Flags().String("project-id", "", "Set the project-id to invite the user to. To see the projects, apply litmusctl get projects")
+	projectMemberCmd.Flags().String("user", "", "Set the username of the user to invite")
+	projectMemberCmd.Flags().String("role", "viewer", "Set the role of the user in the project. One of:\neditor|viewer")
+}
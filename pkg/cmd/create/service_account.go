@@ -0,0 +1,136 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package create
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// serviceAccountCmd represents the service-account command
+var serviceAccountCmd = &cobra.Command{
+	Use: "service-account",
+	Short: `Create a service account, a non-human identity for automations like CI systems
+	Example:
+	#create a service account which can edit the Chaos Scenarios of a project
+	litmusctl create service-account --name="ci" --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --role=editor
+
+	The service account is a user of ChaosCenter named sa-<name>, which is a member of the project with the given role.
+	It has no password, it authenticates with the API tokens issued with litmusctl create service-account-token.
+	Creating users is reserved to the admin of ChaosCenter.
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.QuietMessages()
+
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		name, err := cmd.Flags().GetString("name")
+		utils.PrintError(err)
+
+		if name == "" {
+			utils.Red.Println("⛔ --name flag is empty")
+			os.Exit(1)
+		}
+		username := apis.ServiceAccountUsername(name)
+
+		roleFlag, err := cmd.Flags().GetString("role")
+		utils.PrintError(err)
+
+		role, err := utils.GetMemberRole(roleFlag)
+		utils.PrintError(err)
+
+		// The password is only used to accept the invitation to the project, and is then discarded
+		password, err := randomPassword()
+		utils.PrintError(err)
+
+		user, err := apis.CreateUser(username, password, "Service account "+name, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Error in creating the service account " + username + ": " + err.Error())
+			os.Exit(1)
+		}
+
+		_, err = apis.SendInvitation(projectID, user.ID, role, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Error in adding the service account " + username + " to the project: " + err.Error())
+			os.Exit(1)
+		}
+
+		auth, err := apis.Auth(types.AuthInput{Endpoint: credentials.Endpoint, Username: username, Password: password})
+		if err == nil {
+			_, err = apis.AcceptInvitation(projectID, user.ID, types.Credentials{Endpoint: credentials.Endpoint, Username: username, Token: auth.AccessToken})
+		}
+		if err != nil {
+			utils.Red.Println("\n❌ Error in accepting the invitation of the service account " + username + " to the project: " + err.Error())
+			os.Exit(1)
+		}
+
+		if utils.Quiet {
+			utils.PrintIDs(username)
+			return
+		}
+
+		utils.White_B.Println("\n🚀 Service account " + username + " successfully created with the " + role + " role in the project.")
+		utils.White_B.Println("\nIssue a token for it with: litmusctl create service-account-token --service-account=" + username + " --project-id=" + projectID)
+	},
+}
+
+// randomPassword generates a password matching the password policy of ChaosCenter: 16 characters
+// with upper and lower case letters, digits and special characters
+func randomPassword() (string, error) {
+	classes := []string{"ABCDEFGHJKLMNPQRSTUVWXYZ", "abcdefghijkmnopqrstuvwxyz", "23456789", "!@#$%^&*"}
+	var password []byte
+	for i := 0; i < 16; i++ {
+		// One character of each class, then random ones
+		class := classes[i%len(classes)]
+		if i >= len(classes) {
+			class = classes[0] + classes[1] + classes[2]
+		}
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(class))))
+		if err != nil {
+			return "", errors.New("unable to generate the password of the service account: " + err.Error())
+		}
+		password = append(password, class[n.Int64()])
+	}
+	return string(password), nil
+}
+
+func init() {
+	CreateCmd.AddCommand(serviceAccountCmd)
+
+	serviceAccountCmd.Flags().String("project-id", "", "Set the project-id the service account is a member of. To see the projects, apply litmusctl get projects")
+	serviceAccountCmd.Flags().String("name", "", "Set the name of the service account, its username is prefixed with "+apis.ServiceAccountPrefix)
+	serviceAccountCmd.Flags().String("role", "viewer", "Set the role of the service account in the project. One of:\neditor|viewer")
+}
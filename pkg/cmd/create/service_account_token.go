@@ -0,0 +1,102 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package create
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// serviceAccountTokenCmd represents the service-account-token command
+var serviceAccountTokenCmd = &cobra.Command{
+	Use: "service-account-token",
+	Short: `Issue an API token of a service account
+	Example:
+	#issue a token of the ci service account, expiring in 90 days
+	litmusctl create service-account-token --service-account="ci" --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --name="github-actions" --expiration-days=90
+
+	The token is printed on stdout, use it with litmusctl --endpoint and --token, or the LITMUSCTL_TOKEN environment variable.
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		utils.QuietMessages()
+
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		serviceAccount, err := cmd.Flags().GetString("service-account")
+		utils.PrintError(err)
+
+		if serviceAccount == "" {
+			utils.Red.Println("⛔ --service-account flag is empty")
+			os.Exit(1)
+		}
+
+		name, err := cmd.Flags().GetString("name")
+		utils.PrintError(err)
+
+		if name == "" {
+			utils.Red.Println("⛔ --name flag is empty")
+			os.Exit(1)
+		}
+
+		days, err := cmd.Flags().GetInt("expiration-days")
+		utils.PrintError(err)
+
+		if days < 1 {
+			utils.Red.Println("⛔ --expiration-days should be greater than 0")
+			os.Exit(1)
+		}
+
+		member, err := apis.GetServiceAccount(projectID, serviceAccount, credentials)
+		utils.PrintError(err)
+
+		token, err := apis.CreateAPIToken(member.UserID, name, days, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Error in issuing the token of " + member.UserName + ": " + err.Error())
+			os.Exit(1)
+		}
+
+		// Only the token goes to stdout, so that it can be captured by scripts
+		utils.White_B.Fprintln(os.Stderr, "🚀 Token "+name+" of "+member.UserName+" successfully issued, it expires on "+time.Now().AddDate(0, 0, days).Format("January 2, 2006")+".")
+		fmt.Println(token)
+	},
+}
+
+func init() {
+	CreateCmd.AddCommand(serviceAccountTokenCmd)
+
+	serviceAccountTokenCmd.Flags().String("project-id", "", "Set the project-id the service account is a member of. To see the projects, apply litmusctl get projects")
+	serviceAccountTokenCmd.Flags().String("service-account", "", "Set the name of the service account. To see the service accounts, apply litmusctl get service-accounts")
+	serviceAccountTokenCmd.Flags().String("name", "", "Set the name of the token, e.g. the CI system using it")
+	serviceAccountTokenCmd.Flags().Int("expiration-days", 30, "Set the number of days after which the token expires")
+}
@@ -16,13 +16,17 @@ limitations under the License.
 package create
 
 import (
-	"fmt"
+	"context"
+	"errors"
 	"os"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/gorhill/cronexpr"
 	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/types"
 	"github.com/litmuschaos/litmusctl/pkg/utils"
 
 	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
@@ -38,9 +42,13 @@ var workflowCmd = &cobra.Command{
 	#create a Chaos Scenario
 	litmusctl create chaos-scenario -f chaos-scenario.yaml --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --chaos-delegate-id="1c9c5801-8789-4ac9-bf5f-32649b707a5c"
 
-	Note: The default location of the config file is $HOME/.litmusconfig, and can be overridden by a --config flag
+	#create a Chaos Scenario by selecting its Chaos Faults, target application, probes and schedule, saving the generated manifest
+	litmusctl create chaos-scenario --interactive -f chaos-scenario.yaml --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
 	`,
 	Run: func(cmd *cobra.Command, args []string) {
+		utils.QuietMessages()
 
 		// Fetch user credentials
 		credentials, err := utils.GetCredentials(cmd)
@@ -51,32 +59,25 @@ var workflowCmd = &cobra.Command{
 		workflowManifest, err := cmd.Flags().GetString("file")
 		utils.PrintError(err)
 
+		interactive, err := cmd.Flags().GetBool("interactive")
+		utils.PrintError(err)
+
 		chaosWorkFlowRequest.ProjectID, err = cmd.Flags().GetString("project-id")
 		utils.PrintError(err)
 
 		// Handle blank input for project ID
 		if chaosWorkFlowRequest.ProjectID == "" {
-			utils.White_B.Print("\nEnter the Project ID: ")
-			fmt.Scanln(&chaosWorkFlowRequest.ProjectID)
-
-			if chaosWorkFlowRequest.ProjectID == "" {
-				utils.Red.Println("⛔ Project ID can't be empty!!")
-				os.Exit(1)
-			}
+			chaosWorkFlowRequest.ProjectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
 		}
 
 		chaosWorkFlowRequest.ClusterID, err = cmd.Flags().GetString("chaos-delegate-id")
 		utils.PrintError(err)
 
-		// Handle blank input for Chaos Delegate ID
-		if chaosWorkFlowRequest.ClusterID == "" {
-			utils.White_B.Print("\nEnter the Chaos Delegate ID: ")
-			fmt.Scanln(&chaosWorkFlowRequest.ClusterID)
-
-			if chaosWorkFlowRequest.ClusterID == "" {
-				utils.Red.Println("⛔ Chaos Delegate ID can't be empty!!")
-				os.Exit(1)
-			}
+		// Handle blank input for Chaos Delegate ID, it's selected from a list in interactive mode
+		if chaosWorkFlowRequest.ClusterID == "" && !interactive {
+			chaosWorkFlowRequest.ClusterID, err = picker.AgentID(chaosWorkFlowRequest.ProjectID, credentials)
+			utils.PrintError(err)
 		}
 
 		// Perform authorization
@@ -99,11 +100,16 @@ var workflowCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		// Parse workflow manifest and populate chaosWorkFlowInput
-		err = utils.ParseWorkflowManifest(workflowManifest, &chaosWorkFlowRequest)
-		if err != nil {
-			utils.Red.Println("❌ Error parsing Chaos Scenario manifest: " + err.Error())
-			os.Exit(1)
+		if interactive {
+			// Generate the manifest from the choices, the file is where it's saved
+			createWorkflowInteractively(&chaosWorkFlowRequest, workflowManifest, credentials)
+		} else {
+			// Parse workflow manifest and populate chaosWorkFlowInput
+			err = utils.ParseWorkflowManifest(workflowManifest, &chaosWorkFlowRequest)
+			if err != nil {
+				utils.Red.Println("❌ Error parsing Chaos Scenario manifest: " + err.Error())
+				os.Exit(1)
+			}
 		}
 
 		// Make API call
@@ -121,21 +127,85 @@ var workflowCmd = &cobra.Command{
 		}
 
 		// Successful creation
-		utils.White_B.Println("\n🚀 Chaos Scenario/" + createdWorkflow.Data.CreateChaosWorkflow.WorkflowName + " successfully created 🎉")
-		if createdWorkflow.Data.CreateChaosWorkflow.CronSyntax == "" {
-			utils.White_B.Println("\nThe next run of this Chaos Scenario will be scheduled immediately.")
+		if utils.Quiet {
+			utils.PrintIDs(createdWorkflow.Data.CreateChaosWorkflow.WorkflowID)
 		} else {
-			utils.White_B.Println(
-				"\nThe next run of this Chaos Scenario will be scheduled at " +
-					cronexpr.MustParse(createdWorkflow.Data.CreateChaosWorkflow.CronSyntax).Next(time.Now()).Format("January 2nd 2006, 03:04:05 pm"))
+			utils.White_B.Println("\n🚀 Chaos Scenario/" + createdWorkflow.Data.CreateChaosWorkflow.WorkflowName + " successfully created 🎉")
+			if createdWorkflow.Data.CreateChaosWorkflow.CronSyntax == "" {
+				utils.White_B.Println("\nThe next run of this Chaos Scenario will be scheduled immediately.")
+			} else {
+				utils.White_B.Println(
+					"\nThe next run of this Chaos Scenario will be scheduled at " +
+						cronexpr.MustParse(createdWorkflow.Data.CreateChaosWorkflow.CronSyntax).Next(time.Now()).Format("January 2nd 2006, 03:04:05 pm"))
+			}
+		}
+
+		wait, err := cmd.Flags().GetBool("wait")
+		utils.PrintError(err)
+		if wait && createdWorkflow.Data.CreateChaosWorkflow.CronSyntax == "" {
+			timeout, err := cmd.Flags().GetDuration("timeout")
+			utils.PrintError(err)
+			waitForWorkflowRun(chaosWorkFlowRequest.ProjectID, createdWorkflow.Data.CreateChaosWorkflow.WorkflowID, timeout, credentials)
 		}
 	},
 }
 
+// waitForWorkflowRun waits for the run of the Chaos Scenario to complete, printing its progress, and
+// exits with an error unless the run succeeds
+func waitForWorkflowRun(projectID string, workflowID string, timeout time.Duration, credentials types.Credentials) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	utils.White_B.Println("\n⏳ Waiting for the Chaos Scenario run to complete...")
+	spinner := utils.StartSpinner("Waiting for the Chaos Scenario run")
+
+	var phase string
+	var finishedRun *model.WorkflowRun
+	err := apis.WatchWorkflowRuns(ctx, projectID, credentials, func(run *model.WorkflowRun) bool {
+		if run.WorkflowID != workflowID {
+			return true
+		}
+		if apis.IsWorkflowRunFinished(run) {
+			finishedRun = run
+			return false
+		}
+		if run.Phase != phase {
+			phase = run.Phase
+			if spinner.Active() {
+				spinner.Update("Chaos Scenario run " + run.WorkflowRunID + " is " + phase)
+			} else {
+				utils.White.Println("Chaos Scenario run " + run.WorkflowRunID + " is " + phase)
+			}
+		}
+		return true
+	})
+	spinner.Stop()
+	if errors.Is(err, context.DeadlineExceeded) {
+		utils.Red.Println("\n❌ The Chaos Scenario run didn't complete within " + timeout.String())
+		os.Exit(1)
+	}
+	utils.PrintError(err)
+
+	if model.WorkflowRunStatus(finishedRun.Phase) != model.WorkflowRunStatusSucceeded {
+		utils.Red.Println("\n❌ Chaos Scenario run " + finishedRun.WorkflowRunID + " " + finishedRun.Phase)
+		os.Exit(1)
+	}
+
+	var resiliencyScore float64
+	if finishedRun.ResiliencyScore != nil {
+		resiliencyScore = *finishedRun.ResiliencyScore
+	}
+	utils.White_B.Println("\n✅ Chaos Scenario run " + finishedRun.WorkflowRunID + " succeeded with a resiliency score of " + strconv.FormatFloat(resiliencyScore, 'f', 2, 64))
+}
+
 func init() {
 	CreateCmd.AddCommand(workflowCmd)
 
 	workflowCmd.Flags().String("project-id", "", "Set the project-id to create Chaos Scenario for the particular project. To see the projects, apply litmusctl get projects")
 	workflowCmd.Flags().String("chaos-delegate-id", "", "Set the chaos-delegate-id to create Chaos Scenario for the particular Chaos Delegate. To see the Chaos Delegates, apply litmusctl get chaos-delegates")
-	workflowCmd.Flags().StringP("file", "f", "", "The manifest file for the Chaos Scenario")
+	workflowCmd.Flags().StringP("file", "f", "", "The manifest file for the Chaos Scenario. With --interactive, the file the generated manifest is saved to")
+	workflowCmd.Flags().BoolP("interactive", "i", false, "Create the Chaos Scenario by selecting its Chaos Delegate, Chaos Faults, target application, resilience probes and schedule, and review the generated manifest before it's submitted")
+	workflowCmd.Flags().Bool("wait", false, "Wait for the run of a non-cron Chaos Scenario to complete, and fail unless it succeeds. The run is streamed from the ChaosCenter, or polled every 5 seconds where it can't stream it")
+	workflowCmd.Flags().Duration("timeout", 30*time.Minute, "Set the time to wait for the Chaos Scenario run with --wait")
+	workflowCmd.Flags().BoolVar(&apis.CompressUploads, "compress", false, "Compress the Chaos Scenario manifest with gzip, for large manifests exceeding the request body limit of the ChaosCenter. The ChaosCenter has to accept compressed requests, e.g. with its ingress decompressing them")
 }
@@ -0,0 +1,275 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package create
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/gorhill/cronexpr"
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// hubFault is a Chaos Fault offered by a ChaosHub
+type hubFault struct {
+	chart string
+	name  string
+	desc  string
+}
+
+// createWorkflowInteractively walks through the choices of a Chaos Scenario, generates its manifest and
+// shows it for review. The manifest is saved to manifestFile, if one is given, and the request is
+// populated from it once confirmed.
+func createWorkflowInteractively(chaosWorkFlowRequest *model.ChaosWorkFlowRequest, manifestFile string, credentials types.Credentials) {
+	projectID := chaosWorkFlowRequest.ProjectID
+	var spec utils.ScenarioSpec
+
+	// Chaos Delegate
+	if chaosWorkFlowRequest.ClusterID == "" {
+		chaosWorkFlowRequest.ClusterID = selectAgent(projectID, credentials)
+	}
+	spec.Namespace = promptString("Namespace of the Chaos Delegate", utils.DefaultNs, validateNamespace)
+
+	// Chaos Faults
+	hubName := selectHub(projectID, credentials)
+	for _, fault := range selectFaults(projectID, hubName, credentials) {
+		details, err := apis.GetExperimentDetails(model.ExperimentRequest{
+			ProjectID:      projectID,
+			ChartName:      fault.chart,
+			ExperimentName: fault.name,
+			HubName:        hubName,
+		}, credentials)
+		utils.PrintError(err)
+
+		weight := promptString("Weight of Chaos Fault/"+fault.name+" in the resiliency score [Range: 0-10]", strconv.Itoa(utils.DefaultFaultWeight), func(input string) error {
+			if weight, err := strconv.Atoi(input); err != nil || weight < 0 || weight > 10 {
+				return errors.New("the weight should be a number from 0 to 10")
+			}
+			return nil
+		})
+		weightValue, _ := strconv.Atoi(weight)
+
+		spec.Faults = append(spec.Faults, utils.ScenarioFault{
+			Name:       fault.name,
+			Experiment: details.Data.ExperimentDetails.ExperimentDetails,
+			Engine:     details.Data.ExperimentDetails.EngineDetails,
+			Weight:     weightValue,
+		})
+	}
+
+	// Target application
+	utils.White_B.Println("\nEnter the details of the target application")
+	spec.AppNamespace = promptString("Namespace", "default", validateNamespace)
+	spec.AppLabel = promptString("Label, e.g. app=nginx", "", func(input string) error {
+		parts := strings.SplitN(input, "=", 2)
+		if len(parts) != 2 || len(validation.IsQualifiedName(parts[0])) > 0 || len(validation.IsValidLabelValue(parts[1])) > 0 {
+			return errors.New("the label should be a valid key=value pair")
+		}
+		return nil
+	})
+	spec.AppKind = utils.AppKinds[selectOne("application kind", utils.AppKinds, 0)]
+
+	// Resilience probes
+	spec.Probes = selectProbes(projectID, credentials)
+
+	// Schedule
+	spec.Schedule = promptString("Cron schedule, e.g. \"0 * * * *\", or empty to run the Chaos Scenario once", "", func(input string) error {
+		if input == "" {
+			return nil
+		}
+		if _, err := cronexpr.Parse(input); err != nil {
+			return errors.New("invalid cron schedule: " + err.Error())
+		}
+		return nil
+	})
+
+	spec.Name = promptString("Chaos Scenario name", spec.Faults[0].Name+"-scenario", func(input string) error {
+		if errs := validation.IsDNS1123Subdomain(input); len(errs) > 0 {
+			return errors.New("invalid Chaos Scenario name: " + strings.Join(errs, ", "))
+		}
+		return nil
+	})
+
+	manifest, err := utils.GenerateScenarioManifest(spec)
+	utils.PrintError(err)
+
+	utils.White_B.Println("\nChaos Scenario manifest:")
+	fmt.Println(string(manifest))
+
+	if manifestFile != "" {
+		err = ioutil.WriteFile(manifestFile, manifest, 0644)
+		utils.PrintError(err)
+		utils.White.Println("The manifest is saved to " + manifestFile)
+	}
+
+	confirmed, err := utils.PromptConfirm("Create the Chaos Scenario?", false)
+	utils.PrintError(err)
+	if !confirmed {
+		utils.White_B.Println("\n❌ Chaos Scenario/" + spec.Name + " not created")
+		os.Exit(1)
+	}
+
+	err = utils.ParseWorkflowManifestBody(manifest, chaosWorkFlowRequest)
+	if err != nil {
+		utils.Red.Println("❌ Error parsing Chaos Scenario manifest: " + err.Error())
+		os.Exit(1)
+	}
+}
+
+// selectAgent lists the Chaos Delegates of the project, and returns the ID of the selected one
+func selectAgent(projectID string, credentials types.Credentials) string {
+	agents, err := apis.GetAgentList(credentials, projectID)
+	utils.PrintError(err)
+
+	if len(agents.Data.GetAgent) == 0 {
+		utils.Red.Println("⛔ No Chaos Delegates found in the project. To connect one, apply litmusctl connect chaos-delegate")
+		os.Exit(1)
+	}
+
+	var options []string
+	for _, agent := range agents.Data.GetAgent {
+		status := "INACTIVE"
+		if agent.IsActive {
+			status = "ACTIVE"
+		}
+		options = append(options, agent.AgentName+" ("+status+")")
+	}
+	agent := agents.Data.GetAgent[selectOne("Chaos Delegate", options, 0)]
+	if !agent.IsActive {
+		utils.Red.Println("⚠️  Chaos Delegate/" + agent.AgentName + " is inactive, the Chaos Scenario won't run until it's active again")
+	}
+	return agent.ClusterID
+}
+
+// selectHub returns the name of the ChaosHub to pick the Chaos Faults from, the default one is proposed first
+func selectHub(projectID string, credentials types.Credentials) string {
+	hubs, err := apis.ListHubStatus(projectID, credentials)
+	utils.PrintError(err)
+
+	var options []string
+	defaultHub := 0
+	for _, hub := range hubs.Data.HubStatus {
+		if !hub.IsAvailable {
+			continue
+		}
+		if hub.HubName == utils.DefaultHubName {
+			defaultHub = len(options)
+		}
+		options = append(options, hub.HubName)
+	}
+	if len(options) == 0 {
+		return utils.DefaultHubName
+	}
+	return options[selectOne("ChaosHub", options, defaultHub)]
+}
+
+// selectFaults lists the Chaos Faults of the ChaosHub, and returns the selected ones
+func selectFaults(projectID string, hubName string, credentials types.Credentials) []hubFault {
+	charts, err := apis.ListCharts(projectID, hubName, credentials)
+	utils.PrintError(err)
+
+	var faults []hubFault
+	for _, chart := range charts.Data.Charts {
+		if chart.Metadata == nil || chart.PackageInfo == nil {
+			continue
+		}
+		for _, fault := range chart.PackageInfo.Experiments {
+			faults = append(faults, hubFault{chart: chart.Metadata.Name, name: fault.Name, desc: fault.Desc})
+		}
+	}
+	if len(faults) == 0 {
+		utils.Red.Println("⛔ No Chaos Faults found in ChaosHub/" + hubName)
+		os.Exit(1)
+	}
+
+	var options []string
+	for _, fault := range faults {
+		desc := strings.TrimSpace(fault.desc)
+		if i := strings.Index(desc, "\n"); i >= 0 {
+			desc = desc[:i] + "..."
+		}
+		options = append(options, fault.chart+"/"+fault.name+"  "+desc)
+	}
+
+	var selected []hubFault
+	for _, index := range selectMany("Chaos Faults of ChaosHub/"+hubName+" to run one after another", options, false) {
+		selected = append(selected, faults[index])
+	}
+	return selected
+}
+
+// selectProbes lists the resilience probes of the project, and returns the selected ones with their mode
+func selectProbes(projectID string, credentials types.Credentials) []utils.ScenarioProbe {
+	probes, err := apis.ListProbes(projectID, nil, credentials)
+	if err != nil || len(probes.Data.Probes) == 0 {
+		// The resilience probes are only offered by the ChaosCenters which support them
+		return nil
+	}
+
+	var options []string
+	for _, probe := range probes.Data.Probes {
+		options = append(options, probe.Name+" ("+string(probe.Type)+")")
+	}
+
+	var selected []utils.ScenarioProbe
+	for _, index := range selectMany("Resilience probes to attach to every Chaos Fault, or none", options, true) {
+		name := probes.Data.Probes[index].Name
+		mode := utils.ProbeModes[selectOne("mode of the probe "+name, utils.ProbeModes, 3)]
+		selected = append(selected, utils.ScenarioProbe{Name: name, Mode: mode})
+	}
+	return selected
+}
+
+// promptString asks for a value until it's valid, the default value is used for an empty input
+func promptString(label string, defaultValue string, validate utils.Validator) string {
+	answer, err := utils.PromptInput(label, defaultValue, validate)
+	utils.PrintError(err)
+	return answer
+}
+
+// selectOne asks for one of the options, and returns its index
+func selectOne(label string, options []string, defaultIndex int) int {
+	if len(options) == 1 {
+		utils.White_B.Println("\nUsing the " + label + " " + options[0])
+		return 0
+	}
+
+	index, err := utils.PromptSelect("Select the "+label, options, defaultIndex)
+	utils.PrintError(err)
+	return index
+}
+
+// selectMany asks for any of the options, and returns their indexes
+func selectMany(label string, options []string, allowEmpty bool) []int {
+	indexes, err := utils.PromptMultiSelect(label, options, allowEmpty)
+	utils.PrintError(err)
+	return indexes
+}
+
+func validateNamespace(input string) error {
+	if errs := validation.IsDNS1123Label(input); len(errs) > 0 {
+		return errors.New("invalid namespace: " + strings.Join(errs, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,324 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package dashboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// maxRuns is the number of the latest Chaos Scenario runs kept by the dashboard
+const maxRuns = 50
+
+// DashboardCmd represents the dashboard command
+var DashboardCmd = &cobra.Command{
+	Use: "dashboard",
+	Short: `Display a live dashboard of the Chaos Delegates and the Chaos Scenario runs of a project
+	Example:
+	#open the dashboard of a project
+	litmusctl dashboard --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+	The Chaos Delegates are refreshed every --refresh interval, and the Chaos Scenario runs are streamed from the ChaosCenter,
+	or polled every 5 seconds where it can't stream them. Select a run with the arrow keys, then press r to re-run its
+	Chaos Scenario, s to stop it, or q to quit.
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		refresh, err := cmd.Flags().GetDuration("refresh")
+		utils.PrintError(err)
+
+		if refresh < time.Second {
+			utils.Red.Println("⛔ --refresh should be at least 1s")
+			os.Exit(1)
+		}
+
+		if !term.IsTerminal(int(os.Stdin.Fd())) || !term.IsTerminal(int(os.Stdout.Fd())) {
+			utils.PrintError(errors.New("the dashboard requires a terminal, use litmusctl get chaos-delegates and litmusctl get chaos-scenario-runs --watch instead"))
+		}
+
+		d := &dashboard{projectID: projectID, credentials: credentials, redraw: make(chan struct{}, 1)}
+		utils.PrintError(d.run(refresh))
+	},
+}
+
+// dashboard holds the state displayed by the dashboard, updated by the refresh and watch goroutines
+type dashboard struct {
+	projectID   string
+	credentials types.Credentials
+
+	mu        sync.Mutex
+	delegates []apis.AgentDetails
+	runs      []*model.WorkflowRun
+	selected  int
+	updatedAt time.Time
+	status    string
+	// confirm is the action waiting for a y/n answer, if any
+	confirm func() string
+
+	redraw chan struct{}
+}
+
+// run displays the dashboard until q or Ctrl-C is pressed
+func (d *dashboard) run(refresh time.Duration) error {
+	state, err := term.MakeRaw(int(os.Stdin.Fd()))
+	if err != nil {
+		return err
+	}
+	// Switch to the alternate screen and hide the cursor, the terminal is restored on exit
+	fmt.Print("\x1b[?1049h\x1b[?25l")
+	defer func() {
+		fmt.Print("\x1b[?25h\x1b[?1049l")
+		_ = term.Restore(int(os.Stdin.Fd()), state)
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go d.refreshDelegates(ctx, refresh)
+	go d.watchRuns(ctx)
+
+	keys := make(chan []byte)
+	go readKeys(keys)
+
+	d.requestRedraw()
+	for {
+		select {
+		case <-d.redraw:
+			d.draw()
+		case key := <-keys:
+			if !d.handleKey(key) {
+				return nil
+			}
+			d.requestRedraw()
+		}
+	}
+}
+
+// requestRedraw schedules a redraw of the screen, without blocking when one is already pending
+func (d *dashboard) requestRedraw() {
+	select {
+	case d.redraw <- struct{}{}:
+	default:
+	}
+}
+
+// refreshDelegates fetches the Chaos Delegates of the project every refresh interval
+func (d *dashboard) refreshDelegates(ctx context.Context, refresh time.Duration) {
+	ticker := time.NewTicker(refresh)
+	defer ticker.Stop()
+	for {
+		agents, err := apis.GetAgentList(d.credentials, d.projectID)
+
+		d.mu.Lock()
+		if err != nil {
+			d.status = "❌ Error in fetching the Chaos Delegates: " + err.Error()
+		} else {
+			d.delegates = agents.Data.GetAgent
+			d.updatedAt = time.Now()
+		}
+		d.mu.Unlock()
+		d.requestRedraw()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// watchRuns keeps the latest Chaos Scenario runs up to date
+func (d *dashboard) watchRuns(ctx context.Context) {
+	err := apis.WatchWorkflowRuns(ctx, d.projectID, d.credentials, func(run *model.WorkflowRun) bool {
+		d.mu.Lock()
+		d.updateRun(run)
+		d.updatedAt = time.Now()
+		d.mu.Unlock()
+		d.requestRedraw()
+		return true
+	})
+	if err != nil && ctx.Err() == nil {
+		d.mu.Lock()
+		d.status = "❌ Error in watching the Chaos Scenario runs: " + err.Error()
+		d.mu.Unlock()
+		d.requestRedraw()
+	}
+}
+
+// updateRun adds or replaces the run, keeping the runs ordered from the latest update. The
+// selection stays on the same run.
+func (d *dashboard) updateRun(run *model.WorkflowRun) {
+	var selectedID string
+	if d.selected < len(d.runs) {
+		selectedID = d.runs[d.selected].WorkflowRunID
+	}
+
+	replaced := false
+	for i := range d.runs {
+		if d.runs[i].WorkflowRunID == run.WorkflowRunID {
+			d.runs[i], replaced = run, true
+		}
+	}
+	if !replaced {
+		d.runs = append(d.runs, run)
+	}
+	sort.SliceStable(d.runs, func(i, j int) bool {
+		return lastUpdated(d.runs[i]) > lastUpdated(d.runs[j])
+	})
+	if len(d.runs) > maxRuns {
+		d.runs = d.runs[:maxRuns]
+	}
+
+	for i := range d.runs {
+		if d.runs[i].WorkflowRunID == selectedID {
+			d.selected = i
+		}
+	}
+	if d.selected >= len(d.runs) {
+		d.selected = len(d.runs) - 1
+	}
+	if d.selected < 0 {
+		d.selected = 0
+	}
+}
+
+func lastUpdated(run *model.WorkflowRun) int64 {
+	seconds, _ := strconv.ParseInt(run.LastUpdated, 10, 64)
+	return seconds
+}
+
+// readKeys sends the key presses read from the terminal, escape sequences like the arrow keys are
+// read at once
+func readKeys(keys chan<- []byte) {
+	buf := make([]byte, 16)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			close(keys)
+			return
+		}
+		key := make([]byte, n)
+		copy(key, buf[:n])
+		keys <- key
+	}
+}
+
+// handleKey applies the key press, it returns false to quit
+func (d *dashboard) handleKey(key []byte) bool {
+	if key == nil {
+		return false
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.confirm != nil {
+		confirm := d.confirm
+		d.confirm = nil
+		if string(key) == "y" || string(key) == "Y" {
+			d.status = "⏳ Working..."
+			// The action talks to the ChaosCenter, the state is unlocked meanwhile
+			go func() {
+				status := confirm()
+				d.mu.Lock()
+				d.status = status
+				d.mu.Unlock()
+				d.requestRedraw()
+			}()
+		} else {
+			d.status = "✋ Cancelled"
+		}
+		return true
+	}
+
+	switch string(key) {
+	case "q", "Q", "\x03":
+		return false
+	case "\x1b[A", "k":
+		if d.selected > 0 {
+			d.selected--
+		}
+	case "\x1b[B", "j":
+		if d.selected < len(d.runs)-1 {
+			d.selected++
+		}
+	case "r":
+		if run := d.selectedRun(); run != nil {
+			d.status = "🤷 Re-run the Chaos Scenario " + run.WorkflowName + "? [y/N]"
+			d.confirm = func() string {
+				if err := apis.ReRunWorkflow(d.projectID, run.WorkflowID, d.credentials); err != nil {
+					return "❌ Error in re-running the Chaos Scenario " + run.WorkflowName + ": " + err.Error()
+				}
+				return "🚀 Chaos Scenario " + run.WorkflowName + " successfully re-run"
+			}
+		}
+	case "s":
+		if run := d.selectedRun(); run != nil {
+			if apis.IsWorkflowRunFinished(run) {
+				d.status = "⛔ The Chaos Scenario run " + run.WorkflowRunID + " is already " + run.Phase
+				return true
+			}
+			d.status = "🤷 Stop the Chaos Scenario run " + run.WorkflowRunID + " of " + run.WorkflowName + "? [y/N]"
+			d.confirm = func() string {
+				if err := apis.TerminateWorkflowRun(d.projectID, run.WorkflowID, run.WorkflowRunID, d.credentials); err != nil {
+					return "❌ Error in stopping the Chaos Scenario run " + run.WorkflowRunID + ": " + err.Error()
+				}
+				return "🚀 Chaos Scenario run " + run.WorkflowRunID + " successfully stopped"
+			}
+		}
+	}
+	return true
+}
+
+// selectedRun returns the selected run, the state has to be locked
+func (d *dashboard) selectedRun() *model.WorkflowRun {
+	if d.selected < len(d.runs) {
+		return d.runs[d.selected]
+	}
+	d.status = "⛔ No Chaos Scenario run is selected"
+	return nil
+}
+
+func init() {
+	DashboardCmd.Flags().String("project-id", "", "Set the project-id to display the dashboard of. To see the projects, apply litmusctl get projects")
+	DashboardCmd.Flags().Duration("refresh", 5*time.Second, "Set the interval the Chaos Delegates are refreshed at")
+}
@@ -0,0 +1,174 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package dashboard
+
+import (
+	"bytes"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/fatih/color"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"golang.org/x/term"
+)
+
+var (
+	green    = color.New(color.FgGreen)
+	yellow   = color.New(color.FgYellow)
+	selected = color.New(color.ReverseVideo)
+)
+
+// draw renders the whole screen at once, to avoid flickering
+func (d *dashboard) draw() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	width, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil {
+		width, height = 120, 40
+	}
+
+	var lines []string
+	updatedAt := "-"
+	if !d.updatedAt.IsZero() {
+		updatedAt = d.updatedAt.Format("15:04:05")
+	}
+	lines = append(lines, utils.White_B.Sprint("litmusctl dashboard")+" project "+d.projectID+" at "+d.credentials.Endpoint+", updated at "+updatedAt, "")
+
+	// Chaos Delegates, in at most half of the screen left by the other lines, so that the runs stay visible
+	lines = append(lines, utils.White_B.Sprint("CHAOS DELEGATES"))
+	delegates := d.delegates
+	maxDelegates := (height - 10) / 2
+	if maxDelegates < 2 {
+		maxDelegates = 2
+	}
+	more := 0
+	if len(delegates) > maxDelegates {
+		more = len(delegates) - maxDelegates + 1
+		delegates = delegates[:maxDelegates-1]
+	}
+
+	var rows []string
+	rows = append(rows, "NAME\tSTATUS\tREGISTRATION\tVERSION\tID")
+	for _, delegate := range delegates {
+		status, registration := "INACTIVE", "NOT REGISTERED"
+		if delegate.IsActive {
+			status = "ACTIVE"
+		}
+		if delegate.IsRegistered {
+			registration = "REGISTERED"
+		}
+		rows = append(rows, delegate.AgentName+"\t"+status+"\t"+registration+"\t"+delegate.Version+"\t"+delegate.ClusterID)
+	}
+	for i, row := range table(rows) {
+		switch {
+		case i == 0:
+			row = utils.White_B.Sprint(row)
+		case delegates[i-1].IsActive:
+			row = green.Sprint(row)
+		default:
+			row = utils.Red.Sprint(row)
+		}
+		lines = append(lines, row)
+	}
+	if more > 0 {
+		lines = append(lines, "+"+strconv.Itoa(more)+" more")
+	}
+	lines = append(lines, "")
+
+	// Chaos Scenario runs, as many as fit on the screen
+	lines = append(lines, utils.White_B.Sprint("RECENT CHAOS SCENARIO RUNS"))
+	visible := height - len(lines) - 5
+	if visible < 1 {
+		visible = 1
+	}
+	first := 0
+	if d.selected >= visible {
+		first = d.selected - visible + 1
+	}
+	last := first + visible
+	if last > len(d.runs) {
+		last = len(d.runs)
+	}
+
+	rows = []string{"  CHAOS SCENARIO\tRUN ID\tPHASE\tRESILIENCY SCORE\tCHAOS DELEGATE\tLAST UPDATED"}
+	for _, run := range d.runs[first:last] {
+		score := "-"
+		if run.ResiliencyScore != nil {
+			score = strconv.FormatFloat(*run.ResiliencyScore, 'f', 2, 64)
+		}
+		rows = append(rows, "  "+run.WorkflowName+"\t"+run.WorkflowRunID+"\t"+run.Phase+"\t"+score+"\t"+run.ClusterName+"\t"+utils.FormatTime(utils.ParseTimestamp(run.LastUpdated)))
+	}
+	for i, row := range table(rows) {
+		switch {
+		case i == 0:
+			row = utils.White_B.Sprint(row)
+		case first+i-1 == d.selected:
+			row = selected.Sprint(">" + row[1:])
+		default:
+			row = phaseColor(d.runs[first+i-1].Phase).Sprint(row)
+		}
+		lines = append(lines, row)
+	}
+	if len(d.runs) == 0 {
+		lines = append(lines, "  No Chaos Scenario runs yet")
+	}
+
+	lines = append(lines, "", utils.White_B.Sprint("[↑/↓] select  [r] re-run Chaos Scenario  [s] stop run  [q] quit"))
+	if d.status != "" {
+		lines = append(lines, truncate(d.status, width))
+	}
+
+	// The terminal is in raw mode, lines are ended with \r\n
+	var screen strings.Builder
+	screen.WriteString("\x1b[H\x1b[2J")
+	screen.WriteString(strings.Join(lines, "\r\n"))
+	os.Stdout.WriteString(screen.String())
+}
+
+// table aligns the tab separated rows
+func table(rows []string) []string {
+	var buf bytes.Buffer
+	writer := tabwriter.NewWriter(&buf, 4, 8, 2, ' ', 0)
+	for _, row := range rows {
+		writer.Write([]byte(row + "\n"))
+	}
+	writer.Flush()
+	return strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+}
+
+// phaseColor colors the runs by their phase
+func phaseColor(phase string) *color.Color {
+	switch phase {
+	case "Succeeded":
+		return green
+	case "Failed", "Terminated", "Error":
+		return utils.Red
+	default:
+		return yellow
+	}
+}
+
+// truncate cuts the text to the width of the terminal
+func truncate(text string, width int) string {
+	runes := []rune(text)
+	if width > 1 && len(runes) > width {
+		return string(runes[:width-1]) + "…"
+	}
+	return text
+}
@@ -27,6 +27,18 @@ var DeleteCmd = &cobra.Command{
 		#delete a Chaos Scenario
 		litmusctl delete chaos-scenario c520650e-7cb6-474c-b0f0-4df07b2b025b --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b
 
-		Note: The default location of the config file is $HOME/.litmusconfig, and can be overridden by a --config flag
+		#delete a Resilience Probe
+		litmusctl delete probe http-probe --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b
+
+		#delete a project
+		litmusctl delete project c520650e-7cb6-474c-b0f0-4df07b2b025b
+
+		#remove a member from a project
+		litmusctl delete project-member --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b --user=john
+
+		#delete an environment
+		litmusctl delete environment prod --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b
+
+		Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
 	`,
 }
@@ -0,0 +1,141 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package delete
+
+import (
+	"errors"
+	"os"
+	"strconv"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// environmentCmd represents the environment command
+var environmentCmd = &cobra.Command{
+	Use: "environment",
+	Short: `Delete an environment
+	Example:
+	#delete an environment
+	litmusctl delete environment prod --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b
+
+	#delete an environment which still has Chaos Infrastructures attached
+	litmusctl delete environment prod --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b --force
+
+	#delete an environment without confirmation, e.g. from automation
+	litmusctl delete environment prod --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b --yes
+
+	#delete several environments
+	litmusctl delete environment staging qa --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		// Fetch user credentials
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		// Handle blank input for project ID
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		force, err := cmd.Flags().GetBool("force")
+		utils.PrintError(err)
+
+		concurrency, err := utils.GetConcurrency(cmd)
+		utils.PrintError(err)
+
+		if len(args) > 1 {
+			var affected []string
+			for _, environmentID := range args {
+				affected = append(affected, "Environment/"+environmentID)
+			}
+			utils.ConfirmDestructive(cmd, "deleted", affected)
+
+			results := utils.RunBulk(args, concurrency, func(environmentID string) (string, error) {
+				infras, err := apis.ListInfras(projectID, []string{environmentID}, credentials)
+				if err != nil {
+					return "", err
+				}
+				attached := len(infras.Data.ListInfras.Infras)
+				if attached > 0 && !force {
+					return "", errors.New(strconv.Itoa(attached) + " Chaos Infrastructures attached, use --force to delete it anyway")
+				}
+
+				if _, err := apis.DeleteEnvironment(projectID, environmentID, credentials); err != nil {
+					return "", err
+				}
+				return "Environment deleted", nil
+			})
+			if utils.PrintBulkSummary("ENVIRONMENT ID", results) > 0 {
+				os.Exit(1)
+			}
+			return
+		}
+
+		environmentID := args[0]
+
+		// Check which Chaos Infrastructures are attached to the environment before deleting it
+		infras, err := apis.ListInfras(projectID, []string{environmentID}, credentials)
+		utils.PrintError(err)
+
+		if len(infras.Data.ListInfras.Infras) > 0 {
+			utils.White_B.Println("\nEnvironment/" + environmentID + " has the following Chaos Infrastructures attached:")
+			for _, infra := range infras.Data.ListInfras.Infras {
+				utils.White.Println("-", infra.Name, "("+infra.InfraID+")")
+			}
+
+			if !force {
+				utils.Red.Println("\n⛔ Move or disconnect the above Chaos Infrastructures first, or use --force to delete the environment anyway.")
+				os.Exit(1)
+			}
+		}
+
+		affected := []string{"Environment/" + environmentID}
+		for _, infra := range infras.Data.ListInfras.Infras {
+			affected = append(affected, "Chaos Infrastructure/"+infra.Name+" ("+infra.InfraID+") is left without environment")
+		}
+		utils.ConfirmDestructive(cmd, "deleted", affected)
+
+		// Make API call
+		_, err = apis.DeleteEnvironment(projectID, environmentID, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Error in deleting environment: ", err.Error())
+			os.Exit(1)
+		}
+
+		utils.White_B.Println("\n🚀 Environment successfully deleted.")
+	},
+}
+
+func init() {
+	DeleteCmd.AddCommand(environmentCmd)
+
+	environmentCmd.Flags().String("project-id", "", "Set the project-id to delete the environment from the particular project. To see the projects, apply litmusctl get projects")
+	environmentCmd.Flags().Bool("force", false, "Set to true to delete the environment even if Chaos Infrastructures are attached to it")
+	utils.AddConcurrencyFlag(environmentCmd)
+	utils.AddYesFlag(environmentCmd)
+}
@@ -0,0 +1,160 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package delete
+
+import (
+	"errors"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// probeCmd represents the probe command
+var probeCmd = &cobra.Command{
+	Use: "probe",
+	Short: `Delete a Resilience Probe
+	Example:
+	#delete a Resilience Probe
+	litmusctl delete probe http-probe --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b
+
+	#delete a Resilience Probe which is referenced by Chaos Scenarios
+	litmusctl delete probe http-probe --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b --force
+
+	#delete a Resilience Probe without confirmation, e.g. from automation
+	litmusctl delete probe http-probe --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b --yes
+
+	#delete several Resilience Probes
+	litmusctl delete probe http-probe prom-probe cmd-probe --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		// Fetch user credentials
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		// Handle blank input for project ID
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		force, err := cmd.Flags().GetBool("force")
+		utils.PrintError(err)
+
+		concurrency, err := utils.GetConcurrency(cmd)
+		utils.PrintError(err)
+
+		if len(args) > 1 {
+			var affected []string
+			for _, probeName := range args {
+				affected = append(affected, "Resilience Probe/"+probeName)
+			}
+			utils.ConfirmDestructive(cmd, "deleted", affected)
+
+			results := utils.RunBulk(args, concurrency, func(probeName string) (string, error) {
+				reference, err := apis.GetProbeReference(projectID, probeName, credentials)
+				if err != nil {
+					return "", err
+				}
+				scenarios := reference.Data.ProbeReference.ReferencingScenarios()
+				if len(scenarios) > 0 && !force {
+					return "", errors.New("referenced by " + strconv.Itoa(len(scenarios)) + " Chaos Scenarios, use --force to delete it anyway")
+				}
+
+				deletedProbe, err := apis.DeleteProbe(projectID, probeName, credentials)
+				if err != nil {
+					return "", err
+				}
+				if !deletedProbe.Data.IsDeleted {
+					return "", errors.New("Resilience Probe not deleted, please check if the name is correct or not")
+				}
+				if len(scenarios) > 0 {
+					return "Resilience Probe deleted, it was referenced by " + strconv.Itoa(len(scenarios)) + " Chaos Scenarios", nil
+				}
+				return "Resilience Probe deleted", nil
+			})
+			if utils.PrintBulkSummary("RESILIENCE PROBE", results) > 0 {
+				os.Exit(1)
+			}
+			return
+		}
+
+		probeName := args[0]
+
+		// Check which Chaos Scenarios reference the probe before deleting it
+		reference, err := apis.GetProbeReference(projectID, probeName, credentials)
+		utils.PrintError(err)
+
+		scenarios := reference.Data.ProbeReference.ReferencingScenarios()
+		if len(scenarios) > 0 {
+			utils.White_B.Println("\nResilience Probe/" + probeName + " is referenced by the following Chaos Scenarios:")
+
+			var ids []string
+			for id := range scenarios {
+				ids = append(ids, id)
+			}
+			sort.Strings(ids)
+			for _, id := range ids {
+				utils.White.Println("-", scenarios[id], "("+id+")")
+			}
+
+			if !force {
+				utils.Red.Println("\n⛔ Deleting the Resilience Probe will break the above Chaos Scenarios. Use --force to delete it anyway.")
+				os.Exit(1)
+			}
+		}
+
+		affected := []string{"Resilience Probe/" + probeName}
+		if len(scenarios) > 0 {
+			affected = append(affected, strconv.Itoa(len(scenarios))+" Chaos Scenarios referencing it are broken")
+		}
+		utils.ConfirmDestructive(cmd, "deleted", affected)
+
+		// Make API call
+		deletedProbe, err := apis.DeleteProbe(projectID, probeName, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Error in deleting Resilience Probe: ", err.Error())
+			os.Exit(1)
+		}
+
+		if deletedProbe.Data.IsDeleted {
+			utils.White_B.Println("\n🚀 Resilience Probe successfully deleted.")
+		} else {
+			utils.White_B.Println("\n❌ Failed to delete Resilience Probe. Please check if the name is correct or not.")
+		}
+	},
+}
+
+func init() {
+	DeleteCmd.AddCommand(probeCmd)
+
+	probeCmd.Flags().String("project-id", "", "Set the project-id to delete the Resilience Probe from the particular project. To see the projects, apply litmusctl get projects")
+	probeCmd.Flags().Bool("force", false, "Set to true to delete the Resilience Probe even if it is referenced by Chaos Scenarios")
+	utils.AddConcurrencyFlag(probeCmd)
+	utils.AddYesFlag(probeCmd)
+}
@@ -0,0 +1,98 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package delete
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// projectCmd represents the project command
+var projectCmd = &cobra.Command{
+	Use: "project",
+	Short: `Delete a project
+	Example:
+	#delete a project
+	litmusctl delete project 50addd40-8767-448c-a91a-5071543a2d8e
+
+	#delete a project without confirmation, e.g. from automation
+	litmusctl delete project 50addd40-8767-448c-a91a-5071543a2d8e --yes
+
+	Note: Only the owner of a project can delete it. The deletion has to be confirmed by typing the name of the project, unless --yes is set.
+	The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+
+		// Fetch user credentials
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID := args[0]
+
+		// Perform authorization
+		userDetails, err := apis.GetProjectDetails(credentials)
+		utils.PrintError(err)
+
+		var project *apis.Project
+		for i := range userDetails.Data.Projects {
+			if userDetails.Data.Projects[i].ID == projectID {
+				project = &userDetails.Data.Projects[i]
+			}
+		}
+		if project == nil {
+			utils.Red.Println("⛔ No project found with ID: " + projectID)
+			os.Exit(1)
+		}
+
+		var isOwner = false
+		for _, member := range project.Members {
+			if member.UserID == userDetails.Data.ID && member.Role == "Owner" {
+				isOwner = true
+			}
+		}
+		if !isOwner {
+			utils.Red.Println("⛔ Only the owner of the project can delete it!!")
+			os.Exit(1)
+		}
+
+		// Confirm the deletion by asking for the project name
+		utils.ConfirmDestructiveByName(cmd, "permanently deleted", []string{
+			"Project/" + project.Name + " (" + project.ID + ")",
+			fmt.Sprintf("its Chaos Delegates, Chaos Scenarios and other resources, and the access of its %d member(s)", len(project.Members)),
+		}, project.Name)
+
+		// Make API call
+		_, err = apis.DeleteProject(projectID, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Error in deleting project: ", err.Error())
+			os.Exit(1)
+		}
+
+		utils.White_B.Println(fmt.Sprintf("\n🚀 Project %s successfully deleted.", project.Name))
+	},
+}
+
+func init() {
+	DeleteCmd.AddCommand(projectCmd)
+
+	utils.AddYesFlag(projectCmd)
+}
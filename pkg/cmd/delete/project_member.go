@@ -0,0 +1,96 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package delete
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// projectMemberCmd represents the project-member command
+var projectMemberCmd = &cobra.Command{
+	Use: "project-member",
+	Short: `Remove a member from a project
+	Example:
+	#remove a member from a project
+	litmusctl delete project-member --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --user="john"
+
+	#remove a member from a project without confirmation, e.g. from automation
+	litmusctl delete project-member --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --user="john" --yes
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+
+		// Fetch user credentials
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		// Handle blank input for project ID
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		username, err := cmd.Flags().GetString("user")
+		utils.PrintError(err)
+
+		if username == "" {
+			utils.Red.Println("⛔ --user flag is empty")
+			os.Exit(1)
+		}
+
+		member, err := apis.GetProjectMember(projectID, username, credentials)
+		utils.PrintError(err)
+
+		if member.Role == "Owner" {
+			utils.Red.Println("⛔ The owner of the project can't be removed from it")
+			os.Exit(1)
+		}
+
+		utils.ConfirmDestructive(cmd, "removed from project "+projectID, []string{"Member/" + member.UserName + " (" + member.Role + ")"})
+
+		// Make API call
+		_, err = apis.RemoveMember(projectID, member.UserID, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Error in removing project member: ", err.Error())
+			os.Exit(1)
+		}
+
+		// Print a single line record of the change, so that it can be collected for audits
+		utils.White_B.Println("\n🚀 Project member successfully removed.")
+		utils.White.Println(fmt.Sprintf("time=%s project=%s user=%s user-id=%s role=%s removed-by=%s",
+			time.Now().UTC().Format(time.RFC3339), projectID, member.UserName, member.UserID, member.Role, credentials.Username))
+	},
+}
+
+func init() {
+	DeleteCmd.AddCommand(projectMemberCmd)
+
+	projectMemberCmd.Flags().String("project-id", "", "Set the project-id to remove the member from. To see the projects, apply litmusctl get projects")
+	projectMemberCmd.Flags().String("user", "", "Set the username of the member to remove")
+	utils.AddYesFlag(projectMemberCmd)
+}
@@ -0,0 +1,94 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package delete
+
+import (
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// serviceAccountCmd represents the service-account command
+var serviceAccountCmd = &cobra.Command{
+	Use: "service-account [name]",
+	Short: `Delete a service account
+	Example:
+	#delete the ci service account
+	litmusctl delete service-account ci --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+	#delete it without confirmation, e.g. from automation
+	litmusctl delete service-account ci --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --yes
+
+	Its tokens are revoked, it's removed from the project, and its user is deactivated, which is reserved to the admin of ChaosCenter.
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		member, err := apis.GetServiceAccount(projectID, args[0], credentials)
+		utils.PrintError(err)
+
+		tokens, err := apis.ListAPITokens(member.UserID, credentials)
+		utils.PrintError(err)
+
+		affected := []string{"Service account/" + member.UserName + ", removed from the project and deactivated"}
+		for _, token := range tokens {
+			affected = append(affected, "Token/"+token.Name+" ("+token.ID()+"), revoked")
+		}
+		utils.ConfirmDestructive(cmd, "deleted", affected)
+
+		// The tokens are revoked first, so that a failure of the next steps doesn't leave them usable
+		for _, token := range tokens {
+			if _, err := apis.RevokeAPIToken(member.UserID, token.Token, credentials); err != nil {
+				utils.Red.Println("\n❌ Error in revoking the token " + token.Name + " (" + token.ID() + "): " + err.Error())
+				os.Exit(1)
+			}
+		}
+
+		if _, err := apis.RemoveMember(projectID, member.UserID, credentials); err != nil {
+			utils.Red.Println("\n❌ Error in removing " + member.UserName + " from the project: " + err.Error())
+			os.Exit(1)
+		}
+
+		if _, err := apis.DeactivateUser(member.UserName, credentials); err != nil {
+			utils.Red.Fprintln(os.Stderr, "\n⚠️  The tokens of "+member.UserName+" were revoked and it was removed from the project, but its user couldn't be deactivated: "+err.Error())
+		}
+
+		utils.White_B.Println("\n🚀 Service account " + member.UserName + " successfully deleted.")
+	},
+}
+
+func init() {
+	DeleteCmd.AddCommand(serviceAccountCmd)
+
+	serviceAccountCmd.Flags().String("project-id", "", "Set the project-id the service account is a member of. To see the projects, apply litmusctl get projects")
+	utils.AddYesFlag(serviceAccountCmd)
+}
@@ -0,0 +1,103 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package delete
+
+import (
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// serviceAccountTokenCmd represents the service-account-token command
+var serviceAccountTokenCmd = &cobra.Command{
+	Use: "service-account-token [token-id|name]",
+	Short: `Revoke an API token of a service account
+	Example:
+	#revoke a token of the ci service account, by its ID or its name
+	litmusctl delete service-account-token 9fK2xQ1a --service-account="ci" --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+	#revoke it without confirmation, e.g. from automation
+	litmusctl delete service-account-token 9fK2xQ1a --service-account="ci" --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --yes
+
+	To see the tokens, apply litmusctl get service-account-tokens
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		serviceAccount, err := cmd.Flags().GetString("service-account")
+		utils.PrintError(err)
+
+		if serviceAccount == "" {
+			utils.Red.Println("⛔ --service-account flag is empty")
+			os.Exit(1)
+		}
+
+		member, err := apis.GetServiceAccount(projectID, serviceAccount, credentials)
+		utils.PrintError(err)
+
+		tokens, err := apis.ListAPITokens(member.UserID, credentials)
+		utils.PrintError(err)
+
+		var matches []apis.APIToken
+		for _, token := range tokens {
+			if token.ID() == args[0] || token.Name == args[0] {
+				matches = append(matches, token)
+			}
+		}
+		switch {
+		case len(matches) == 0:
+			utils.Red.Println("⛔ No token " + args[0] + " of " + member.UserName + ". To see the tokens, apply litmusctl get service-account-tokens")
+			os.Exit(1)
+		case len(matches) > 1:
+			utils.Red.Println("⛔ Several tokens of " + member.UserName + " are named " + args[0] + ", revoke them by their ID")
+			os.Exit(1)
+		}
+
+		utils.ConfirmDestructive(cmd, "revoked", []string{"Token/" + matches[0].Name + " (" + matches[0].ID() + ") of " + member.UserName})
+
+		_, err = apis.RevokeAPIToken(member.UserID, matches[0].Token, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Error in revoking the token: " + err.Error())
+			os.Exit(1)
+		}
+
+		utils.White_B.Println("\n🚀 Token " + matches[0].Name + " (" + matches[0].ID() + ") of " + member.UserName + " successfully revoked.")
+	},
+}
+
+func init() {
+	DeleteCmd.AddCommand(serviceAccountTokenCmd)
+
+	serviceAccountTokenCmd.Flags().String("project-id", "", "Set the project-id the service account is a member of. To see the projects, apply litmusctl get projects")
+	serviceAccountTokenCmd.Flags().String("service-account", "", "Set the name of the service account. To see the service accounts, apply litmusctl get service-accounts")
+	utils.AddYesFlag(serviceAccountTokenCmd)
+}
@@ -16,10 +16,12 @@ limitations under the License.
 package delete
 
 import (
-	"fmt"
+	"errors"
 	"os"
 
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
 	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
 	"github.com/litmuschaos/litmusctl/pkg/utils"
 
 	"github.com/spf13/cobra"
@@ -33,9 +35,15 @@ var workflowCmd = &cobra.Command{
 	#delete a Chaos Scenario
 	litmusctl delete chaos-scenario c520650e-7cb6-474c-b0f0-4df07b2b025b --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b
 
-	Note: The default location of the config file is $HOME/.litmusconfig, and can be overridden by a --config flag
+	#delete a Chaos Scenario without confirmation, e.g. from automation
+	litmusctl delete chaos-scenario c520650e-7cb6-474c-b0f0-4df07b2b025b --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b --yes
+
+	#delete several Chaos Scenarios, 8 at a time
+	litmusctl delete chaos-scenario c520650e-7cb6-474c-b0f0-4df07b2b025b 9f1a1c2e-3b4d-4e5f-8a7b-6c5d4e3f2a1b --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b --concurrency=8
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
 	`,
-	Args: cobra.ExactArgs(1),
+	Args: cobra.MinimumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 
 		// Fetch user credentials
@@ -47,26 +55,19 @@ var workflowCmd = &cobra.Command{
 
 		// Handle blank input for project ID
 		if projectID == "" {
-			utils.White_B.Print("\nEnter the Project ID: ")
-			fmt.Scanln(&projectID)
-
-			if projectID == "" {
-				utils.Red.Println("⛔ Project ID can't be empty!!")
-				os.Exit(1)
-			}
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
 		}
 
+		concurrency, err := utils.GetConcurrency(cmd)
+		utils.PrintError(err)
+
 		workflowID := args[0]
 
 		// Handle blank input for Chaos Scenario ID
 		if workflowID == "" {
-			utils.White_B.Print("\nEnter the Chaos Scenario ID: ")
-			fmt.Scanln(&workflowID)
-
-			if workflowID == "" {
-				utils.Red.Println("⛔ Chaos Scenario ID can't be empty!!")
-				os.Exit(1)
-			}
+			workflowID, err = picker.WorkflowID(projectID, credentials)
+			utils.PrintError(err)
 		}
 
 		// Perform authorization
@@ -89,6 +90,45 @@ var workflowCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		// List the Chaos Scenarios to delete by their name, the IDs which aren't found are listed as is
+		workflowIDs := append([]string{workflowID}, args[1:]...)
+		var ids []*string
+		for i := range workflowIDs {
+			ids = append(ids, &workflowIDs[i])
+		}
+		names := make(map[string]string)
+		if workflows, err := apis.GetAllWorkflows(model.ListWorkflowsRequest{ProjectID: projectID, WorkflowIDs: ids}, nil, credentials); err == nil {
+			for _, workflow := range workflows.Data.ListWorkflowDetails.Workflows {
+				names[workflow.WorkflowID] = workflow.WorkflowName + " (" + workflow.WorkflowID + ") of Chaos Delegate/" + workflow.ClusterName
+			}
+		}
+		var affected []string
+		for _, id := range workflowIDs {
+			if name, ok := names[id]; ok {
+				affected = append(affected, "Chaos Scenario/"+name)
+			} else {
+				affected = append(affected, "Chaos Scenario/"+id)
+			}
+		}
+		utils.ConfirmDestructive(cmd, "deleted", affected)
+
+		if len(args) > 1 {
+			results := utils.RunBulk(args, concurrency, func(workflowID string) (string, error) {
+				deletedWorkflow, err := apis.DeleteChaosWorkflow(projectID, &workflowID, credentials)
+				if err != nil {
+					return "", err
+				}
+				if !deletedWorkflow.Data.IsDeleted {
+					return "", errors.New("Chaos Scenario not deleted, please check if the ID is correct or not")
+				}
+				return "Chaos Scenario deleted", nil
+			})
+			if utils.PrintBulkSummary("CHAOS SCENARIO ID", results) > 0 {
+				os.Exit(1)
+			}
+			return
+		}
+
 		// Make API call
 		deletedWorkflow, err := apis.DeleteChaosWorkflow(projectID, &workflowID, credentials)
 		if err != nil {
@@ -108,4 +148,6 @@ func init() {
 	DeleteCmd.AddCommand(workflowCmd)
 
 	workflowCmd.Flags().String("project-id", "", "Set the project-id to create Chaos Scenario for the particular project. To see the projects, apply litmusctl get projects")
+	utils.AddConcurrencyFlag(workflowCmd)
+	utils.AddYesFlag(workflowCmd)
 }
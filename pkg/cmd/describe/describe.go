@@ -27,6 +27,12 @@ var DescribeCmd = &cobra.Command{
 		#describe a Chaos Scenario
 		litmusctl describe chaos-scenario d861b650-1549-4574-b2ba-ab754058dd04 --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
 
-		Note: The default location of the config file is $HOME/.litmusconfig, and can be overridden by a --config flag
+		#describe a Resilience Probe
+		litmusctl describe probe http-probe --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+		#describe an environment
+		litmusctl describe environment prod --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+		Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
 	`,
 }
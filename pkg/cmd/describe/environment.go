@@ -0,0 +1,117 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package describe
+
+import (
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// environmentCmd represents the environment command
+var environmentCmd = &cobra.Command{
+	Use:   "environment",
+	Short: "Describe an environment within the project",
+	Long:  `Describe an environment within the project, including its type, tags and the Chaos Infrastructures attached to it`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		var environmentID string
+		if len(args) == 0 {
+			environmentID, err = utils.PromptInput("Enter the environment ID", "", utils.NotEmpty("Environment ID"))
+			utils.PrintError(err)
+		} else {
+			environmentID = args[0]
+		}
+
+		// Handle blank input for environment ID
+		if environmentID == "" {
+			utils.Red.Println("⛔ Environment ID can't be empty!!")
+			os.Exit(1)
+		}
+
+		// The environment and its Chaos Infrastructures are fetched in a single request
+		description, err := apis.DescribeEnvironment(projectID, environmentID, credentials)
+		utils.PrintError(err)
+
+		if len(description.Data.ListEnvironments.Environments) == 0 {
+			utils.Red.Println("⛔ No environment found with ID: ", environmentID)
+			os.Exit(1)
+		}
+		environment := description.Data.ListEnvironments.Environments[0]
+		infras := description.Data.ListInfras.Infras
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(environment)
+
+		case "yaml":
+			utils.PrintInYamlFormat(environment)
+
+		case "":
+			utils.White_B.Println("ID: " + environment.EnvironmentID)
+			utils.White_B.Println("Name: " + environment.Name)
+			utils.White_B.Println("Type: " + environment.Type.DisplayName())
+			if environment.Description != nil && *environment.Description != "" {
+				utils.White_B.Println("Description: " + *environment.Description)
+			}
+			if len(environment.Tags) > 0 {
+				utils.White_B.Println("Tags: " + strings.Join(environment.Tags, ", "))
+			}
+
+			if len(infras) == 0 {
+				utils.White_B.Println("\nNo Chaos Infrastructures attached")
+				return
+			}
+
+			utils.White_B.Println("\nChaos Infrastructures:")
+			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+			utils.White_B.Fprintln(writer, "CHAOS INFRASTRUCTURE ID\tNAME\tSTATUS")
+			for _, infra := range infras {
+				status := "INACTIVE"
+				if infra.IsActive {
+					status = "ACTIVE"
+				}
+				utils.White.Fprintln(writer, infra.InfraID+"\t"+infra.Name+"\t"+status)
+			}
+			writer.Flush()
+		}
+	},
+}
+
+func init() {
+	DescribeCmd.AddCommand(environmentCmd)
+
+	environmentCmd.Flags().String("project-id", "", "Set the project-id of the environment. To see the projects, apply litmusctl get projects")
+	environmentCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
@@ -0,0 +1,128 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package describe
+
+import (
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// probeCmd represents the probe command
+var probeCmd = &cobra.Command{
+	Use:   "probe",
+	Short: "Describe a Resilience Probe within the project",
+	Long:  `Describe a Resilience Probe within the project, including its configuration, the Chaos Scenarios referencing it and its recent pass rate`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		var probeName string
+		if len(args) == 0 {
+			probeName, err = utils.PromptInput("Enter the Resilience Probe name", "", utils.NotEmpty("Resilience Probe name"))
+			utils.PrintError(err)
+		} else {
+			probeName = args[0]
+		}
+
+		// Handle blank input for probe name
+		if probeName == "" {
+			utils.Red.Println("⛔ Resilience Probe name can't be empty!!")
+			os.Exit(1)
+		}
+
+		// The probe and its references are fetched in a single request
+		description, err := apis.DescribeProbe(projectID, probeName, credentials)
+		utils.PrintError(err)
+
+		if len(description.Data.Probes) == 0 {
+			utils.Red.Println("⛔ No Resilience Probe found with name: ", probeName)
+			os.Exit(1)
+		}
+		probe := description.Data.Probes[0]
+		reference := description.Data.ProbeReference
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(probe)
+
+		case "yaml":
+			utils.PrintInYamlFormat(probe)
+
+		case "":
+			utils.White_B.Println("Name: " + probe.Name)
+			utils.White_B.Println("Type: " + string(probe.Type))
+			if probe.Description != nil && *probe.Description != "" {
+				utils.White_B.Println("Description: " + *probe.Description)
+			}
+			utils.White_B.Println("Total Runs: ", reference.TotalRuns)
+			if rate, runs := probe.PassRate(); runs > 0 {
+				utils.White_B.Printf("Recent Pass Rate: %.2f%% (%d runs)\n", rate, runs)
+			} else {
+				utils.White_B.Println("Recent Pass Rate: N/A")
+			}
+
+			// Print only the properties of the probe's type
+			probe.RecentExecutions = nil
+			probe.ReferencedBy = nil
+			utils.White_B.Println("\nConfiguration:")
+			utils.PrintInYamlFormat(probe)
+
+			utils.White_B.Println("Referencing Chaos Scenarios:")
+			scenarios := reference.ReferencingScenarios()
+			if len(scenarios) == 0 {
+				utils.White.Println("None")
+				return
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+			utils.White_B.Fprintln(writer, "CHAOS SCENARIO ID\tCHAOS SCENARIO NAME")
+			var ids []string
+			for id := range scenarios {
+				ids = append(ids, id)
+			}
+			sort.Strings(ids)
+			for _, id := range ids {
+				utils.White.Fprintln(writer, id+"\t"+scenarios[id])
+			}
+			writer.Flush()
+		}
+	},
+}
+
+func init() {
+	DescribeCmd.AddCommand(probeCmd)
+
+	probeCmd.Flags().String("project-id", "", "Set the project-id to describe the Resilience Probe from the particular project. To see the projects, apply litmusctl get projects")
+
+	probeCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
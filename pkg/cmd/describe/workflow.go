@@ -16,11 +16,11 @@ limitations under the License.
 package describe
 
 import (
-	"fmt"
 	"os"
 
 	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
 	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
 	"github.com/litmuschaos/litmusctl/pkg/utils"
 	"github.com/spf13/cobra"
 	"sigs.k8s.io/yaml"
@@ -41,19 +41,14 @@ var workflowCmd = &cobra.Command{
 		utils.PrintError(err)
 
 		if describeWorkflowRequest.ProjectID == "" {
-			utils.White_B.Print("\nEnter the Project ID: ")
-			fmt.Scanln(&describeWorkflowRequest.ProjectID)
-
-			for describeWorkflowRequest.ProjectID == "" {
-				utils.Red.Println("⛔ Project ID can't be empty!!")
-				os.Exit(1)
-			}
+			describeWorkflowRequest.ProjectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
 		}
 
 		var workflowID string
 		if len(args) == 0 {
-			utils.White_B.Print("\nEnter the Chaos Scenario ID: ")
-			fmt.Scanln(&workflowID)
+			workflowID, err = picker.WorkflowID(describeWorkflowRequest.ProjectID, credentials)
+			utils.PrintError(err)
 		} else {
 			workflowID = args[0]
 		}
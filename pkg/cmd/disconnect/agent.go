@@ -16,11 +16,12 @@ limitations under the License.
 package disconnect
 
 import (
-	"fmt"
 	"os"
 	"strings"
 
 	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/k8s"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
 	"github.com/litmuschaos/litmusctl/pkg/utils"
 
 	"github.com/spf13/cobra"
@@ -34,7 +35,13 @@ var agentCmd = &cobra.Command{
 	#disconnect a Chaos Delegate
 	litmusctl disconnect chaos-delegate c520650e-7cb6-474c-b0f0-4df07b2b025b --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b
 
-	Note: The default location of the config file is $HOME/.litmusconfig, and can be overridden by a --config flag
+	#disconnect a Chaos Delegate and delete its resources from the cluster
+	litmusctl disconnect chaos-delegate c520650e-7cb6-474c-b0f0-4df07b2b025b --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b --cleanup
+
+	#disconnect a Chaos Delegate without confirmation, e.g. from automation
+	litmusctl disconnect chaos-delegate c520650e-7cb6-474c-b0f0-4df07b2b025b --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b --yes
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
 	`,
 	Run: func(cmd *cobra.Command, args []string) {
 
@@ -42,24 +49,25 @@ var agentCmd = &cobra.Command{
 		credentials, err := utils.GetCredentials(cmd)
 		utils.PrintError(err)
 
+		// The kubeconfig is read first, as it may be passed on stdin
+		kubeconfig, err := cmd.Flags().GetString("kubeconfig")
+		utils.PrintError(err)
+		utils.PrintError(k8s.LoadKubeconfig(&kubeconfig))
+		utils.PrintError(k8s.Impersonate(cmd))
+
 		projectID, err := cmd.Flags().GetString("project-id")
 		utils.PrintError(err)
 
 		// Handle blank input for project ID
 		if projectID == "" {
-			utils.White_B.Print("\nEnter the Project ID: ")
-			fmt.Scanln(&projectID)
-
-			if projectID == "" {
-				utils.Red.Println("⛔ Project ID can't be empty!!")
-				os.Exit(1)
-			}
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
 		}
 
 		var agentID string
 		if len(args) == 0 {
-			utils.White_B.Print("\nEnter the Agent ID: ")
-			fmt.Scanln(&agentID)
+			agentID, err = picker.AgentID(projectID, credentials)
+			utils.PrintError(err)
 		} else {
 			agentID = args[0]
 		}
@@ -89,6 +97,36 @@ var agentCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		cleanup, err := cmd.Flags().GetBool("cleanup")
+		utils.PrintError(err)
+
+		// The manifest can't be fetched anymore once the Chaos Delegate is disconnected
+		var manifest string
+		if cleanup {
+			manifest, err = apis.GetAgentManifest(credentials, projectID, agentID)
+			if err != nil {
+				utils.Red.Println("\n❌ Error in fetching the Chaos Delegate manifest: ", err.Error())
+				os.Exit(1)
+			}
+		}
+
+		affected := []string{"Chaos Delegate/" + agentID}
+		if agents, err := apis.GetAgentList(credentials, projectID); err == nil {
+			for _, agent := range agents.Data.GetAgent {
+				if agent.ClusterID == agentID {
+					affected[0] = "Chaos Delegate/" + agent.AgentName + " (" + agentID + ")"
+				}
+			}
+		}
+		if cleanup {
+			resources, err := k8s.ManifestResources([]byte(manifest))
+			utils.PrintError(err)
+			for _, resource := range resources {
+				affected = append(affected, resource+", deleted from the cluster")
+			}
+		}
+		utils.ConfirmDestructive(cmd, "disconnected", affected)
+
 		// Make API call
 		var agentIDs []*string
 		agentIDs = append(agentIDs, &agentID)
@@ -98,10 +136,26 @@ var agentCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
-		if strings.Contains(disconnectedAgent.Data.Message, "Successfully deleted clusters") {
-			utils.White_B.Println("\n🚀 Chaos Delegate successfully disconnected.")
-		} else {
+		if !strings.Contains(disconnectedAgent.Data.Message, "Successfully deleted clusters") {
 			utils.White_B.Println("\n❌ Failed to disconnect Chaos Delegate. Please check if the ID is correct or not.")
+			os.Exit(1)
+		}
+		utils.White_B.Println("\n🚀 Chaos Delegate successfully disconnected.")
+
+		if cleanup {
+			ctx, cancel := utils.CommandContext(cmd)
+			defer cancel()
+
+			utils.White_B.Println("\n🧹 Deleting the Chaos Delegate resources from the cluster...")
+			spinner := utils.StartSpinner("Deleting the Chaos Delegate resources")
+			output, err := k8s.DeleteYaml(ctx, []byte(manifest), &kubeconfig)
+			spinner.Stop()
+			utils.White.Print("\n", output)
+			if err != nil {
+				utils.Red.Println("\n❌ Error in deleting the Chaos Delegate resources: ", err.Error())
+				os.Exit(1)
+			}
+			utils.White_B.Println("\n🚀 Chaos Delegate resources successfully deleted from the cluster.")
 		}
 	},
 }
@@ -110,4 +164,9 @@ func init() {
 	DisconnectCmd.AddCommand(agentCmd)
 
 	agentCmd.Flags().String("project-id", "", "Set the project-id to disconnect Chaos Delegate for the particular project. To see the projects, apply litmusctl get projects")
+	agentCmd.Flags().Bool("cleanup", false, "Set to delete the resources of the Chaos Delegate from the cluster after disconnecting it")
+	agentCmd.Flags().String("kubeconfig", "", "Set to pass kubeconfig file if it is not in the default location ($HOME/.kube/config). Used with --cleanup. Pass - to read it from stdin, or set the base64 encoded kubeconfig in KUBECONFIG_DATA")
+	k8s.AddImpersonationFlags(agentCmd)
+	utils.AddYesFlag(agentCmd)
+	agentCmd.Flags().Duration("timeout", 0, "Set the maximum time to wait for the cleanup, e.g. 5m. No limit by default")
 }
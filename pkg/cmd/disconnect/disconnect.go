@@ -27,6 +27,6 @@ var DisconnectCmd = &cobra.Command{
 		#disconnect a Chaos Delegate
 		litmusctl disconnect chaos-delegate c520650e-7cb6-474c-b0f0-4df07b2b025b --project-id=c520650e-7cb6-474c-b0f0-4df07b2b025b
 
-		Note: The default location of the config file is $HOME/.litmusconfig, and can be overridden by a --config flag
+		Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
 	`,
 }
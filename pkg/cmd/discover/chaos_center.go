@@ -0,0 +1,115 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package discover
+
+import (
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/litmuschaos/litmusctl/pkg/k8s"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// chaosCenterCmd represents the discover chaos-center command
+var chaosCenterCmd = &cobra.Command{
+	Use:   "chaos-center",
+	Short: "Discover the URLs the ChaosCenter frontend is exposed at",
+	Long: `Discover the URLs the ChaosCenter frontend is exposed at, from its ingresses, load balancers, node ports and cluster IP.
+With --set-account, the chosen URL is used to set a new account entry in the config file.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		kubeconfig, err := cmd.Flags().GetString("kubeconfig")
+		utils.PrintError(err)
+		utils.PrintError(k8s.LoadKubeconfig(&kubeconfig))
+		utils.PrintError(k8s.Impersonate(cmd))
+
+		namespace, err := cmd.Flags().GetString("namespace")
+		utils.PrintError(err)
+
+		ctx, cancel := utils.CommandContext(cmd)
+		defer cancel()
+
+		urls, err := k8s.DiscoverChaosCenter(ctx, namespace, &kubeconfig)
+		if err != nil {
+			utils.Red.Println("\n❌ Failed to discover the ChaosCenter: " + err.Error())
+			os.Exit(1)
+		}
+
+		setAccount, err := cmd.Flags().GetBool("set-account")
+		utils.PrintError(err)
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(urls)
+
+		case "yaml":
+			utils.PrintInYamlFormat(urls)
+
+		case "":
+			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+			utils.White_B.Fprintln(writer, "#\tURL\tSOURCE\tSERVICE")
+			for i, url := range urls {
+				source := url.Source
+				if url.InCluster {
+					source += " (in-cluster only)"
+				}
+				utils.White.Fprintln(writer, strconv.Itoa(i+1)+"\t"+url.URL+"\t"+source+"\t"+url.Namespace+"/"+url.Name)
+			}
+			writer.Flush()
+		}
+
+		if !setAccount {
+			return
+		}
+
+		chosen := 0
+		if len(urls) > 1 {
+			options := make([]string, len(urls))
+			for i, url := range urls {
+				options[i] = url.URL + " (" + url.Source + ")"
+			}
+			chosen, err = utils.PromptSelect("Select the URL to set the account with", options, 0)
+			utils.PrintError(err)
+		}
+
+		// The account is set by config set-account, which logs in with the chosen URL
+		setAccountCmd, _, err := cmd.Root().Find([]string{"config", "set-account"})
+		utils.PrintError(err)
+
+		setAccountArgs := []string{"--server=" + urls[chosen].URL}
+		if configFilePath, err := cmd.Flags().GetString("config"); err == nil && configFilePath != "" {
+			setAccountArgs = append(setAccountArgs, "--config="+configFilePath)
+		}
+		utils.PrintError(setAccountCmd.ParseFlags(setAccountArgs))
+		setAccountCmd.Run(setAccountCmd, nil)
+	},
+}
+
+func init() {
+	DiscoverCmd.AddCommand(chaosCenterCmd)
+
+	chaosCenterCmd.Flags().String("namespace", "", "Set the namespace of the ChaosCenter installation. All the namespaces are searched by default")
+	// The namespace default of the account is the one of the Chaos Delegate
+	utils.PrintError(chaosCenterCmd.Flags().SetAnnotation("namespace", utils.NoAccountDefaultsAnnotation, []string{"true"}))
+	chaosCenterCmd.Flags().StringP("kubeconfig", "k", "", "Set to pass kubeconfig file if it is not in the default location ($HOME/.kube/config). The in-cluster config is used when running inside a pod without a kubeconfig. Pass - to read it from stdin, or set the base64 encoded kubeconfig in KUBECONFIG_DATA")
+	k8s.AddImpersonationFlags(chaosCenterCmd)
+	chaosCenterCmd.Flags().Bool("set-account", false, "Choose one of the discovered URLs and set a new account entry with it")
+	chaosCenterCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
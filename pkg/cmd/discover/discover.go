@@ -0,0 +1,35 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package discover
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// DiscoverCmd represents the discover command
+var DiscoverCmd = &cobra.Command{
+	Use: "discover",
+	Short: `Discover the LitmusChaos components running in a cluster.
+		Examples:
+		#list the URLs the ChaosCenter is exposed at
+		litmusctl discover chaos-center --kubeconfig=$HOME/.kube/config
+
+		#choose one of the URLs and set a new account with it
+		litmusctl discover chaos-center --set-account
+
+		Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+}
@@ -0,0 +1,88 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package generate
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/agent"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+// rbacCmd represents the rbac command
+var rbacCmd = &cobra.Command{
+	Use: "rbac",
+	Short: `Generate the role and role binding a cluster admin must create, so that a non-admin user can install a Chaos Delegate
+	The permissions are the same the connect chaos-delegate command checks for.
+
+	Example:
+	#generate the RBAC for a user to install a Chaos Delegate in the litmus namespace
+	litmusctl generate rbac --mode=namespace --namespace=litmus --user=jane | kubectl apply -f -
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		mode, err := cmd.Flags().GetString("mode")
+		utils.PrintError(err)
+		if mode != "namespace" && mode != "cluster" {
+			utils.Red.Println("⛔ Invalid --mode " + mode + ", supported modes are namespace/cluster")
+			os.Exit(1)
+		}
+
+		namespace, err := cmd.Flags().GetString("namespace")
+		utils.PrintError(err)
+
+		user, err := cmd.Flags().GetString("user")
+		utils.PrintError(err)
+
+		group, err := cmd.Flags().GetString("group")
+		utils.PrintError(err)
+
+		serviceAccount, err := cmd.Flags().GetString("service-account")
+		utils.PrintError(err)
+
+		var subject rbacv1.Subject
+		switch {
+		case user != "":
+			subject = rbacv1.Subject{Kind: rbacv1.UserKind, APIGroup: rbacv1.GroupName, Name: user}
+		case group != "":
+			subject = rbacv1.Subject{Kind: rbacv1.GroupKind, APIGroup: rbacv1.GroupName, Name: group}
+		case serviceAccount != "":
+			subject = rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Name: serviceAccount, Namespace: namespace}
+		default:
+			utils.Red.Println("⛔ One of --user, --group or --service-account is required")
+			os.Exit(1)
+		}
+
+		manifest, err := agent.InstallRBAC(mode, namespace, subject)
+		utils.PrintError(err)
+
+		fmt.Print(manifest)
+	},
+}
+
+func init() {
+	GenerateCmd.AddCommand(rbacCmd)
+
+	rbacCmd.Flags().String("mode", "namespace", "Set the installation mode of the Chaos Delegate | Supported=namespace/cluster")
+	rbacCmd.Flags().String("namespace", utils.DefaultNs, "Set the namespace of the Chaos Delegate installation")
+	rbacCmd.Flags().String("user", "", "Set the user who installs the Chaos Delegate")
+	rbacCmd.Flags().String("group", "", "Set the group of the users who install the Chaos Delegate")
+	rbacCmd.Flags().String("service-account", "", "Set the service account, in the namespace of the installation, which installs the Chaos Delegate")
+}
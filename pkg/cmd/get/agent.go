@@ -0,0 +1,118 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package get
+
+import (
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/litmuschaos/litmusctl/pkg/k8s"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// agentCmd represents the chaos-delegate command
+var agentCmd = &cobra.Command{
+	Use:   "chaos-delegate",
+	Short: "Display details of the Chaos Delegate installed in a cluster",
+	Long:  `Display details of the Chaos Delegate installed in a cluster`,
+}
+
+// accessKeyCmd represents the chaos-delegate access-key command
+var accessKeyCmd = &cobra.Command{
+	Use:   "access-key",
+	Short: "Display the credentials a Chaos Delegate registers with, to troubleshoot registration issues",
+	Long: `Display the credentials a Chaos Delegate registers with, to troubleshoot registration issues.
+The values are redacted unless --show is set.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		kubeconfig, err := cmd.Flags().GetString("kubeconfig")
+		utils.PrintError(err)
+		utils.PrintError(k8s.LoadKubeconfig(&kubeconfig))
+		utils.PrintError(k8s.Impersonate(cmd))
+
+		namespace, err := cmd.Flags().GetString("namespace")
+		utils.PrintError(err)
+
+		show, err := cmd.Flags().GetBool("show")
+		utils.PrintError(err)
+
+		ctx, cancel := utils.CommandContext(cmd)
+		defer cancel()
+
+		secret, err := k8s.GetSecret(ctx, utils.ChaosAgentSecret, namespace, &kubeconfig)
+		if err != nil {
+			utils.Red.Println("\n❌ Failed to read the Chaos Delegate secret: " + err.Error())
+			os.Exit(1)
+		}
+
+		if !show {
+			for k, v := range secret {
+				secret[k] = redact(v)
+			}
+		}
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(secret)
+
+		case "yaml":
+			utils.PrintInYamlFormat(secret)
+
+		case "":
+			keys := make([]string, 0, len(secret))
+			for k := range secret {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+
+			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+			utils.White_B.Fprintln(writer, "KEY\tVALUE")
+			for _, k := range keys {
+				utils.White.Fprintln(writer, k+"\t"+secret[k])
+			}
+			writer.Flush()
+
+			if !show {
+				utils.White_B.Println("\nThe values are redacted, set --show to reveal them")
+			}
+		}
+	},
+}
+
+// redact hides all but the first characters of a secret value
+func redact(value string) string {
+	if len(value) <= 8 {
+		return strings.Repeat("*", len(value))
+	}
+	return value[:4] + strings.Repeat("*", 8)
+}
+
+func init() {
+	GetCmd.AddCommand(agentCmd)
+	agentCmd.AddCommand(accessKeyCmd)
+
+	accessKeyCmd.Flags().String("namespace", utils.DefaultNs, "Set the namespace of the Chaos Delegate installation")
+	accessKeyCmd.Flags().StringP("kubeconfig", "k", "", "Set to pass kubeconfig file if it is not in the default location ($HOME/.kube/config). The in-cluster config is used when running inside a pod without a kubeconfig. Pass - to read it from stdin, or set the base64 encoded kubeconfig in KUBECONFIG_DATA")
+	k8s.AddImpersonationFlags(accessKeyCmd)
+	accessKeyCmd.Flags().Bool("show", false, "Set to reveal the values instead of redacting them")
+
+	accessKeyCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
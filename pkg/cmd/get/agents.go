@@ -16,11 +16,10 @@ limitations under the License.
 package get
 
 import (
-	"fmt"
-	"os"
-	"text/tabwriter"
+	"sort"
 
 	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
 	"github.com/litmuschaos/litmusctl/pkg/utils"
 	"github.com/spf13/cobra"
 )
@@ -38,18 +37,32 @@ var agentsCmd = &cobra.Command{
 		utils.PrintError(err)
 
 		if projectID == "" {
-			utils.White_B.Print("\nEnter the Project ID: ")
-			fmt.Scanln(&projectID)
-
-			for projectID == "" {
-				utils.Red.Println("⛔ Project ID can't be empty!!")
-				os.Exit(1)
-			}
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
 		}
 
-		agents, err := apis.GetAgentList(credentials, projectID)
+		agents, err := apis.GetAgentListByTags(credentials, projectID, getSelector(cmd))
 		utils.PrintError(err)
 
+		if order := getSort(cmd, "name", "status"); order != nil {
+			sort.SliceStable(agents.Data.GetAgent, func(i, j int) bool {
+				if order.By == "name" {
+					return order.less(agents.Data.GetAgent[i].AgentName, agents.Data.GetAgent[j].AgentName)
+				}
+				return order.less(agentStatus(agents.Data.GetAgent[i]), agentStatus(agents.Data.GetAgent[j]))
+			})
+		}
+		agents.Data.GetAgent = agents.Data.GetAgent[:limitRows(cmd, len(agents.Data.GetAgent))]
+
+		if utils.Quiet {
+			var ids []string
+			for _, agent := range agents.Data.GetAgent {
+				ids = append(ids, agent.ClusterID)
+			}
+			utils.PrintIDs(ids...)
+			return
+		}
+
 		output, err := cmd.Flags().GetString("output")
 		utils.PrintError(err)
 
@@ -60,36 +73,49 @@ var agentsCmd = &cobra.Command{
 		case "yaml":
 			utils.PrintInYamlFormat(agents.Data)
 
-		case "":
-
-			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
-			utils.White_B.Fprintln(writer, "CHAOS DELEGATE ID \tCHAOS DELEGATE NAME\tSTATUS\tREGISTRATION\t")
+		case "", utils.WideOutput:
+			table := utils.NewTable(output == utils.WideOutput,
+				utils.TableColumn{Header: "CHAOS DELEGATE ID"},
+				utils.TableColumn{Header: "CHAOS DELEGATE NAME"},
+				utils.TableColumn{Header: "STATUS"},
+				utils.TableColumn{Header: "REGISTRATION"},
+				utils.TableColumn{Header: "VERSION", Wide: true},
+				utils.TableColumn{Header: "NAMESPACE", Wide: true},
+				utils.TableColumn{Header: "LAST HEARTBEAT", Wide: true},
+				utils.TableColumn{Header: "ENVIRONMENT", Wide: true},
+			)
 
 			for _, agent := range agents.Data.GetAgent {
-				var status string
-				if agent.IsActive {
-					status = "ACTIVE"
-				} else {
-					status = "INACTIVE"
-				}
-
 				var isRegistered string
 				if agent.IsRegistered {
 					isRegistered = "REGISTERED"
 				} else {
 					isRegistered = "NOT REGISTERED"
 				}
-				utils.White.Fprintln(writer, agent.ClusterID+"\t"+agent.AgentName+"\t"+status+"\t"+isRegistered+"\t")
+				table.AddRow(agent.ClusterID, agent.AgentName, agentStatus(agent), isRegistered,
+					orDash(agent.Version), orDash(agent.Namespace), utils.ParseTimestamp(agent.LastHeartbeat), orDash(agent.EnvironmentID))
 			}
-			writer.Flush()
+			table.Print()
 		}
 	},
 }
 
+// agentStatus returns whether the Chaos Delegate is active
+func agentStatus(agent apis.AgentDetails) string {
+	if agent.IsActive {
+		return "ACTIVE"
+	}
+	return "INACTIVE"
+}
+
 func init() {
 	GetCmd.AddCommand(agentsCmd)
 
 	agentsCmd.Flags().String("project-id", "", "Set the project-id. To retrieve projects. Apply `litmusctl get projects`")
 
-	agentsCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+	addSortFlags(agentsCmd, "Chaos Delegates", "name", "status")
+	addSelectorFlag(agentsCmd, "Chaos Delegates")
+	agentsCmd.Flags().Int("limit", 0, "Set the maximum number of Chaos Delegates to display, all of them by default")
+
+	agentsCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml|wide\nwide adds the version, namespace, last heartbeat and environment of the Chaos Delegates")
 }
@@ -0,0 +1,142 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package get
+
+import (
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// environmentsCmd represents the environments command
+var environmentsCmd = &cobra.Command{
+	Use:   "environments",
+	Short: "Display list of environments within the project",
+	Long:  `Display list of environments within the project, along with their type, tags and the number of Chaos Infrastructures attached to them`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		envType, err := cmd.Flags().GetString("type")
+		utils.PrintError(err)
+
+		// --tag is the deprecated name of --selector
+		tags, err := cmd.Flags().GetStringSlice("tag")
+		utils.PrintError(err)
+		tags = append(getSelector(cmd), tags...)
+
+		request := apis.ListEnvironmentsRequest{Pagination: getPagination(cmd)}
+		if order := getSort(cmd, "name", "created"); order != nil {
+			request.Sort = &apis.EnvironmentSortInput{Field: "NAME", Ascending: &order.Ascending}
+			if order.By == "created" {
+				request.Sort.Field = "TIME"
+			}
+		}
+
+		// The environments are filtered by the server, instead of filtering all of them here
+		if envType != "" || len(tags) > 0 {
+			request.Filter = &apis.EnvironmentFilterInput{Tags: tags}
+		}
+		if envType != "" {
+			environmentType, ok := parseEnvironmentType(envType)
+			if !ok {
+				utils.Red.Println("⛔ Invalid --type " + envType + ", supported types are production/non-production")
+				os.Exit(1)
+			}
+			request.Filter.Type = &environmentType
+		}
+
+		var environments apis.EnvironmentListData
+		if request.Pagination == nil {
+			environments, err = apis.ListAllEnvironments(projectID, request, credentials)
+		} else {
+			environments, err = apis.ListEnvironments(projectID, request, credentials)
+		}
+		utils.PrintError(err)
+		filteredEnvironments := environments.Data.ListEnvironments.Environments
+
+		if utils.Quiet {
+			var ids []string
+			for _, environment := range filteredEnvironments {
+				ids = append(ids, environment.EnvironmentID)
+			}
+			utils.PrintIDs(ids...)
+			return
+		}
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(filteredEnvironments)
+
+		case "yaml":
+			utils.PrintInYamlFormat(filteredEnvironments)
+
+		case "":
+			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+			utils.White_B.Fprintln(writer, "ENVIRONMENT ID\tNAME\tTYPE\tTAGS\tCHAOS INFRASTRUCTURES")
+			for _, environment := range filteredEnvironments {
+				utils.White.Fprintln(writer, environment.EnvironmentID+"\t"+environment.Name+"\t"+environment.Type.DisplayName()+"\t"+strings.Join(environment.Tags, ",")+"\t"+strconv.Itoa(len(environment.InfraIDs)))
+			}
+			utils.White_B.Fprintln(writer, showingMessage(request.Pagination, len(filteredEnvironments), environments.Data.ListEnvironments.TotalNoOfEnvironments, "environments"))
+			writer.Flush()
+		}
+	},
+}
+
+// parseEnvironmentType parses the type of an environment from its name or its value
+func parseEnvironmentType(envType string) (types.EnvironmentType, bool) {
+	for _, t := range []types.EnvironmentType{types.ProductionEnvironment, types.NonProductionEnvironment} {
+		if strings.EqualFold(t.DisplayName(), envType) || strings.EqualFold(string(t), envType) {
+			return t, true
+		}
+	}
+	return "", false
+}
+
+func init() {
+	GetCmd.AddCommand(environmentsCmd)
+
+	environmentsCmd.Flags().String("project-id", "", "Set the project-id to list environments from the particular project. To see the projects, apply litmusctl get projects")
+	environmentsCmd.Flags().Int("limit", 30, "Set the count of environments to display per page. Default value is 30")
+	environmentsCmd.Flags().Int("count", 30, "Set the count of environments to display per page. Default value is 30")
+	utils.PrintError(environmentsCmd.Flags().MarkDeprecated("count", "use --limit instead"))
+	environmentsCmd.Flags().Int("page", 1, "Set the page of environments to display, each page holds --limit environments")
+	environmentsCmd.Flags().Bool("all", false, "Set to true to display all environments, they are fetched a page at a time")
+	addSortFlags(environmentsCmd, "environments", "name", "created")
+	environmentsCmd.Flags().String("type", "", "Filter the environments by type. One of:\nproduction|non-production")
+	addSelectorFlag(environmentsCmd, "environments")
+	environmentsCmd.Flags().StringSlice("tag", []string{}, "Filter the environments by tags, can be repeated. For example: --tag team=sre")
+	utils.PrintError(environmentsCmd.Flags().MarkDeprecated("tag", "use --selector instead"))
+	environmentsCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
@@ -0,0 +1,129 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package get
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// faultsCmd represents the chaos-faults command
+var faultsCmd = &cobra.Command{
+	Use:   "chaos-faults",
+	Short: "Display list of Chaos Faults available in a ChaosHub",
+	Long:  `Display list of Chaos Faults available in a ChaosHub`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		hubName, err := cmd.Flags().GetString("hub")
+		utils.PrintError(err)
+
+		category, err := cmd.Flags().GetString("category")
+		utils.PrintError(err)
+
+		charts, err := apis.ListCharts(projectID, hubName, credentials)
+		utils.PrintError(err)
+
+		// Filter the charts by category, if one is provided
+		var filteredCharts []model.Chart
+		for _, chart := range charts.Data.Charts {
+			if category != "" && (chart.Metadata == nil || !strings.EqualFold(chart.Metadata.Name, category)) {
+				continue
+			}
+			filteredCharts = append(filteredCharts, chart)
+		}
+
+		if utils.Quiet {
+			var ids []string
+			for _, chart := range filteredCharts {
+				if chart.PackageInfo == nil {
+					continue
+				}
+				for _, fault := range chart.PackageInfo.Experiments {
+					ids = append(ids, fault.Name)
+				}
+			}
+			utils.PrintIDs(ids...)
+			return
+		}
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(filteredCharts)
+
+		case "yaml":
+			utils.PrintInYamlFormat(filteredCharts)
+
+		case "":
+			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+			utils.White_B.Fprintln(writer, "CHAOS FAULT NAME\tCATEGORY\tDESCRIPTION")
+
+			var count int
+			for _, chart := range filteredCharts {
+				if chart.Metadata == nil || chart.PackageInfo == nil {
+					continue
+				}
+				for _, fault := range chart.PackageInfo.Experiments {
+					utils.White.Fprintln(writer, fault.Name+"\t"+chart.Metadata.Name+"\t"+faultDescription(fault.Desc))
+					count++
+				}
+			}
+
+			utils.White_B.Fprintln(writer, fmt.Sprintf("\nShowing %d Chaos Faults from ChaosHub/%s", count, hubName))
+			writer.Flush()
+		}
+	},
+}
+
+// faultDescription returns the first line of a fault description, so that
+// multi-line descriptions don't break the table layout
+func faultDescription(desc string) string {
+	desc = strings.TrimSpace(desc)
+	if i := strings.Index(desc, "\n"); i >= 0 {
+		return desc[:i] + "..."
+	}
+	return desc
+}
+
+func init() {
+	GetCmd.AddCommand(faultsCmd)
+
+	faultsCmd.Flags().String("project-id", "", "Set the project-id to list Chaos Faults from the ChaosHubs of the particular project. To see the projects, apply litmusctl get projects")
+	faultsCmd.Flags().String("hub", utils.DefaultHubName, "Set the name of the ChaosHub to list Chaos Faults from")
+	faultsCmd.Flags().String("category", "", "Set the category (chart name) to filter Chaos Faults. For example: generic, aws, kube-aws")
+
+	faultsCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
@@ -16,6 +16,7 @@ limitations under the License.
 package get
 
 import (
+	"github.com/litmuschaos/litmusctl/pkg/utils"
 	"github.com/spf13/cobra"
 )
 
@@ -26,15 +27,49 @@ var GetCmd = &cobra.Command{
 		#get list of projects accessed by the user
 		litmusctl get projects
 
+		#get list of projects owned by the user, sorted by name
+		litmusctl get projects --role=owner --sort-by=name
+
+		#get list of members of a project
+		litmusctl get project-members --project-id=""
+
+		#get list of pending project invitations of the user
+		litmusctl get invitations
+
+		#get the settings of a project
+		litmusctl get project-settings --project-id=""
+
+		#get list of production environments
+		litmusctl get environments --type=production --project-id=""
+
 		#get list of Chaos Delegates within the project
 		litmusctl get chaos-delegates --project-id=""
 
+		#get the access key of the Chaos Delegate installed in a cluster
+		litmusctl get chaos-delegate access-key --namespace=litmus
+
 		#get list of chaos Chaos Scenarios
 		litmusctl get chaos-scenarios --project-id=""
 
 		#get list of Chaos Scenario runs
 		litmusctl get chaos-scenario-runs --project-id=""
 
-		Note: The default location of the config file is $HOME/.litmusconfig, and can be overridden by a --config flag
+		#get list of Resilience Probes within the project
+		litmusctl get probes --project-id=""
+
+		#get list of Chaos Faults available in a ChaosHub
+		litmusctl get chaos-faults --hub="Litmus ChaosHub" --project-id=""
+
+		#get the version, features and auth mode of the ChaosCenter
+		litmusctl get server-info
+
+		#get list of Chaos Scenario runs with their times in RFC3339 instead of relative to now
+		litmusctl get chaos-scenario-runs --absolute-time --project-id=""
+
+		Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
 	`,
 }
+
+func init() {
+	GetCmd.PersistentFlags().BoolVar(&utils.AbsoluteTime, "absolute-time", false, "Show the times of the tables in RFC3339, e.g. 2023-06-05T10:02:11+02:00, instead of relative to now, e.g. 3h ago")
+}
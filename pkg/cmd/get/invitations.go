@@ -0,0 +1,73 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package get
+
+import (
+	"os"
+	"text/tabwriter"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// invitationsCmd represents the invitations command
+var invitationsCmd = &cobra.Command{
+	Use:   "invitations",
+	Short: "Display list of pending project invitations",
+	Long:  `Display list of pending project invitations. To join a project, apply litmusctl accept-invitation <project-id>`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		invitations, err := apis.ListInvitations(credentials)
+		utils.PrintError(err)
+
+		if utils.Quiet {
+			var ids []string
+			for _, invitation := range invitations {
+				ids = append(ids, invitation.ProjectID)
+			}
+			utils.PrintIDs(ids...)
+			return
+		}
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(invitations)
+
+		case "yaml":
+			utils.PrintInYamlFormat(invitations)
+
+		case "":
+			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+			utils.White_B.Fprintln(writer, "PROJECT ID\tPROJECT NAME\tROLE\tINVITED BY")
+			for _, invitation := range invitations {
+				utils.White.Fprintln(writer, invitation.ProjectID+"\t"+invitation.ProjectName+"\t"+invitation.InvitationRole+"\t"+invitation.ProjectOwner.UserName)
+			}
+			writer.Flush()
+		}
+	},
+}
+
+func init() {
+	GetCmd.AddCommand(invitationsCmd)
+
+	invitationsCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
@@ -0,0 +1,188 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package get
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// getPagination reads the page to fetch from the --page and --limit flags, or returns nil
+// when --all is set. The pages of the flags start from 1, the ones of the server from 0.
+func getPagination(cmd *cobra.Command) *model.Pagination {
+	listAll, err := cmd.Flags().GetBool("all")
+	utils.PrintError(err)
+	if listAll {
+		return nil
+	}
+
+	page, err := cmd.Flags().GetInt("page")
+	utils.PrintError(err)
+	limit, err := cmd.Flags().GetInt("limit")
+	utils.PrintError(err)
+	if cmd.Flags().Changed("count") {
+		// --count is the deprecated name of --limit
+		limit, err = cmd.Flags().GetInt("count")
+		utils.PrintError(err)
+	}
+
+	if page < 1 || limit < 1 {
+		utils.Red.Println("⛔ --page and --limit should be greater than 0")
+		os.Exit(1)
+	}
+
+	return &model.Pagination{Page: page - 1, Limit: limit}
+}
+
+// showingMessage returns the number of rows shown out of the total, with the next page to fetch if any
+func showingMessage(pagination *model.Pagination, shown int, total int, noun string) string {
+	message := fmt.Sprintf("\nShowing %d of %d %s", shown, total, noun)
+	if pagination != nil && (pagination.Page+1)*pagination.Limit < total {
+		message += fmt.Sprintf(", use --page=%d for the next page", pagination.Page+2)
+	}
+	return message
+}
+
+// listSort is the order of a list, set by the --sort-by and --order flags
+type listSort struct {
+	By        string
+	Ascending bool
+}
+
+// addSortFlags registers the --sort-by and --order flags with the fields the list can be sorted by
+func addSortFlags(cmd *cobra.Command, noun string, fields ...string) {
+	cmd.Flags().String("sort-by", "", "Sort the "+noun+" by one of:\n"+strings.Join(fields, "|"))
+	cmd.Flags().String("order", "", "Set the sort order, one of:\nasc|desc\nNames and statuses are sorted ascending and creation times descending by default")
+}
+
+// getSort reads the order of the list from the --sort-by and --order flags, or returns nil when
+// the list isn't sorted. The defaults of the account which don't apply to the list are ignored.
+func getSort(cmd *cobra.Command, fields ...string) *listSort {
+	sortBy, err := cmd.Flags().GetString("sort-by")
+	utils.PrintError(err)
+	order, err := cmd.Flags().GetString("order")
+	utils.PrintError(err)
+
+	if sortBy == "" {
+		return nil
+	}
+	if !contains(fields, sortBy) {
+		if !cmd.Flags().Changed("sort-by") {
+			return nil
+		}
+		utils.Red.Println("⛔ Invalid --sort-by value " + sortBy + ", supported values are " + strings.Join(fields, "/"))
+		os.Exit(1)
+	}
+
+	switch order {
+	case "":
+		return &listSort{By: sortBy, Ascending: !strings.HasPrefix(sortBy, "created")}
+	case "asc", "desc":
+		return &listSort{By: sortBy, Ascending: order == "asc"}
+	default:
+		utils.Red.Println("⛔ Invalid --order value " + order + ", supported values are asc/desc")
+		os.Exit(1)
+	}
+	return nil
+}
+
+// limitRows returns the number of rows to display out of the given rows with the --limit flag,
+// for the lists which aren't paginated by the server
+func limitRows(cmd *cobra.Command, rows int) int {
+	limit, err := cmd.Flags().GetInt("limit")
+	utils.PrintError(err)
+	if limit < 0 {
+		utils.Red.Println("⛔ --limit can't be negative")
+		os.Exit(1)
+	}
+	if limit == 0 || limit > rows {
+		return rows
+	}
+	return limit
+}
+
+// workflowSortingField returns the field the server sorts the Chaos Scenarios and their runs by
+func workflowSortingField(order *listSort) model.WorkflowSortingField {
+	if order.By == "created" {
+		return model.WorkflowSortingFieldTime
+	}
+	return model.WorkflowSortingFieldName
+}
+
+// less orders two values of the sorted field, following the order of the list. Timestamps are
+// compared as numbers, the other values case-insensitively.
+func (s *listSort) less(a string, b string) bool {
+	if s.Ascending {
+		return compareValues(a, b) < 0
+	}
+	return compareValues(a, b) > 0
+}
+
+func compareValues(a string, b string) int {
+	aInt, aErr := strconv.ParseInt(a, 10, 64)
+	bInt, bErr := strconv.ParseInt(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil && aInt < bInt:
+		return -1
+	case aErr == nil && bErr == nil && aInt > bInt:
+		return 1
+	case aErr == nil && bErr == nil:
+		return 0
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// addSelectorFlag registers the --selector flag, which filters the list by tags on the server
+func addSelectorFlag(cmd *cobra.Command, noun string) {
+	cmd.Flags().StringSliceP("selector", "l", nil, "Filter the "+noun+" by tags, can be repeated. Only the "+noun+" carrying all the tags are listed. For example: --selector team=sre")
+}
+
+// getSelector reads the tags to filter the list by from the --selector flag
+func getSelector(cmd *cobra.Command) []string {
+	selector, err := cmd.Flags().GetStringSlice("selector")
+	utils.PrintError(err)
+
+	for _, tag := range selector {
+		if parts := strings.SplitN(tag, "=", 2); len(parts) != 2 || parts[0] == "" {
+			utils.Red.Println("⛔ Invalid --selector value " + tag + ", expected key=value")
+			os.Exit(1)
+		}
+	}
+	return selector
+}
+
+// orDash returns the value of a table cell, or "-" if it's not set
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
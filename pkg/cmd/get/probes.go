@@ -0,0 +1,100 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package get
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// probesCmd represents the probes command
+var probesCmd = &cobra.Command{
+	Use:   "probes",
+	Short: "Display list of Resilience Probes within the project",
+	Long:  `Display list of Resilience Probes within the project`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		probes, err := apis.ListProbes(projectID, nil, credentials)
+		utils.PrintError(err)
+
+		if utils.Quiet {
+			var ids []string
+			for _, probe := range probes.Data.Probes {
+				ids = append(ids, probe.Name)
+			}
+			utils.PrintIDs(ids...)
+			return
+		}
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(probes.Data)
+
+		case "yaml":
+			utils.PrintInYamlFormat(probes.Data)
+
+		case "":
+			table := utils.NewTable(false,
+				utils.TableColumn{Header: "PROBE NAME"},
+				utils.TableColumn{Header: "PROBE TYPE"},
+				utils.TableColumn{Header: "REFERENCED BY"},
+				utils.TableColumn{Header: "RECENT PASS RATE"},
+				utils.TableColumn{Header: "CREATED AT"},
+			)
+
+			for _, probe := range probes.Data.Probes {
+				referencedBy := "0"
+				if probe.ReferencedBy != nil {
+					referencedBy = strconv.Itoa(*probe.ReferencedBy)
+				}
+
+				passRate := "N/A"
+				if rate, runs := probe.PassRate(); runs > 0 {
+					passRate = fmt.Sprintf("%.2f%% (%d runs)", rate, runs)
+				}
+
+				table.AddRow(probe.Name, string(probe.Type), referencedBy, passRate, utils.ParseTimestamp(probe.CreatedAt))
+			}
+			table.Print()
+		}
+	},
+}
+
+func init() {
+	GetCmd.AddCommand(probesCmd)
+
+	probesCmd.Flags().String("project-id", "", "Set the project-id to list Resilience Probes from the particular project. To see the projects, apply litmusctl get projects")
+
+	probesCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
@@ -0,0 +1,84 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package get
+
+import (
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// projectMembersCmd represents the project-members command
+var projectMembersCmd = &cobra.Command{
+	Use:   "project-members",
+	Short: "Display list of members of a project",
+	Long:  `Display list of members of a project, along with their role and invitation state`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		members, err := apis.GetProjectMembers(projectID, credentials)
+		utils.PrintError(err)
+
+		if utils.Quiet {
+			var ids []string
+			for _, member := range members {
+				ids = append(ids, member.UserName)
+			}
+			utils.PrintIDs(ids...)
+			return
+		}
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(members)
+
+		case "yaml":
+			utils.PrintInYamlFormat(members)
+
+		case "":
+			table := utils.NewTable(false,
+				utils.TableColumn{Header: "USERNAME"},
+				utils.TableColumn{Header: "ROLE"},
+				utils.TableColumn{Header: "INVITATION"},
+				utils.TableColumn{Header: "JOINED AT"},
+			)
+			for _, member := range members {
+				table.AddRow(member.UserName, member.Role, member.Invitation, utils.ParseTimestamp(member.JoinedAt))
+			}
+			table.Print()
+		}
+	},
+}
+
+func init() {
+	GetCmd.AddCommand(projectMembersCmd)
+
+	projectMembersCmd.Flags().String("project-id", "", "Set the project-id to list the members of the particular project. To see the projects, apply litmusctl get projects")
+	projectMembersCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
@@ -0,0 +1,109 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package get
+
+import (
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// projectSettingsCmd represents the project-settings command
+var projectSettingsCmd = &cobra.Command{
+	Use:   "project-settings",
+	Short: "Display the settings of a project",
+	Long:  `Display the settings of a project, i.e. the GitOps configuration and the image registry used by the Chaos Scenarios`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		settings, err := apis.GetProjectSettings(projectID, credentials)
+		utils.PrintError(err)
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(settings.Data)
+
+		case "yaml":
+			utils.PrintInYamlFormat(settings.Data)
+
+		case "":
+			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+
+			gitOps := settings.Data.GitOps
+			utils.White_B.Fprintln(writer, "GITOPS ENABLED\t"+strconv.FormatBool(gitOps.Enabled))
+			if gitOps.Enabled {
+				utils.White.Fprintln(writer, "GITOPS REPOSITORY\t"+stringValue(gitOps.RepoURL))
+				utils.White.Fprintln(writer, "GITOPS BRANCH\t"+stringValue(gitOps.Branch))
+			}
+
+			registry := activeImageRegistry(settings.Data.ImageRegistries)
+			if registry == nil || registry.ImageRegistryInfo == nil {
+				utils.White_B.Fprintln(writer, "\nIMAGE REGISTRY\tdefault (LitmusChaos registry)")
+			} else {
+				info := registry.ImageRegistryInfo
+				utils.White_B.Fprintln(writer, "\nIMAGE REGISTRY\t"+info.ImageRegistryName)
+				utils.White.Fprintln(writer, "IMAGE REPOSITORY\t"+info.ImageRepoName)
+				utils.White.Fprintln(writer, "IMAGE REGISTRY TYPE\t"+info.ImageRegistryType)
+				if info.SecretName != nil && *info.SecretName != "" {
+					utils.White.Fprintln(writer, "IMAGE PULL SECRET\t"+stringValue(info.SecretNamespace)+"/"+*info.SecretName)
+				}
+			}
+			writer.Flush()
+		}
+	},
+}
+
+// activeImageRegistry returns the image registry configured for the project, if any
+func activeImageRegistry(registries []model.ImageRegistryResponse) *model.ImageRegistryResponse {
+	for i := range registries {
+		if registries[i].IsRemoved == nil || !*registries[i].IsRemoved {
+			return &registries[i]
+		}
+	}
+	return nil
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+func init() {
+	GetCmd.AddCommand(projectSettingsCmd)
+
+	projectSettingsCmd.Flags().String("project-id", "", "Set the project-id to display the settings of the particular project. To see the projects, apply litmusctl get projects")
+	projectSettingsCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
@@ -16,9 +16,11 @@ limitations under the License.
 package get
 
 import (
+	"errors"
 	"os"
+	"sort"
 	"strconv"
-	"text/tabwriter"
+	"strings"
 	"time"
 
 	"github.com/litmuschaos/litmusctl/pkg/apis"
@@ -38,34 +40,125 @@ var projectsCmd = &cobra.Command{
 		projects, err := apis.ListProject(credentials)
 		utils.PrintError(err)
 
+		role, err := cmd.Flags().GetString("role")
+		utils.PrintError(err)
+
+		createdAfterFlag, err := cmd.Flags().GetString("created-after")
+		utils.PrintError(err)
+
+		var createdAfter time.Time
+		if createdAfterFlag != "" {
+			createdAfter, err = parseDate(createdAfterFlag)
+			utils.PrintError(err)
+		}
+
+		if role != "" {
+			switch strings.ToLower(role) {
+			case "owner", "editor", "viewer":
+			default:
+				utils.Red.Println("⛔ Invalid role " + role + ", supported roles are owner/editor/viewer")
+				os.Exit(1)
+			}
+		}
+
+		// The user ID is only needed to look up the role of the user in each project
+		var userID string
+		if role != "" {
+			userDetails, err := apis.GetProjectDetails(credentials)
+			utils.PrintError(err)
+			userID = userDetails.Data.ID
+		}
+
+		var filteredProjects []apis.Project
+		for _, project := range projects.Data {
+			if role != "" && !hasRole(project, userID, role) {
+				continue
+			}
+
+			if createdAfterFlag != "" {
+				intTime, err := strconv.ParseInt(project.CreatedAt, 10, 64)
+				utils.PrintError(err)
+
+				if !time.Unix(intTime, 0).After(createdAfter) {
+					continue
+				}
+			}
+
+			filteredProjects = append(filteredProjects, project)
+		}
+
+		// created-at is the name of the created sort of the earlier releases
+		if order := getSort(cmd, "name", "created", "created-at"); order != nil {
+			sort.SliceStable(filteredProjects, func(i, j int) bool {
+				if order.By == "name" {
+					return order.less(filteredProjects[i].Name, filteredProjects[j].Name)
+				}
+				return order.less(filteredProjects[i].CreatedAt, filteredProjects[j].CreatedAt)
+			})
+		}
+		filteredProjects = filteredProjects[:limitRows(cmd, len(filteredProjects))]
+
+		if utils.Quiet {
+			var ids []string
+			for _, project := range filteredProjects {
+				ids = append(ids, project.ID)
+			}
+			utils.PrintIDs(ids...)
+			return
+		}
+
 		output, err := cmd.Flags().GetString("output")
 		utils.PrintError(err)
 
 		switch output {
 		case "json":
-			utils.PrintInJsonFormat(projects.Data)
+			utils.PrintInJsonFormat(filteredProjects)
 
 		case "yaml":
-			utils.PrintInYamlFormat(projects.Data)
+			utils.PrintInYamlFormat(filteredProjects)
 
 		case "":
-			writer := tabwriter.NewWriter(os.Stdout, 8, 8, 8, '\t', tabwriter.AlignRight)
-			utils.White_B.Fprintln(writer, "PROJECT ID\tPROJECT NAME\tCREATED AT")
-			for _, project := range projects.Data {
-				intTime, err := strconv.ParseInt(project.CreatedAt, 10, 64)
-				utils.PrintError(err)
-
-				humanTime := time.Unix(intTime, 0)
-
-				utils.White.Fprintln(writer, project.ID+"\t"+project.Name+"\t"+humanTime.String()+"\t")
+			table := utils.NewTable(false,
+				utils.TableColumn{Header: "PROJECT ID"},
+				utils.TableColumn{Header: "PROJECT NAME"},
+				utils.TableColumn{Header: "CREATED AT"},
+			)
+			for _, project := range filteredProjects {
+				table.AddRow(project.ID, project.Name, utils.ParseTimestamp(project.CreatedAt))
 			}
-			writer.Flush()
+			table.Print()
 		}
 	},
 }
 
+// hasRole checks whether the user is a member of the project with the given role
+func hasRole(project apis.Project, userID string, role string) bool {
+	for _, member := range project.Members {
+		if member.UserID == userID && strings.EqualFold(member.Role, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseDate parses a date passed as YYYY-MM-DD or in RFC3339 format
+func parseDate(date string) (time.Time, error) {
+	if t, err := time.ParseInLocation("2006-01-02", date, time.Local); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, date); err == nil {
+		return t, nil
+	}
+	return time.Time{}, errors.New("invalid date " + date + ", expected format is YYYY-MM-DD or RFC3339")
+}
+
 func init() {
 	GetCmd.AddCommand(projectsCmd)
 
+	projectsCmd.Flags().String("role", "", "Filter the projects by your role in them. One of:\nowner|editor|viewer")
+	projectsCmd.Flags().String("created-after", "", "Filter the projects created after the given date, in YYYY-MM-DD or RFC3339 format")
+	addSortFlags(projectsCmd, "projects", "name", "created")
+	projectsCmd.Flags().Int("limit", 0, "Set the maximum number of projects to display, all of them by default")
+
 	projectsCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
 }
@@ -0,0 +1,85 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package get
+
+import (
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// serverInfoCmd represents the server-info command
+var serverInfoCmd = &cobra.Command{
+	Use:   "server-info",
+	Short: "Display the build and configuration of the ChaosCenter",
+	Long: `Display the version, build commit, GraphQL schema, enabled features and auth mode of the ChaosCenter of the current account.
+The features are introspected from the GraphQL schema, and are not listed when the introspection is disabled.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		info, err := apis.GetServerInfo(credentials)
+		utils.PrintError(err)
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(info)
+
+		case "yaml":
+			utils.PrintInYamlFormat(info)
+
+		case "":
+			buildCommit := info.BuildCommit
+			if buildCommit == "" {
+				buildCommit = "not exposed by the ChaosCenter"
+			}
+			features := strings.Join(info.Features, ", ")
+			if features == "" {
+				features = "unknown, the GraphQL introspection is disabled"
+			}
+			version, schema := info.Version, string(info.Schema)
+			if info.GraphQLBlocked {
+				version, schema = "unknown, the GraphQL API is blocked", "unknown"
+				features = "unknown, the GraphQL API is blocked"
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+			utils.White_B.Fprintln(writer, "ENDPOINT\t"+info.Endpoint)
+			utils.White.Fprintln(writer, "VERSION\t"+version)
+			utils.White.Fprintln(writer, "BUILD COMMIT\t"+buildCommit)
+			utils.White.Fprintln(writer, "SCHEMA\t"+schema)
+			utils.White.Fprintln(writer, "AUTH MODE\t"+info.AuthMode)
+			if info.AuthStatus != "" {
+				utils.White.Fprintln(writer, "AUTH SERVER\t"+info.AuthStatus)
+			}
+			utils.White.Fprintln(writer, "FEATURES\t"+features)
+			writer.Flush()
+		}
+	},
+}
+
+func init() {
+	GetCmd.AddCommand(serverInfoCmd)
+
+	serverInfoCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
@@ -0,0 +1,123 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package get
+
+import (
+	"os"
+	"time"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// serviceAccountToken is a token as listed, without the token itself
+type serviceAccountToken struct {
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"createdAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Expired   bool      `json:"expired"`
+}
+
+// serviceAccountTokensCmd represents the service-account-tokens command
+var serviceAccountTokensCmd = &cobra.Command{
+	Use:   "service-account-tokens",
+	Short: "Display list of API tokens of a service account",
+	Long:  `Display list of API tokens of a service account, identified by their name and the last characters of the token, which is not printed`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		serviceAccount, err := cmd.Flags().GetString("service-account")
+		utils.PrintError(err)
+
+		if serviceAccount == "" {
+			utils.Red.Println("⛔ --service-account flag is empty")
+			os.Exit(1)
+		}
+
+		member, err := apis.GetServiceAccount(projectID, serviceAccount, credentials)
+		utils.PrintError(err)
+
+		apiTokens, err := apis.ListAPITokens(member.UserID, credentials)
+		utils.PrintError(err)
+
+		var tokens []serviceAccountToken
+		for _, token := range apiTokens {
+			tokens = append(tokens, serviceAccountToken{
+				ID:        token.ID(),
+				Name:      token.Name,
+				CreatedAt: token.CreatedAt.Time(),
+				ExpiresAt: token.ExpiresAt.Time(),
+				Expired:   !token.ExpiresAt.Time().IsZero() && token.ExpiresAt.Time().Before(time.Now()),
+			})
+		}
+
+		if utils.Quiet {
+			var ids []string
+			for _, token := range tokens {
+				ids = append(ids, token.ID)
+			}
+			utils.PrintIDs(ids...)
+			return
+		}
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(tokens)
+
+		case "yaml":
+			utils.PrintInYamlFormat(tokens)
+
+		case "":
+			table := utils.NewTable(false,
+				utils.TableColumn{Header: "TOKEN ID"},
+				utils.TableColumn{Header: "NAME"},
+				utils.TableColumn{Header: "CREATED AT"},
+				utils.TableColumn{Header: "EXPIRES AT"},
+			)
+			for _, token := range tokens {
+				expiresAt := utils.FormatTime(token.ExpiresAt)
+				if token.Expired {
+					expiresAt += " (expired)"
+				}
+				table.AddRow(token.ID, token.Name, token.CreatedAt, expiresAt)
+			}
+			table.Print()
+		}
+	},
+}
+
+func init() {
+	GetCmd.AddCommand(serviceAccountTokensCmd)
+
+	serviceAccountTokensCmd.Flags().String("project-id", "", "Set the project-id the service account is a member of. To see the projects, apply litmusctl get projects")
+	serviceAccountTokensCmd.Flags().String("service-account", "", "Set the name of the service account. To see the service accounts, apply litmusctl get service-accounts")
+	serviceAccountTokensCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
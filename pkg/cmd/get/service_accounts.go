@@ -0,0 +1,91 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package get
+
+import (
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// serviceAccountsCmd represents the service-accounts command
+var serviceAccountsCmd = &cobra.Command{
+	Use:   "service-accounts",
+	Short: "Display list of service accounts of a project",
+	Long:  `Display list of service accounts of a project, i.e. the members created with litmusctl create service-account, along with their role`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		members, err := apis.GetProjectMembers(projectID, credentials)
+		utils.PrintError(err)
+
+		var serviceAccounts []apis.Member
+		for _, member := range members {
+			if apis.IsServiceAccount(member.UserName) {
+				serviceAccounts = append(serviceAccounts, member)
+			}
+		}
+
+		if utils.Quiet {
+			var ids []string
+			for _, member := range serviceAccounts {
+				ids = append(ids, member.UserName)
+			}
+			utils.PrintIDs(ids...)
+			return
+		}
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(serviceAccounts)
+
+		case "yaml":
+			utils.PrintInYamlFormat(serviceAccounts)
+
+		case "":
+			table := utils.NewTable(false,
+				utils.TableColumn{Header: "SERVICE ACCOUNT"},
+				utils.TableColumn{Header: "ROLE"},
+				utils.TableColumn{Header: "INVITATION"},
+				utils.TableColumn{Header: "JOINED AT"},
+			)
+			for _, member := range serviceAccounts {
+				table.AddRow(member.UserName, member.Role, member.Invitation, utils.ParseTimestamp(member.JoinedAt))
+			}
+			table.Print()
+		}
+	},
+}
+
+func init() {
+	GetCmd.AddCommand(serviceAccountsCmd)
+
+	serviceAccountsCmd.Flags().String("project-id", "", "Set the project-id to list the service accounts of the particular project. To see the projects, apply litmusctl get projects")
+	serviceAccountsCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
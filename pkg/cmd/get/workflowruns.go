@@ -16,14 +16,16 @@ limitations under the License.
 package get
 
 import (
-	"fmt"
+	"context"
 	"os"
+	"os/signal"
+	"sort"
 	"strconv"
-	"text/tabwriter"
-	"time"
 
 	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
 	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/types"
 	"github.com/litmuschaos/litmusctl/pkg/utils"
 	"github.com/spf13/cobra"
 )
@@ -43,26 +45,52 @@ var workflowRunsCmd = &cobra.Command{
 		utils.PrintError(err)
 
 		if listWorkflowRunsRequest.ProjectID == "" {
-			utils.White_B.Print("\nEnter the Project ID: ")
-			fmt.Scanln(&listWorkflowRunsRequest.ProjectID)
+			listWorkflowRunsRequest.ProjectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
 
-			for listWorkflowRunsRequest.ProjectID == "" {
-				utils.Red.Println("⛔ Project ID can't be empty!!")
-				os.Exit(1)
-			}
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		watch, err := cmd.Flags().GetBool("watch")
+		utils.PrintError(err)
+		if watch {
+			watchWorkflowRuns(listWorkflowRunsRequest.ProjectID, output, credentials)
+			return
 		}
 
-		listAllWorkflowRuns, _ := cmd.Flags().GetBool("all")
-		if !listAllWorkflowRuns {
-			listWorkflowRunsRequest.Pagination = &model.Pagination{}
-			listWorkflowRunsRequest.Pagination.Limit, _ = cmd.Flags().GetInt("count")
+		listWorkflowRunsRequest.Pagination = getPagination(cmd)
+
+		// The runs can't be sorted by status on the server, the fetched runs are sorted instead
+		order := getSort(cmd, "name", "created", "status")
+		if order != nil && order.By != "status" {
+			descending := !order.Ascending
+			listWorkflowRunsRequest.Sort = &model.WorkflowRunSortInput{Field: workflowSortingField(order), Descending: &descending}
 		}
 
-		workflowRuns, err := apis.GetWorkflowRunsList(listWorkflowRunsRequest, credentials)
+		var workflowRuns apis.WorkflowRunsListData
+		if listWorkflowRunsRequest.Pagination == nil {
+			workflowRuns, err = apis.GetAllWorkflowRuns(listWorkflowRunsRequest, credentials)
+		} else {
+			workflowRuns, err = apis.GetWorkflowRunsList(listWorkflowRunsRequest, credentials)
+		}
 		utils.PrintError(err)
 
-		output, err := cmd.Flags().GetString("output")
-		utils.PrintError(err)
+		if order != nil && order.By == "status" {
+			runs := workflowRuns.Data.ListWorkflowRunsDetails.WorkflowRuns
+			sort.SliceStable(runs, func(i, j int) bool {
+				return order.less(runs[i].Phase, runs[j].Phase)
+			})
+		}
+
+		if utils.Quiet {
+			var ids []string
+			for _, workflowRun := range workflowRuns.Data.ListWorkflowRunsDetails.WorkflowRuns {
+				ids = append(ids, workflowRun.WorkflowRunID)
+			}
+			utils.PrintIDs(ids...)
+			return
+		}
 
 		switch output {
 		case "json":
@@ -71,43 +99,96 @@ var workflowRunsCmd = &cobra.Command{
 		case "yaml":
 			utils.PrintInYamlFormat(workflowRuns.Data)
 
-		case "":
+		case "", utils.WideOutput:
+			table := workflowRunsTable(output == utils.WideOutput)
+			for _, workflowRun := range workflowRuns.Data.ListWorkflowRunsDetails.WorkflowRuns {
+				table.AddRow(workflowRunRow(workflowRun)...)
+			}
+			table.Print()
 
-			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
-			utils.White_B.Fprintln(writer, "CHAOS SCENARIO RUN ID\tSTATUS\tRESILIENCY SCORE\tCHAOS SCENARIO ID\tCHAOS SCENARIO NAME\tTARGET CHAOS DELEGATE\tLAST RUN\tEXECUTED BY")
+			utils.White_B.Println(showingMessage(listWorkflowRunsRequest.Pagination, len(workflowRuns.Data.ListWorkflowRunsDetails.WorkflowRuns), workflowRuns.Data.ListWorkflowRunsDetails.TotalNoOfWorkflowRuns, "Chaos Scenario runs"))
+		}
+	},
+}
 
-			for _, workflowRun := range workflowRuns.Data.ListWorkflowRunsDetails.WorkflowRuns {
+// workflowRunsTable returns the table of the Chaos Scenario runs
+func workflowRunsTable(wide bool) *utils.Table {
+	return utils.NewTable(wide,
+		utils.TableColumn{Header: "CHAOS SCENARIO RUN ID"},
+		utils.TableColumn{Header: "STATUS"},
+		utils.TableColumn{Header: "RESILIENCY SCORE"},
+		utils.TableColumn{Header: "CHAOS SCENARIO ID"},
+		utils.TableColumn{Header: "CHAOS SCENARIO NAME"},
+		utils.TableColumn{Header: "TARGET CHAOS DELEGATE"},
+		utils.TableColumn{Header: "LAST RUN"},
+		utils.TableColumn{Header: "EXECUTED BY"},
+		utils.TableColumn{Header: "CHAOS DELEGATE ID", Wide: true},
+		utils.TableColumn{Header: "FAULTS PASSED", Wide: true},
+		utils.TableColumn{Header: "FAULTS FAILED", Wide: true},
+	)
+}
 
-				var lastUpdated string
-				unixSecondsInt, err := strconv.ParseInt(workflowRun.LastUpdated, 10, 64)
-				if err != nil {
-					lastUpdated = "None"
-				} else {
-					lastUpdated = time.Unix(unixSecondsInt, 0).Format("January 2 2006, 03:04:05 pm")
-				}
-
-				utils.White.Fprintln(
-					writer,
-					workflowRun.WorkflowRunID+"\t"+workflowRun.Phase+"\t"+strconv.FormatFloat(*workflowRun.ResiliencyScore, 'f', 2, 64)+"\t"+workflowRun.WorkflowID+"\t"+workflowRun.WorkflowName+"\t"+workflowRun.ClusterName+"\t"+lastUpdated+"\t"+workflowRun.ExecutedBy)
-			}
+// workflowRunRow returns the row of the Chaos Scenario run in the table
+func workflowRunRow(workflowRun *model.WorkflowRun) []interface{} {
+	// The resiliency score is only set once the run is completed
+	var resiliencyScore float64
+	if workflowRun.ResiliencyScore != nil {
+		resiliencyScore = *workflowRun.ResiliencyScore
+	}
 
-			if listAllWorkflowRuns || (workflowRuns.Data.ListWorkflowRunsDetails.TotalNoOfWorkflowRuns <= listWorkflowRunsRequest.Pagination.Limit) {
-				utils.White_B.Fprintln(writer, fmt.Sprintf("\nShowing %d of %d Chaos Scenario runs", workflowRuns.Data.ListWorkflowRunsDetails.TotalNoOfWorkflowRuns, workflowRuns.Data.ListWorkflowRunsDetails.TotalNoOfWorkflowRuns))
-			} else {
-				utils.White_B.Fprintln(writer, fmt.Sprintf("\nShowing %d of %d Chaos Scenario runs", listWorkflowRunsRequest.Pagination.Limit, workflowRuns.Data.ListWorkflowRunsDetails.TotalNoOfWorkflowRuns))
-			}
+	return []interface{}{workflowRun.WorkflowRunID, workflowRun.Phase, strconv.FormatFloat(resiliencyScore, 'f', 2, 64), workflowRun.WorkflowID, workflowRun.WorkflowName, workflowRun.ClusterName, utils.ParseTimestamp(workflowRun.LastUpdated), workflowRun.ExecutedBy,
+		workflowRun.ClusterID, faultCount(workflowRun.ExperimentsPassed), faultCount(workflowRun.ExperimentsFailed)}
+}
+
+// faultCount returns the number of Chaos Faults of a run, or "-" if the ChaosCenter didn't count them
+func faultCount(count *int) string {
+	if count == nil {
+		return "-"
+	}
+	return strconv.Itoa(*count)
+}
+
+// watchWorkflowRuns prints the latest Chaos Scenario runs of the project, and then the runs as they're
+// updated until it's interrupted
+func watchWorkflowRuns(projectID string, output string, credentials types.Credentials) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
 
-			writer.Flush()
+	table := workflowRunsTable(output == utils.WideOutput)
+
+	err := apis.WatchWorkflowRuns(ctx, projectID, credentials, func(workflowRun *model.WorkflowRun) bool {
+		if utils.Quiet {
+			utils.PrintIDs(workflowRun.WorkflowRunID)
+			return true
 		}
-	},
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(workflowRun)
+		case "yaml":
+			utils.PrintInYamlFormat(workflowRun)
+		case "", utils.WideOutput:
+			table.AddRow(workflowRunRow(workflowRun)...)
+			table.Print()
+		}
+		return true
+	})
+	if err != nil && ctx.Err() == nil {
+		utils.PrintError(err)
+	}
 }
 
 func init() {
 	GetCmd.AddCommand(workflowRunsCmd)
 
 	workflowRunsCmd.Flags().String("project-id", "", "Set the project-id to list Chaos Scenarios from the particular project. To see the projects, apply litmusctl get projects")
-	workflowRunsCmd.Flags().Int("count", 30, "Set the count of Chaos Scenario runs to display. Default value is 30")
-	workflowRunsCmd.Flags().BoolP("all", "A", false, "Set to true to display all Chaos Scenario runs")
-
-	workflowRunsCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+	workflowRunsCmd.Flags().Int("limit", 30, "Set the count of Chaos Scenario runs to display per page. Default value is 30")
+	workflowRunsCmd.Flags().Int("count", 30, "Set the count of Chaos Scenario runs to display per page. Default value is 30")
+	utils.PrintError(workflowRunsCmd.Flags().MarkDeprecated("count", "use --limit instead"))
+	workflowRunsCmd.Flags().Int("page", 1, "Set the page of Chaos Scenario runs to display, each page holds --limit Chaos Scenario runs")
+	workflowRunsCmd.Flags().BoolP("all", "A", false, "Set to true to display all Chaos Scenario runs, they are fetched a page at a time")
+	addSortFlags(workflowRunsCmd, "Chaos Scenario runs", "name", "created", "status")
+	workflowRunsCmd.Flags().BoolP("watch", "w", false, "Watch the Chaos Scenario runs, the latest runs are printed and then the runs as they're updated. They're streamed from the ChaosCenter, or polled every 5 seconds where it can't stream them")
+
+	workflowRunsCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml|wide\nwide adds the Chaos Delegate ID and the number of passed and failed Chaos Faults of the runs")
 }
@@ -16,14 +16,12 @@ limitations under the License.
 package get
 
 import (
-	"fmt"
-	"os"
-	"text/tabwriter"
 	"time"
 
 	"github.com/gorhill/cronexpr"
 	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
 	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
 	"github.com/litmuschaos/litmusctl/pkg/utils"
 	"github.com/spf13/cobra"
 )
@@ -43,19 +41,14 @@ var workflowsCmd = &cobra.Command{
 		utils.PrintError(err)
 
 		if listWorkflowsRequest.ProjectID == "" {
-			utils.White_B.Print("\nEnter the Project ID: ")
-			fmt.Scanln(&listWorkflowsRequest.ProjectID)
-
-			for listWorkflowsRequest.ProjectID == "" {
-				utils.Red.Println("⛔ Project ID can't be empty!!")
-				os.Exit(1)
-			}
+			listWorkflowsRequest.ProjectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
 		}
 
-		listAllWorkflows, _ := cmd.Flags().GetBool("all")
-		if !listAllWorkflows {
-			listWorkflowsRequest.Pagination = &model.Pagination{}
-			listWorkflowsRequest.Pagination.Limit, _ = cmd.Flags().GetInt("count")
+		listWorkflowsRequest.Pagination = getPagination(cmd)
+		if order := getSort(cmd, "name", "created"); order != nil {
+			descending := !order.Ascending
+			listWorkflowsRequest.Sort = &model.WorkflowSortInput{Field: workflowSortingField(order), Descending: &descending}
 		}
 
 		listWorkflowsRequest.Filter = &model.WorkflowFilterInput{}
@@ -63,9 +56,23 @@ var workflowsCmd = &cobra.Command{
 		utils.PrintError(err)
 		listWorkflowsRequest.Filter.ClusterName = &agentName
 
-		workflows, err := apis.GetWorkflowList(listWorkflowsRequest, credentials)
+		var workflows apis.WorkflowListData
+		if listWorkflowsRequest.Pagination == nil {
+			workflows, err = apis.GetAllWorkflows(listWorkflowsRequest, getSelector(cmd), credentials)
+		} else {
+			workflows, err = apis.GetWorkflowListByTags(listWorkflowsRequest, getSelector(cmd), credentials)
+		}
 		utils.PrintError(err)
 
+		if utils.Quiet {
+			var ids []string
+			for _, workflow := range workflows.Data.ListWorkflowDetails.Workflows {
+				ids = append(ids, workflow.WorkflowID)
+			}
+			utils.PrintIDs(ids...)
+			return
+		}
+
 		output, err := cmd.Flags().GetString("output")
 		utils.PrintError(err)
 
@@ -76,29 +83,32 @@ var workflowsCmd = &cobra.Command{
 		case "yaml":
 			utils.PrintInYamlFormat(workflows.Data)
 
-		case "":
-
-			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
-			utils.White_B.Fprintln(writer, "CHAOS SCENARIO ID\tCHAOS SCENARIO NAME\tCHAOS SCENARIO TYPE\tNEXT SCHEDULE\tCHAOS DELEGATE ID\tCHAOS DELEGATE NAME\tLAST UPDATED BY")
+		case "", utils.WideOutput:
+			table := utils.NewTable(output == utils.WideOutput,
+				utils.TableColumn{Header: "CHAOS SCENARIO ID"},
+				utils.TableColumn{Header: "CHAOS SCENARIO NAME"},
+				utils.TableColumn{Header: "CHAOS SCENARIO TYPE"},
+				utils.TableColumn{Header: "NEXT SCHEDULE"},
+				utils.TableColumn{Header: "CHAOS DELEGATE ID"},
+				utils.TableColumn{Header: "CHAOS DELEGATE NAME"},
+				utils.TableColumn{Header: "LAST UPDATED BY"},
+				utils.TableColumn{Header: "CHAOS FAULTS", Wide: true},
+				utils.TableColumn{Header: "CREATED AT", Wide: true},
+				utils.TableColumn{Header: "UPDATED AT", Wide: true},
+			)
 
 			for _, workflow := range workflows.Data.ListWorkflowDetails.Workflows {
+				scenarioType, nextSchedule := "Non Cron Chaos Scenario", "None"
 				if workflow.CronSyntax != "" {
-					utils.White.Fprintln(
-						writer,
-						workflow.WorkflowID+"\t"+workflow.WorkflowName+"\tCron Chaos Scenario\t"+cronexpr.MustParse(workflow.CronSyntax).Next(time.Now()).Format("January 2 2006, 03:04:05 pm")+"\t"+workflow.ClusterID+"\t"+workflow.ClusterName+"\t"+*workflow.LastUpdatedBy)
-				} else {
-					utils.White.Fprintln(
-						writer,
-						workflow.WorkflowID+"\t"+workflow.WorkflowName+"\tNon Cron Chaos Scenario\tNone\t"+workflow.ClusterID+"\t"+workflow.ClusterName+"\t"+*workflow.LastUpdatedBy)
+					scenarioType = "Cron Chaos Scenario"
+					nextSchedule = utils.FormatTime(cronexpr.MustParse(workflow.CronSyntax).Next(time.Now()))
 				}
+				table.AddRow(workflow.WorkflowID, workflow.WorkflowName, scenarioType, nextSchedule, workflow.ClusterID, workflow.ClusterName, *workflow.LastUpdatedBy,
+					len(workflow.Weightages), utils.ParseTimestamp(workflow.CreatedAt), utils.ParseTimestamp(workflow.UpdatedAt))
 			}
+			table.Print()
 
-			if listAllWorkflows || (workflows.Data.ListWorkflowDetails.TotalNoOfWorkflows <= listWorkflowsRequest.Pagination.Limit) {
-				utils.White_B.Fprintln(writer, fmt.Sprintf("\nShowing %d of %d Chaos Scenarios", workflows.Data.ListWorkflowDetails.TotalNoOfWorkflows, workflows.Data.ListWorkflowDetails.TotalNoOfWorkflows))
-			} else {
-				utils.White_B.Fprintln(writer, fmt.Sprintf("\nShowing %d of %d Chaos Scenarios", listWorkflowsRequest.Pagination.Limit, workflows.Data.ListWorkflowDetails.TotalNoOfWorkflows))
-			}
-			writer.Flush()
+			utils.White_B.Println(showingMessage(listWorkflowsRequest.Pagination, len(workflows.Data.ListWorkflowDetails.Workflows), workflows.Data.ListWorkflowDetails.TotalNoOfWorkflows, "Chaos Scenarios"))
 		}
 	},
 }
@@ -107,9 +117,14 @@ func init() {
 	GetCmd.AddCommand(workflowsCmd)
 
 	workflowsCmd.Flags().String("project-id", "", "Set the project-id to list Chaos Scenarios from the particular project. To see the projects, apply litmusctl get projects")
-	workflowsCmd.Flags().Int("count", 30, "Set the count of Chaos Scenarios to display. Default value is 30")
-	workflowsCmd.Flags().Bool("all", false, "Set to true to display all Chaos Scenarios")
+	workflowsCmd.Flags().Int("limit", 30, "Set the count of Chaos Scenarios to display per page. Default value is 30")
+	workflowsCmd.Flags().Int("count", 30, "Set the count of Chaos Scenarios to display per page. Default value is 30")
+	utils.PrintError(workflowsCmd.Flags().MarkDeprecated("count", "use --limit instead"))
+	workflowsCmd.Flags().Int("page", 1, "Set the page of Chaos Scenarios to display, each page holds --limit Chaos Scenarios")
+	workflowsCmd.Flags().Bool("all", false, "Set to true to display all Chaos Scenarios, they are fetched a page at a time")
+	addSortFlags(workflowsCmd, "Chaos Scenarios", "name", "created")
+	addSelectorFlag(workflowsCmd, "Chaos Scenarios")
 	workflowsCmd.Flags().StringP("chaos-delegate", "A", "", "Set the Chaos Delegate name to display all Chaos Scenarios targeted towards that particular Chaos Delegate.")
 
-	workflowsCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+	workflowsCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml|wide\nwide adds the number of Chaos Faults and the creation and update times of the Chaos Scenarios")
 }
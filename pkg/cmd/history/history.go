@@ -0,0 +1,104 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package history
+
+import (
+	"os"
+	"text/tabwriter"
+
+	"github.com/litmuschaos/litmusctl/pkg/history"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// HistoryCmd represents the history command
+var HistoryCmd = &cobra.Command{
+	Use:   "history",
+	Short: "List the create, update, delete and connect operations performed with litmusctl",
+	Long: `List the create, update, delete and connect operations performed with litmusctl, from the local audit log.
+Every mutating command is appended to the log before it runs, with the account, the operation and its target, and its
+result once it's done. The result of the commands which exited before recording it is unknown.
+
+The audit log is kept in the litmusctl directory of the user config directory, e.g. $HOME/.config/litmusctl/audit.jsonl,
+and can be moved with the ` + history.PathEnv + ` environment variable.
+
+Examples:
+	#list the last 20 operations
+	litmusctl history
+
+	#list the failed operations of an account as JSON
+	litmusctl history --account https://chaos.example.com --result failed -o json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		operations, err := history.Read()
+		utils.PrintError(err)
+
+		account, err := cmd.Flags().GetString("account")
+		utils.PrintError(err)
+		result, err := cmd.Flags().GetString("result")
+		utils.PrintError(err)
+
+		var filtered []history.Operation
+		for _, operation := range operations {
+			if (account == "" || operation.Account == account) && (result == "" || operation.Result == result) {
+				filtered = append(filtered, operation)
+			}
+		}
+
+		limit, err := cmd.Flags().GetInt("limit")
+		utils.PrintError(err)
+		if limit > 0 && len(filtered) > limit {
+			filtered = filtered[len(filtered)-limit:]
+		}
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(filtered)
+
+		case "yaml":
+			utils.PrintInYamlFormat(filtered)
+
+		case "":
+			if len(filtered) == 0 {
+				utils.White_B.Println("No operations recorded")
+				return
+			}
+
+			writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+			utils.White_B.Fprintln(writer, "TIME\tACCOUNT\tUSERNAME\tOPERATION\tTARGET\tRESULT\tDURATION")
+			for _, operation := range filtered {
+				status := operation.Result
+				if operation.Error != "" {
+					status += ": " + operation.Error
+				}
+				utils.White.Fprintln(writer, operation.Time.Local().Format("2006-01-02 15:04:05")+"\t"+operation.Account+"\t"+operation.Username+"\t"+
+					operation.Operation+"\t"+operation.Target+"\t"+status+"\t"+operation.Duration)
+			}
+			writer.Flush()
+		}
+	},
+}
+
+func init() {
+	HistoryCmd.Flags().Int("limit", 20, "Set the number of the latest operations to list, 0 lists all of them")
+	HistoryCmd.Flags().String("account", "", "List only the operations on the account with the given endpoint")
+	HistoryCmd.Flags().String("result", "", "List only the operations with the given result. One of:\nsucceeded|failed|unknown")
+	HistoryCmd.Flags().StringP("output", "o", "", "Output format. One of:\njson|yaml")
+}
@@ -0,0 +1,104 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package invitation
+
+import (
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// AcceptInvitationCmd represents the accept-invitation command
+var AcceptInvitationCmd = &cobra.Command{
+	Use: "accept-invitation <project-id>",
+	Short: `Accept an invitation to join a project
+	Example:
+	#accept an invitation to join a project
+	litmusctl accept-invitation 50addd40-8767-448c-a91a-5071543a2d8e
+
+	Note: To see the pending invitations, apply litmusctl get invitations
+	The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		invitation := getInvitation(args[0], credentials)
+
+		userDetails, err := apis.GetProjectDetails(credentials)
+		utils.PrintError(err)
+
+		_, err = apis.AcceptInvitation(invitation.ProjectID, userDetails.Data.ID, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Error in accepting the invitation: " + err.Error())
+			os.Exit(1)
+		}
+
+		utils.White_B.Println("\n🚀 Successfully joined project/" + invitation.ProjectName + " as " + invitation.InvitationRole + ".")
+	},
+}
+
+// DeclineInvitationCmd represents the decline-invitation command
+var DeclineInvitationCmd = &cobra.Command{
+	Use: "decline-invitation <project-id>",
+	Short: `Decline an invitation to join a project
+	Example:
+	#decline an invitation to join a project
+	litmusctl decline-invitation 50addd40-8767-448c-a91a-5071543a2d8e
+
+	Note: To see the pending invitations, apply litmusctl get invitations
+	The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		invitation := getInvitation(args[0], credentials)
+
+		userDetails, err := apis.GetProjectDetails(credentials)
+		utils.PrintError(err)
+
+		_, err = apis.DeclineInvitation(invitation.ProjectID, userDetails.Data.ID, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Error in declining the invitation: " + err.Error())
+			os.Exit(1)
+		}
+
+		utils.White_B.Println("\n🚀 Invitation to project/" + invitation.ProjectName + " successfully declined.")
+	},
+}
+
+// getInvitation returns the pending invitation of the user to the project
+func getInvitation(projectID string, credentials types.Credentials) apis.Invitation {
+	invitations, err := apis.ListInvitations(credentials)
+	utils.PrintError(err)
+
+	for _, invitation := range invitations {
+		if invitation.ProjectID == projectID {
+			return invitation
+		}
+	}
+
+	utils.Red.Println("⛔ No pending invitation found for project: " + projectID)
+	os.Exit(1)
+	return apis.Invitation{}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package portforward
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/k8s"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// chaosCenterCmd represents the chaos-center command
+var chaosCenterCmd = &cobra.Command{
+	Use: "chaos-center",
+	Short: `Forward a local port to the ChaosCenter frontend, for the clusters without a LoadBalancer
+	Example:
+	#reach the ChaosCenter at http://localhost:8080
+	litmusctl port-forward chaos-center --port=8080
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		kubeconfig, err := cmd.Flags().GetString("kubeconfig")
+		utils.PrintError(err)
+		utils.PrintError(k8s.LoadKubeconfig(&kubeconfig))
+		utils.PrintError(k8s.Impersonate(cmd))
+
+		namespace, err := cmd.Flags().GetString("namespace")
+		utils.PrintError(err)
+
+		port, err := cmd.Flags().GetInt("port")
+		utils.PrintError(err)
+
+		ctx, cancel := utils.CommandContext(cmd)
+		defer cancel()
+
+		service, err := k8s.FindService(ctx, namespace, utils.ChaosCenterFrontendLabel, &kubeconfig)
+		if err != nil {
+			utils.Red.Println("\n❌ Failed to find the ChaosCenter frontend service: " + err.Error())
+			os.Exit(1)
+		}
+
+		ready := make(chan struct{})
+		go func() {
+			<-ready
+			endpoint := fmt.Sprintf("http://localhost:%d", port)
+			utils.White_B.Println("\n🚀 ChaosCenter is available at " + endpoint)
+			utils.White_B.Println("👉 Log in with: litmusctl config set-account --server=" + endpoint)
+			utils.White.Println("\nPress Ctrl+C to stop forwarding")
+		}()
+
+		err = k8s.PortForwardService(ctx, service, port, ready, &kubeconfig)
+		if err != nil {
+			utils.Red.Println("\n❌ Port-forward to " + service.Namespace + "/" + service.Name + " failed: " + err.Error())
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	PortForwardCmd.AddCommand(chaosCenterCmd)
+
+	chaosCenterCmd.Flags().Int("port", 8080, "Set the local port to forward to the ChaosCenter")
+	chaosCenterCmd.Flags().String("namespace", "", "Set the namespace of the ChaosCenter installation. All the namespaces are searched by default")
+	// The namespace default of the account is the one of the Chaos Delegate
+	utils.PrintError(chaosCenterCmd.Flags().SetAnnotation("namespace", utils.NoAccountDefaultsAnnotation, []string{"true"}))
+	chaosCenterCmd.Flags().StringP("kubeconfig", "k", "", "Set to pass kubeconfig file if it is not in the default location ($HOME/.kube/config). The in-cluster config is used when running inside a pod without a kubeconfig. Pass - to read it from stdin, or set the base64 encoded kubeconfig in KUBECONFIG_DATA")
+	k8s.AddImpersonationFlags(chaosCenterCmd)
+}
@@ -0,0 +1,32 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package portforward
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// PortForwardCmd represents the port-forward command
+var PortForwardCmd = &cobra.Command{
+	Use: "port-forward",
+	Short: `Forward a local port to the LitmusChaos components running in a cluster.
+		Examples:
+		#reach the ChaosCenter at http://localhost:8080
+		litmusctl port-forward chaos-center --port=8080
+
+		Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+}
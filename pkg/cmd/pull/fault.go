@@ -0,0 +1,119 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package pull
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// faultCmd represents the chaos-fault command
+var faultCmd = &cobra.Command{
+	Use: "chaos-fault <hub>/<fault>",
+	Short: `Pull the ChaosExperiment and ChaosEngine manifests of a Chaos Fault from a ChaosHub
+	Example:
+	#pull a Chaos Fault
+	litmusctl pull chaos-fault "Litmus ChaosHub"/pod-delete -o ./faults/ --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		// The hub name may contain spaces, so only the last separator splits the fault name
+		separator := strings.LastIndex(args[0], "/")
+		if separator <= 0 || separator == len(args[0])-1 {
+			utils.Red.Println("⛔ Invalid Chaos Fault reference. Correct format: <hub>/<fault>")
+			os.Exit(1)
+		}
+		hubName, faultName := args[0][:separator], args[0][separator+1:]
+
+		outputDir, err := cmd.Flags().GetString("output-dir")
+		utils.PrintError(err)
+
+		// Find the chart which contains the fault
+		charts, err := apis.ListCharts(projectID, hubName, credentials)
+		utils.PrintError(err)
+
+		var chartName string
+	outerloop:
+		for _, chart := range charts.Data.Charts {
+			if chart.Metadata == nil || chart.PackageInfo == nil {
+				continue
+			}
+			for _, fault := range chart.PackageInfo.Experiments {
+				if fault.Name == faultName {
+					chartName = chart.Metadata.Name
+					break outerloop
+				}
+			}
+		}
+
+		if chartName == "" {
+			utils.Red.Println("⛔ Chaos Fault/" + faultName + " not found in ChaosHub/" + hubName + ". To see the Chaos Faults, apply litmusctl get chaos-faults")
+			os.Exit(1)
+		}
+
+		details, err := apis.GetExperimentDetails(model.ExperimentRequest{
+			ProjectID:      projectID,
+			ChartName:      chartName,
+			ExperimentName: faultName,
+			HubName:        hubName,
+		}, credentials)
+		utils.PrintError(err)
+
+		faultDir := filepath.Join(outputDir, faultName)
+		err = os.MkdirAll(faultDir, 0755)
+		utils.PrintError(err)
+
+		experimentPath := filepath.Join(faultDir, "experiment.yaml")
+		err = ioutil.WriteFile(experimentPath, []byte(details.Data.ExperimentDetails.ExperimentDetails), 0644)
+		utils.PrintError(err)
+
+		enginePath := filepath.Join(faultDir, "engine.yaml")
+		err = ioutil.WriteFile(enginePath, []byte(details.Data.ExperimentDetails.EngineDetails), 0644)
+		utils.PrintError(err)
+
+		utils.White_B.Println("\n🚀 Chaos Fault/" + faultName + " successfully pulled from ChaosHub/" + hubName)
+		utils.White.Println("ChaosExperiment: " + experimentPath)
+		utils.White.Println("ChaosEngine: " + enginePath)
+	},
+}
+
+func init() {
+	PullCmd.AddCommand(faultCmd)
+
+	faultCmd.Flags().String("project-id", "", "Set the project-id to pull the Chaos Fault from the ChaosHubs of the particular project. To see the projects, apply litmusctl get projects")
+	faultCmd.Flags().StringP("output-dir", "o", ".", "Set the directory in which the Chaos Fault manifests will be saved")
+}
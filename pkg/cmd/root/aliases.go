@@ -0,0 +1,73 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rootCmd
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// resourceAliases are the kubectl-style short names of the resources, e.g. litmusctl get cd. They're
+// given to the commands of the resource under every verb, for its singular and plural names alike.
+var resourceAliases = map[string][]string{
+	"chaos-delegate":      {"cd"},
+	"chaos-delegates":     {"cd"},
+	"chaos-scenario":      {"cs"},
+	"chaos-scenarios":     {"cs"},
+	"chaos-scenario-runs": {"csr"},
+	"chaos-hub":           {"ch"},
+	"chaos-fault":         {"cf"},
+	"chaos-faults":        {"cf"},
+	"environment":         {"env"},
+	"environments":        {"env"},
+	"project-member":      {"pm"},
+	"project-members":     {"pm"},
+	"service-account":     {"sa"},
+	"service-accounts":    {"sa"},
+}
+
+// addResourceAliases adds the short names of resourceAliases to the subcommands of the command, and of
+// theirs. A short name already used by another subcommand of the same command is left out, so that
+// it's never ambiguous. The plural names come first, so that e.g. litmusctl get cd lists the Chaos
+// Delegates like kubectl get does, rather than describing the one installed in the cluster.
+func addResourceAliases(cmd *cobra.Command) {
+	used := make(map[string]bool)
+	for _, sub := range cmd.Commands() {
+		used[sub.Name()] = true
+		for _, alias := range sub.Aliases {
+			used[alias] = true
+		}
+	}
+
+	for _, plural := range []bool{true, false} {
+		for _, sub := range cmd.Commands() {
+			if strings.HasSuffix(sub.Name(), "s") != plural {
+				continue
+			}
+			for _, alias := range resourceAliases[sub.Name()] {
+				if !used[alias] {
+					sub.Aliases = append(sub.Aliases, alias)
+					used[alias] = true
+				}
+			}
+		}
+	}
+
+	for _, sub := range cmd.Commands() {
+		addResourceAliases(sub)
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package rootCmd
+
+import (
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/litmuschaos/litmusctl/pkg/history"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// mutatingCommands are the top level commands, or subcommands, which change the ChaosCenter or the
+// clusters, they're recorded in the audit log
+var mutatingCommands = map[string]bool{
+	"create":             true,
+	"update":             true,
+	"delete":             true,
+	"connect":            true,
+	"disconnect":         true,
+	"upgrade":            true,
+	"accept-invitation":  true,
+	"decline-invitation": true,
+	"auth revoke":        true,
+}
+
+// sensitiveFlag matches the flags whose values are never written to the audit log
+func sensitiveFlag(name string) bool {
+	return strings.Contains(name, "token") || strings.Contains(name, "password") || strings.Contains(name, "passphrase") || name == "header"
+}
+
+// startAuditLog records the mutating command in the audit log before it runs. Failing to write the
+// log doesn't fail the command.
+func startAuditLog(cmd *cobra.Command, args []string) {
+	path := strings.Fields(cmd.CommandPath())
+	if len(path) < 2 || !(mutatingCommands[path[1]] || mutatingCommands[strings.Join(path[1:], " ")]) {
+		return
+	}
+
+	// The target is made of the arguments and the flags set on the command line
+	target := append([]string{}, args...)
+	var flags []string
+	cmd.Flags().Visit(func(flag *pflag.Flag) {
+		value := flag.Value.String()
+		if sensitiveFlag(flag.Name) {
+			value = "***"
+		}
+		flags = append(flags, "--"+flag.Name+"="+value)
+	})
+	sort.Strings(flags)
+	target = append(target, flags...)
+
+	account, username := utils.CurrentAccount(cmd)
+	err := history.Start(utils.RequestID, account, username, strings.Join(path[1:], " "), strings.Join(target, " "))
+	if err != nil && !utils.Quiet {
+		utils.Red.Fprintln(os.Stderr, "⚠️  Unable to write the audit log: "+err.Error())
+	}
+}
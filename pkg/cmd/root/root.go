@@ -16,19 +16,31 @@ limitations under the License.
 package rootCmd
 
 import (
-	"crypto/tls"
-	"crypto/x509"
 	"fmt"
-	"io/ioutil"
-	"net/http"
 	"os"
 
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/cmd/api"
+	"github.com/litmuschaos/litmusctl/pkg/cmd/auth"
+	"github.com/litmuschaos/litmusctl/pkg/cmd/check"
+	"github.com/litmuschaos/litmusctl/pkg/cmd/compat"
 	"github.com/litmuschaos/litmusctl/pkg/cmd/connect"
+	"github.com/litmuschaos/litmusctl/pkg/cmd/dashboard"
 	"github.com/litmuschaos/litmusctl/pkg/cmd/delete"
 	"github.com/litmuschaos/litmusctl/pkg/cmd/describe"
 	"github.com/litmuschaos/litmusctl/pkg/cmd/disconnect"
+	"github.com/litmuschaos/litmusctl/pkg/cmd/discover"
+	"github.com/litmuschaos/litmusctl/pkg/cmd/generate"
+	historyCmd "github.com/litmuschaos/litmusctl/pkg/cmd/history"
+	"github.com/litmuschaos/litmusctl/pkg/cmd/invitation"
+	"github.com/litmuschaos/litmusctl/pkg/cmd/portforward"
+	"github.com/litmuschaos/litmusctl/pkg/cmd/pull"
+	"github.com/litmuschaos/litmusctl/pkg/cmd/test"
+	"github.com/litmuschaos/litmusctl/pkg/cmd/update"
 	"github.com/litmuschaos/litmusctl/pkg/cmd/upgrade"
 	"github.com/litmuschaos/litmusctl/pkg/cmd/version"
+	"github.com/litmuschaos/litmusctl/pkg/history"
+	"github.com/litmuschaos/litmusctl/pkg/telemetry"
 	"github.com/litmuschaos/litmusctl/pkg/utils"
 
 	"github.com/litmuschaos/litmusctl/pkg/cmd/config"
@@ -37,11 +49,13 @@ import (
 	config2 "github.com/litmuschaos/litmusctl/pkg/config"
 	"github.com/spf13/cobra"
 
-	"github.com/mitchellh/go-homedir"
 	"github.com/spf13/viper"
 )
 
-var cfgFile string
+var (
+	cfgFile     string
+	noTelemetry bool
+)
 
 //var kubeconfig string
 
@@ -50,17 +64,56 @@ var rootCmd = &cobra.Command{
 	Use:   "litmusctl",
 	Short: "Litmusctl controls the litmuschaos agent plane",
 	Long:  `Litmusctl controls the litmuschaos agent plane. ` + "\n" + ` Find more information at: https://github.com/litmuschaos/litmusctl`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		if cmd.Name() != telemetry.FlushCommand {
+			telemetry.Start(cmd.CommandPath(), !noTelemetry && utils.TelemetryEnabled(cmd))
+		}
+		utils.PrintError(utils.ApplyAccountDefaults(cmd))
+		utils.PrintError(utils.LoadCustomHeaders(cmd))
+		utils.PrintError(utils.ConfigureTransport())
+		startAuditLog(cmd, args)
+	},
+	PersistentPostRun: func(cmd *cobra.Command, args []string) {
+		telemetry.Record(nil)
+		history.Record(nil)
+		utils.PrintProfile()
+	},
+}
+
+// telemetryFlushCmd sends the spooled telemetry events, it's started in the background by the other commands
+var telemetryFlushCmd = &cobra.Command{
+	Use:    telemetry.FlushCommand,
+	Hidden: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		_ = telemetry.Flush()
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	cobra.CheckErr(rootCmd.Execute())
+	err := rootCmd.Execute()
+	if err != nil {
+		telemetry.Record(err)
+	}
+	cobra.CheckErr(err)
 }
 
 func init() {
 	cobra.OnInitialize(initConfig)
 
+	// Expired tokens are renewed by logging in again
+	utils.Login = apis.Auth
+	utils.ExitHook = func(err error) {
+		telemetry.Record(err)
+		history.Record(err)
+		utils.PrintProfile()
+	}
+	utils.ServerVersion = func(endpoint string) (string, error) {
+		resp, err := apis.GetServerVersion(endpoint)
+		return resp.Data.GetServerVersion.Value, err
+	}
+
 	rootCmd.AddCommand(config.ConfigCmd)
 	rootCmd.AddCommand(create.CreateCmd)
 	rootCmd.AddCommand(get.GetCmd)
@@ -70,44 +123,65 @@ func init() {
 	rootCmd.AddCommand(describe.DescribeCmd)
 	rootCmd.AddCommand(version.VersionCmd)
 	rootCmd.AddCommand(upgrade.UpgradeCmd)
+	rootCmd.AddCommand(pull.PullCmd)
+	rootCmd.AddCommand(update.UpdateCmd)
+	rootCmd.AddCommand(test.TestCmd)
+	rootCmd.AddCommand(portforward.PortForwardCmd)
+	rootCmd.AddCommand(generate.GenerateCmd)
+	rootCmd.AddCommand(check.CheckCmd)
+	rootCmd.AddCommand(compat.CompatCmd)
+	rootCmd.AddCommand(discover.DiscoverCmd)
+	rootCmd.AddCommand(api.ApiCmd)
+	rootCmd.AddCommand(auth.AuthCmd)
+	rootCmd.AddCommand(historyCmd.HistoryCmd)
+	rootCmd.AddCommand(dashboard.DashboardCmd)
+	rootCmd.AddCommand(telemetryFlushCmd)
+	rootCmd.AddCommand(invitation.AcceptInvitationCmd)
+	rootCmd.AddCommand(invitation.DeclineInvitationCmd)
+	addResourceAliases(rootCmd)
 
 	// Here you will define your flags and configuration settings.
 	// Cobra supports persistent flags, which, if defined here,
 	// will be global for your application.
 
-	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.litmusctl)")
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $LITMUSCONFIG, $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig)")
+	rootCmd.PersistentFlags().StringVar(&cfgFile, "litmusconfig", "", "alias of --config")
 	//rootCmd.PersistentFlags().StringVar(&kubeconfig, "kubeconfig", "", "kubeconfig file (default is $HOME/.kube/config")
 	rootCmd.PersistentFlags().BoolVar(&config2.SkipSSLVerify, "skipSSL", false, "skipSSL, litmusctl will skip ssl/tls verification while communicating with portal")
+	rootCmd.PersistentFlags().BoolVar(&noTelemetry, "no-telemetry", false, "no-telemetry, litmusctl will not record the usage telemetry of this command, even when it's enabled with litmusctl config set telemetry=on")
+	rootCmd.PersistentFlags().BoolVar(&apis.NoCache, "no-cache", false, "no-cache, litmusctl will not use the cached project, environment and ChaosHub fault lists, which are cached for a minute")
+	rootCmd.PersistentFlags().Float64Var(&apis.RequestsPerSecond, "rate-limit", 0, "rate-limit, litmusctl will send at most this many requests per second to the ChaosCenter. Requests rate limited by the ChaosCenter are retried and slow down the following ones")
+	rootCmd.PersistentFlags().BoolVar(&utils.StrictCompat, "strict-compat", false, "strict-compat, litmusctl will fail instead of warning when the ChaosCenter version is not supported")
+	rootCmd.PersistentFlags().BoolVar(&utils.Profile, "profile", false, "profile, litmusctl will print how long each GraphQL call, download and Kubernetes operation of the command took")
+	rootCmd.PersistentFlags().BoolVarP(&utils.Quiet, "quiet", "q", false, "quiet, litmusctl will not print warnings, e.g. about deprecated ChaosCenter versions, and the create, connect and list commands will only print the IDs of their resources, one per line")
+	rootCmd.PersistentFlags().StringVar(&utils.EndpointOverride, "endpoint", "", "endpoint of the ChaosCenter, used with --token instead of the config file, which is then neither read nor written (default is $LITMUSCTL_ENDPOINT)")
+	rootCmd.PersistentFlags().StringVar(&utils.TokenOverride, "token", "", "token of the ChaosCenter, used with --endpoint instead of the config file (default is $LITMUSCTL_TOKEN)")
+	rootCmd.PersistentFlags().IntVar(&utils.MaxIdleConnsPerHost, "max-idle-conns", utils.DefaultMaxIdleConnsPerHost, "max-idle-conns, litmusctl will keep at most this many idle connections open to the ChaosCenter to reuse them across the requests of the command, 0 disables the keep-alives")
+	rootCmd.PersistentFlags().BoolVar(&utils.NoHTTP2, "no-http2", false, "no-http2, litmusctl will not use HTTP/2 with the ChaosCenter, e.g. for proxies which don't support it")
+	rootCmd.PersistentFlags().StringArrayVar(&utils.HeaderFlags, "header", nil, "header \"Name: value\", litmusctl will send the header with every request to the ChaosCenter, e.g. for an authenticating reverse proxy. Can be repeated, and takes precedence over the headers of the account set with litmusctl config set-headers")
 	rootCmd.PersistentFlags().StringVar(&config2.CACert, "cacert", "", "cacert <path_to_crt_file> , custom ca certificate used for communicating with portal")
 }
 
 // initConfig reads in config file and ENV variables if set.
 func initConfig() {
+	// Stateless invocations don't read the config file
+	if utils.Stateless() {
+		return
+	}
+
 	if cfgFile != "" {
 		// Use config file from the flag.
 		viper.SetConfigFile(cfgFile)
 	} else {
-		// Find home directory.
-		home, err := homedir.Dir()
+		// Use the config file of the environment, the XDG config directory or the home directory,
+		// the same one the commands read.
+		configFilePath, err := utils.DefaultLitmusConfigPath()
 		cobra.CheckErr(err)
-
-		// Search config in home directory with name ".litmusconfig" (without extension).
-		viper.AddConfigPath(home)
-		viper.SetConfigName(utils.DefaultFileName)
+		viper.SetConfigFile(configFilePath)
 	}
 
 	viper.AutomaticEnv() // read in environment variables that match
 
-	if config2.SkipSSLVerify {
-		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
-	} else if config2.CACert != "" {
-		caCert, err := ioutil.ReadFile(config2.CACert)
-		cobra.CheckErr(err)
-		caCertPool := x509.NewCertPool()
-		caCertPool.AppendCertsFromPEM(caCert)
-		http.DefaultTransport.(*http.Transport).TLSClientConfig = &tls.Config{RootCAs: caCertPool}
-	}
-
 	// If a config file is found, read it in.
 	if err := viper.ReadInConfig(); err == nil {
 		fmt.Fprintln(os.Stderr, "Using config file:", viper.ConfigFileUsed())
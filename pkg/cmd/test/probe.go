@@ -0,0 +1,95 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package test
+
+import (
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/k8s"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/probe"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// probeCmd represents the probe command
+var probeCmd = &cobra.Command{
+	Use: "probe",
+	Short: `Validate a Resilience Probe and execute it once out-of-band
+	HTTP and Prometheus probes are executed from this machine, K8s probes with the present/absent
+	operations are executed against the cluster of the given kubeconfig. CMD probes and mutating
+	K8s probes are only validated, since they have to run inside the target cluster.
+
+	Example:
+	#test a Resilience Probe
+	litmusctl test probe http-probe --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		kubeconfig, err := cmd.Flags().GetString("kubeconfig")
+		utils.PrintError(err)
+		utils.PrintError(k8s.LoadKubeconfig(&kubeconfig))
+		utils.PrintError(k8s.Impersonate(cmd))
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		probes, err := apis.ListProbes(projectID, []string{args[0]}, credentials)
+		utils.PrintError(err)
+
+		if len(probes.Data.Probes) == 0 {
+			utils.Red.Println("⛔ No Resilience Probe found with name: ", args[0])
+			os.Exit(1)
+		}
+
+		utils.White_B.Println("\n🏃 Testing Resilience Probe/" + args[0] + "...")
+		result, err := probe.Test(probes.Data.Probes[0], &kubeconfig)
+		if err != nil {
+			utils.Red.Println("\n❌ Resilience Probe is misconfigured: " + err.Error())
+			os.Exit(1)
+		}
+
+		switch {
+		case result.Passed && result.Executed:
+			utils.White_B.Println("\n✅ Resilience Probe passed: " + result.Description)
+		case result.Passed:
+			utils.White_B.Println("\n✅ Resilience Probe is valid: " + result.Description)
+		default:
+			utils.Red.Println("\n❌ Resilience Probe failed: " + result.Description)
+			os.Exit(1)
+		}
+	},
+}
+
+func init() {
+	TestCmd.AddCommand(probeCmd)
+
+	probeCmd.Flags().String("project-id", "", "Set the project-id of the Resilience Probe. To see the projects, apply litmusctl get projects")
+	probeCmd.Flags().StringP("kubeconfig", "k", "", "Set to pass kubeconfig file if it is not in the default location ($HOME/.kube/config). The in-cluster config is used when running inside a pod without a kubeconfig. Pass - to read it from stdin, or set the base64 encoded kubeconfig in KUBECONFIG_DATA")
+	k8s.AddImpersonationFlags(probeCmd)
+}
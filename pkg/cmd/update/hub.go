@@ -0,0 +1,105 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package update
+
+import (
+	"os"
+
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/hub"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// hubCmd represents the chaos-hub command
+var hubCmd = &cobra.Command{
+	Use: "chaos-hub <hub-name>",
+	Short: `Rotate the credentials of a ChaosHub
+	Example:
+	#rotate the personal access token of a private ChaosHub
+	litmusctl update chaos-hub "my-hub" --auth-type=token --token-file=./token --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+	#switch a private ChaosHub to a new SSH key
+	litmusctl update chaos-hub "my-hub" --auth-type=ssh --ssh-private-key-file=./id_rsa --project-id="d861b650-1549-4574-b2ba-ab754058dd04"
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		hubName := args[0]
+
+		hubs, err := apis.ListHubStatus(projectID, credentials)
+		utils.PrintError(err)
+
+		var existingHub *model.ChaosHubStatus
+		for i := range hubs.Data.HubStatus {
+			if hubs.Data.HubStatus[i].HubName == hubName {
+				existingHub = &hubs.Data.HubStatus[i]
+			}
+		}
+
+		if existingHub == nil {
+			utils.Red.Println("⛔ ChaosHub/" + hubName + " not found in the project")
+			os.Exit(1)
+		}
+
+		hubCredentials, err := hub.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		// Only the credentials are rotated, the rest of the configuration is kept as is
+		chaosHub, err := apis.UpdateChaosHub(model.UpdateChaosHubRequest{
+			ID:            existingHub.ID,
+			HubName:       existingHub.HubName,
+			RepoURL:       existingHub.RepoURL,
+			RepoBranch:    existingHub.RepoBranch,
+			ProjectID:     projectID,
+			IsPrivate:     hubCredentials.IsPrivate,
+			AuthType:      hubCredentials.AuthType,
+			Token:         hubCredentials.Token,
+			UserName:      hubCredentials.UserName,
+			Password:      hubCredentials.Password,
+			SSHPrivateKey: hubCredentials.SSHPrivateKey,
+			SSHPublicKey:  hubCredentials.SSHPublicKey,
+		}, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Error in updating ChaosHub: " + err.Error())
+			os.Exit(1)
+		}
+
+		utils.White_B.Println("\n🚀 Credentials of ChaosHub/" + chaosHub.Data.UpdateChaosHub.HubName + " successfully updated.")
+	},
+}
+
+func init() {
+	UpdateCmd.AddCommand(hubCmd)
+
+	hubCmd.Flags().String("project-id", "", "Set the project-id of the ChaosHub. To see the projects, apply litmusctl get projects")
+	hub.AddCredentialFlags(hubCmd)
+}
@@ -0,0 +1,65 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package update
+
+import (
+	"os"
+	"strings"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// projectCmd represents the project command
+var projectCmd = &cobra.Command{
+	Use: "project <project-id>",
+	Short: `Rename a project
+	Example:
+	#rename a project
+	litmusctl update project 50addd40-8767-448c-a91a-5071543a2d8e --name="DevOps Project"
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectName, err := cmd.Flags().GetString("name")
+		utils.PrintError(err)
+
+		if strings.TrimSpace(projectName) == "" {
+			projectName, err = utils.PromptInput("Enter the new project name", "", utils.NotEmpty("Project name"))
+			utils.PrintError(err)
+		}
+
+		_, err = apis.UpdateProjectName(args[0], strings.TrimSpace(projectName), credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Error in renaming project: " + err.Error())
+			os.Exit(1)
+		}
+
+		utils.White_B.Println("\n🚀 Project successfully renamed to " + strings.TrimSpace(projectName) + ".")
+	},
+}
+
+func init() {
+	UpdateCmd.AddCommand(projectCmd)
+
+	projectCmd.Flags().String("name", "", "Set the new name of the project")
+}
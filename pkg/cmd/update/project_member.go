@@ -0,0 +1,93 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package update
+
+import (
+	"os"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// projectMemberCmd represents the project-member command
+var projectMemberCmd = &cobra.Command{
+	Use: "project-member",
+	Short: `Change the role of a member of a project
+	Example:
+	#downgrade a member of a project to viewer
+	litmusctl update project-member --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --user="john" --role=viewer
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		username, err := cmd.Flags().GetString("user")
+		utils.PrintError(err)
+
+		if username == "" {
+			utils.Red.Println("⛔ --user flag is empty")
+			os.Exit(1)
+		}
+
+		roleFlag, err := cmd.Flags().GetString("role")
+		utils.PrintError(err)
+
+		role, err := utils.GetMemberRole(roleFlag)
+		utils.PrintError(err)
+
+		member, err := apis.GetProjectMember(projectID, username, credentials)
+		utils.PrintError(err)
+
+		if member.Role == "Owner" {
+			utils.Red.Println("⛔ The role of the owner of the project can't be changed")
+			os.Exit(1)
+		}
+
+		if member.Role == role {
+			utils.White_B.Println("\n" + username + " is already " + role + " of the project.")
+			return
+		}
+
+		_, err = apis.UpdateMemberRole(projectID, member.UserID, role, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Error in updating the role of " + username + ": " + err.Error())
+			os.Exit(1)
+		}
+
+		utils.White_B.Println("\n🚀 Role of " + username + " successfully changed from " + member.Role + " to " + role + ".")
+	},
+}
+
+func init() {
+	UpdateCmd.AddCommand(projectMemberCmd)
+
+	projectMemberCmd.Flags().String("project-id", "", "Set the project-id of the member. To see the projects, apply litmusctl get projects")
+	projectMemberCmd.Flags().String("user", "", "Set the username of the member")
+	projectMemberCmd.Flags().String("role", "", "Set the new role of the member. One of:\neditor|viewer")
+}
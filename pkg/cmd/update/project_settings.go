@@ -0,0 +1,135 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package update
+
+import (
+	"os"
+
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+
+	"github.com/spf13/cobra"
+)
+
+// projectSettingsCmd represents the project-settings command
+var projectSettingsCmd = &cobra.Command{
+	Use: "project-settings",
+	Short: `Update the image registry settings of a project
+	Example:
+	#use a private image registry for the Chaos Scenarios of a project
+	litmusctl update project-settings --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --image-registry="ghcr.io" --image-repo="my-org" --image-registry-type=private --secret-name="regcred" --secret-namespace="litmus"
+
+	#switch back to the default LitmusChaos image registry
+	litmusctl update project-settings --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --use-default-registry
+
+	Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+	Run: func(cmd *cobra.Command, args []string) {
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		projectID, err := cmd.Flags().GetString("project-id")
+		utils.PrintError(err)
+
+		if projectID == "" {
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
+		}
+
+		useDefault, err := cmd.Flags().GetBool("use-default-registry")
+		utils.PrintError(err)
+
+		var imageRegistry model.ImageRegistryInput
+		if useDefault {
+			imageRegistry = model.ImageRegistryInput{
+				IsDefault:         true,
+				ImageRegistryName: utils.DefaultImageRegistry,
+				ImageRepoName:     utils.DefaultImageRepo,
+				ImageRegistryType: "public",
+			}
+		} else {
+			imageRegistry.ImageRegistryName, err = cmd.Flags().GetString("image-registry")
+			utils.PrintError(err)
+
+			imageRegistry.ImageRepoName, err = cmd.Flags().GetString("image-repo")
+			utils.PrintError(err)
+
+			imageRegistry.ImageRegistryType, err = cmd.Flags().GetString("image-registry-type")
+			utils.PrintError(err)
+
+			if imageRegistry.ImageRegistryName == "" || imageRegistry.ImageRepoName == "" {
+				utils.Red.Println("⛔ --image-registry and --image-repo flags are required, or use --use-default-registry")
+				os.Exit(1)
+			}
+
+			if imageRegistry.ImageRegistryType != "public" && imageRegistry.ImageRegistryType != "private" {
+				utils.Red.Println("⛔ Invalid --image-registry-type " + imageRegistry.ImageRegistryType + ", supported types are public/private")
+				os.Exit(1)
+			}
+
+			secretName, err := cmd.Flags().GetString("secret-name")
+			utils.PrintError(err)
+
+			secretNamespace, err := cmd.Flags().GetString("secret-namespace")
+			utils.PrintError(err)
+
+			if imageRegistry.ImageRegistryType == "private" {
+				if secretName == "" || secretNamespace == "" {
+					utils.Red.Println("⛔ --secret-name and --secret-namespace flags are required for a private image registry")
+					os.Exit(1)
+				}
+				imageRegistry.SecretName, imageRegistry.SecretNamespace = &secretName, &secretNamespace
+			}
+		}
+
+		enableRegistry := true
+		imageRegistry.EnableRegistry = &enableRegistry
+
+		// Update the image registry of the project if one is configured already
+		settings, err := apis.GetProjectSettings(projectID, credentials)
+		utils.PrintError(err)
+
+		var imageRegistryID string
+		for _, registry := range settings.Data.ImageRegistries {
+			if registry.IsRemoved == nil || !*registry.IsRemoved {
+				imageRegistryID = registry.ImageRegistryID
+				break
+			}
+		}
+
+		_, err = apis.SaveImageRegistry(projectID, imageRegistryID, imageRegistry, credentials)
+		if err != nil {
+			utils.Red.Println("\n❌ Error in updating project settings: " + err.Error())
+			os.Exit(1)
+		}
+
+		utils.White_B.Println("\n🚀 Image registry of the project successfully set to " + imageRegistry.ImageRegistryName + "/" + imageRegistry.ImageRepoName + ".")
+	},
+}
+
+func init() {
+	UpdateCmd.AddCommand(projectSettingsCmd)
+
+	projectSettingsCmd.Flags().String("project-id", "", "Set the project-id to update the settings of the particular project. To see the projects, apply litmusctl get projects")
+	projectSettingsCmd.Flags().String("image-registry", "", "Set the image registry used by the Chaos Scenarios of the project. For example: docker.io")
+	projectSettingsCmd.Flags().String("image-repo", "", "Set the image repository in the image registry. For example: litmuschaos")
+	projectSettingsCmd.Flags().String("image-registry-type", "public", "Set the type of the image registry. One of:\npublic|private")
+	projectSettingsCmd.Flags().String("secret-name", "", "Set the name of the image pull secret for a private image registry")
+	projectSettingsCmd.Flags().String("secret-namespace", "", "Set the namespace of the image pull secret for a private image registry")
+	projectSettingsCmd.Flags().Bool("use-default-registry", false, "Set to true to switch back to the default LitmusChaos image registry")
+}
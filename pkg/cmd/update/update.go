@@ -0,0 +1,41 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package update
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// UpdateCmd represents the update command
+var UpdateCmd = &cobra.Command{
+	Use: "update",
+	Short: `Update resources for LitmusChaos agent plane.
+		Examples:
+		#rotate the credentials of a private ChaosHub
+		litmusctl update chaos-hub "my-hub" --auth-type=token --token-file=./token --project-id=""
+
+		#rename a project
+		litmusctl update project c520650e-7cb6-474c-b0f0-4df07b2b025b --name="DevOps Project"
+
+		#change the role of a member of a project
+		litmusctl update project-member --project-id="" --user="john" --role=viewer
+
+		#use a custom image registry for the Chaos Scenarios of a project
+		litmusctl update project-settings --project-id="" --image-registry="ghcr.io" --image-repo="my-org"
+
+		Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
+	`,
+}
@@ -16,43 +16,85 @@ limitations under the License.
 package upgrade
 
 import (
-	"context"
-	"fmt"
 	"os"
 
 	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/k8s"
+	"github.com/litmuschaos/litmusctl/pkg/picker"
 	"github.com/litmuschaos/litmusctl/pkg/utils"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 )
 
 // createCmd represents the create command
 var agentCmd = &cobra.Command{
-	Use:   "chaos-delegate",
-	Short: `Upgrades the LitmusChaos agent plane.`,
+	Use: "chaos-delegate",
+	Short: `Upgrades the LitmusChaos agent plane.
+	Example:
+	#upgrade a Chaos Delegate
+	litmusctl upgrade chaos-delegate --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --chaos-delegate-id="1c9c5801-8789-4ac9-bf5f-32649b707a5c"
+
+	#review the changes of the upgrade side-by-side, without upgrading
+	litmusctl upgrade chaos-delegate --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --chaos-delegate-id="1c9c5801-8789-4ac9-bf5f-32649b707a5c" --diff --diff-style=side-by-side
+	`,
 	Run: func(cmd *cobra.Command, args []string) {
 		credentials, err := utils.GetCredentials(cmd)
 		utils.PrintError(err)
 
+		// The kubeconfig is read first, as it may be passed on stdin
+		kubeconfig, err := cmd.Flags().GetString("kubeconfig")
+		utils.PrintError(err)
+		utils.PrintError(k8s.LoadKubeconfig(&kubeconfig))
+		utils.PrintError(k8s.Impersonate(cmd))
+
 		projectID, err := cmd.Flags().GetString("project-id")
 		utils.PrintError(err)
 
 		if projectID == "" {
-			utils.White_B.Print("\nEnter the project ID: ")
-			fmt.Scanln(&projectID)
+			projectID, err = picker.ProjectID(credentials)
+			utils.PrintError(err)
 		}
 
 		cluster_id, err := cmd.Flags().GetString("chaos-delegate-id")
 		utils.PrintError(err)
 
 		if cluster_id == "" {
-			utils.White_B.Print("\nEnter the Chaos Delegate ID: ")
-			fmt.Scanln(&cluster_id)
+			cluster_id, err = picker.AgentID(projectID, credentials)
+			utils.PrintError(err)
 		}
 
-		kubeconfig, err := cmd.Flags().GetString("kubeconfig")
+		diffStyle, err := cmd.Flags().GetString("diff-style")
 		utils.PrintError(err)
 
-		output, err := apis.UpgradeAgent(context.Background(), credentials, projectID, cluster_id, kubeconfig)
+		if diffStyle != utils.UnifiedDiff && diffStyle != utils.SideBySideDiff {
+			utils.Red.Println("⛔ Invalid --diff-style " + diffStyle + ", supported styles are " + utils.UnifiedDiff + "/" + utils.SideBySideDiff)
+			os.Exit(1)
+		}
+
+		ctx, cancel := utils.CommandContext(cmd)
+		defer cancel()
+
+		if diff, _ := cmd.Flags().GetBool("diff"); diff {
+			changes, err := apis.DiffAgentUpgrade(ctx, credentials, projectID, cluster_id, kubeconfig)
+			if err != nil {
+				utils.Red.Print("\n❌ Failed comparing the Chaos Delegate with its upgrade: \n" + err.Error() + "\n")
+				os.Exit(1)
+			}
+			if changes == "" {
+				utils.White_B.Println("\n👍 The Chaos Delegate is up to date, the upgrade changes nothing.")
+				return
+			}
+
+			width, _, err := term.GetSize(int(os.Stdout.Fd()))
+			if err != nil {
+				width = 160
+			}
+			utils.PrintError(utils.RenderDiff(os.Stdout, changes, diffStyle, width))
+			utils.White_B.Println("\n👉 To apply the upgrade, run the command again without --diff")
+			return
+		}
+
+		output, err := apis.UpgradeAgent(ctx, credentials, projectID, cluster_id, kubeconfig)
 		if err != nil {
 			utils.Red.Print("\n❌ Failed upgrading Chaos Delegate: \n" + err.Error() + "\n")
 			os.Exit(1)
@@ -64,6 +106,10 @@ var agentCmd = &cobra.Command{
 func init() {
 	UpgradeCmd.AddCommand(agentCmd)
 	agentCmd.Flags().String("project-id", "", "Enter the project ID")
-	agentCmd.Flags().String("kubeconfig", "", "Enter the kubeconfig path(default: $HOME/.kube/config))")
+	agentCmd.Flags().String("kubeconfig", "", "Enter the kubeconfig path(default: $HOME/.kube/config)). Pass - to read it from stdin, or set the base64 encoded kubeconfig in KUBECONFIG_DATA")
+	k8s.AddImpersonationFlags(agentCmd)
 	agentCmd.Flags().String("chaos-delegate-id", "", "Enter the Chaos Delegate ID")
+	agentCmd.Flags().Duration("timeout", 0, "Set the maximum time for the upgrade, e.g. 5m. No limit by default")
+	agentCmd.Flags().Bool("diff", false, "Show the changes the upgrade makes to the resources of the Chaos Delegate, without upgrading it")
+	agentCmd.Flags().String("diff-style", utils.UnifiedDiff, "Set the style of the --diff | Supported=unified/side-by-side")
 }
@@ -26,6 +26,6 @@ var UpgradeCmd = &cobra.Command{
 		#upgrade version of your Chaos Delegate
 		litmusctl upgrade chaos-delegate --chaos-delegate-id="4cc25543-36c8-4373-897b-2e5dbbe87bcf" --project-id="d861b650-1549-4574-b2ba-ab754058dd04" --non-interactive
 
-		Note: The default location of the config file is $HOME/.litmusconfig, and can be overridden by a --config flag
+		Note: The default location of the config file is $XDG_CONFIG_HOME/litmusctl/config or $HOME/.litmusconfig, and can be overridden by the LITMUSCONFIG environment variable or a --config flag
 	`,
 }
@@ -18,24 +18,94 @@ package version
 import (
 	"os"
 
+	"github.com/litmuschaos/litmusctl/pkg/apis"
 	"github.com/litmuschaos/litmusctl/pkg/utils"
 
 	"github.com/spf13/cobra"
 )
 
+// compatibilityCheck is the result of checking the connected ChaosCenter against the compatibility matrix
+type compatibilityCheck struct {
+	CLIVersion               string             `json:"cliVersion"`
+	ServerVersion            string             `json:"serverVersion"`
+	Endpoint                 string             `json:"endpoint"`
+	Compatible               bool               `json:"compatible"`
+	CompatibleServerVersions string             `json:"compatibleServerVersions"`
+	CompatibleCLIVersions    []string           `json:"compatibleCLIVersions"`
+	Guidance                 string             `json:"guidance,omitempty"`
+	Deprecation              *utils.Deprecation `json:"deprecation,omitempty"`
+}
+
 // versionCmd represents the version command
 var VersionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Displays the version of litmusctl",
-	Long:  ``,
+	Long: `Displays the version of litmusctl and the compatible ChaosCenter versions.
+With --check, the version of the ChaosCenter of the current account is checked against them.`,
 	Run: func(cmd *cobra.Command, args []string) {
-		compatibilityArr := utils.CompatibilityMatrix[os.Getenv("CLIVersion")]
-		utils.White_B.Println("Litmusctl version: ", os.Getenv("CLIVersion"))
-		utils.White_B.Println("Compatible ChaosCenter versions: ")
-		utils.White_B.Print("[ ")
-		for _, v := range compatibilityArr {
-			utils.White_B.Print("'" + v + "' ")
+		cliVersion := os.Getenv("CLIVersion")
+		compatibleVersions := utils.CompatibleVersions(cliVersion)
+
+		check, err := cmd.Flags().GetBool("check")
+		utils.PrintError(err)
+
+		if !check {
+			utils.White_B.Println("Litmusctl version: ", cliVersion)
+			utils.White_B.Println("Compatible ChaosCenter versions: ", compatibleVersions)
+			return
+		}
+
+		credentials, err := utils.GetCredentials(cmd)
+		utils.PrintError(err)
+
+		serverResp, err := apis.GetServerVersion(credentials.Endpoint)
+		utils.PrintError(err)
+
+		result := compatibilityCheck{
+			CLIVersion:               cliVersion,
+			ServerVersion:            serverResp.Data.GetServerVersion.Value,
+			Endpoint:                 credentials.Endpoint,
+			CompatibleServerVersions: compatibleVersions,
+			CompatibleCLIVersions:    utils.CompatibleCLIVersions(serverResp.Data.GetServerVersion.Value),
+			Compatible:               utils.IsCompatible(cliVersion, serverResp.Data.GetServerVersion.Value),
+		}
+		if !result.Compatible {
+			if len(result.CompatibleCLIVersions) > 0 {
+				result.Guidance = "Install litmusctl " + result.CompatibleCLIVersions[len(result.CompatibleCLIVersions)-1] + " to work with ChaosCenter " + result.ServerVersion
+			} else if compatibleVersions != "" {
+				result.Guidance = "Upgrade the ChaosCenter to " + compatibleVersions + " to work with litmusctl " + cliVersion
+			} else {
+				result.Guidance = "No compatibility data is known for litmusctl " + cliVersion + ", install the latest litmusctl release"
+			}
+		}
+
+		if deprecation, ok := utils.ServerDeprecation(result.ServerVersion); ok {
+			result.Deprecation = &deprecation
+		}
+
+		output, err := cmd.Flags().GetString("output")
+		utils.PrintError(err)
+
+		switch output {
+		case "json":
+			utils.PrintInJsonFormat(result)
+
+		case "":
+			utils.White_B.Println("Litmusctl version: ", result.CLIVersion)
+			utils.White_B.Println("ChaosCenter version: ", result.ServerVersion, "("+result.Endpoint+")")
+			utils.PrintDeprecationWarning(result.ServerVersion)
+			if result.Compatible {
+				utils.White_B.Println("\n✅  Installed versions of ChaosCenter and LitmusCTL are compatible! ")
+			} else {
+				utils.Red.Println("\n🚫 ChaosCenter version: " + result.ServerVersion + " is not compatible with the installed LitmusCTL version: " + result.CLIVersion)
+				utils.White_B.Println("👉 " + result.Guidance)
+				os.Exit(1)
+			}
 		}
-		utils.White_B.Print("]\n")
 	},
 }
+
+func init() {
+	VersionCmd.Flags().Bool("check", false, "Set to check the version of the ChaosCenter of the current account against the compatibility matrix")
+	VersionCmd.Flags().StringP("output", "o", "", "Output format of --check. One of:\njson")
+}
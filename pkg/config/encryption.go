@@ -0,0 +1,175 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"golang.org/x/crypto/scrypt"
+)
+
+// EncryptedTokenPrefix marks the tokens which are encrypted at rest, the version
+// allows changing the key derivation without breaking the existing config files
+const EncryptedTokenPrefix = "enc:v1:"
+
+const (
+	saltSize = 16
+	keySize  = 32
+)
+
+// IsEncrypted returns whether the token is encrypted at rest
+func IsEncrypted(token string) bool {
+	return strings.HasPrefix(token, EncryptedTokenPrefix)
+}
+
+// HasEncryptedTokens returns whether any token of the config is encrypted at rest
+func HasEncryptedTokens(obj types.LitmuCtlConfig) bool {
+	for _, account := range obj.Accounts {
+		for _, user := range account.Users {
+			if IsEncrypted(user.Token) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// EncryptToken encrypts the token with AES-GCM, using a key derived from the passphrase with scrypt
+func EncryptToken(token string, passphrase string) (string, error) {
+	if passphrase == "" {
+		return "", errors.New("passphrase cannot be empty")
+	}
+
+	salt := make([]byte, saltSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return "", err
+	}
+
+	gcm, err := newGCM(passphrase, salt)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	data := append(salt, nonce...)
+	data = gcm.Seal(data, nonce, []byte(token), nil)
+	return EncryptedTokenPrefix + base64.StdEncoding.EncodeToString(data), nil
+}
+
+// DecryptToken decrypts a token encrypted with EncryptToken, tokens which are not
+// encrypted are returned as they are
+func DecryptToken(token string, passphrase string) (string, error) {
+	if !IsEncrypted(token) {
+		return token, nil
+	}
+
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(token, EncryptedTokenPrefix))
+	if err != nil || len(data) < saltSize {
+		return "", errors.New("invalid encrypted token in the config file")
+	}
+
+	gcm, err := newGCM(passphrase, data[:saltSize])
+	if err != nil {
+		return "", err
+	}
+
+	data = data[saltSize:]
+	if len(data) < gcm.NonceSize() {
+		return "", errors.New("invalid encrypted token in the config file")
+	}
+
+	plaintext, err := gcm.Open(nil, data[:gcm.NonceSize()], data[gcm.NonceSize():], nil)
+	if err != nil {
+		return "", errors.New("unable to decrypt the token, the passphrase may be wrong")
+	}
+	return string(plaintext), nil
+}
+
+// EncryptFile encrypts all the tokens of the config file which are not encrypted yet,
+// and returns the number of encrypted tokens
+func EncryptFile(filename string, passphrase string) (int, error) {
+	return transformTokens(filename, func(token string) (string, bool, error) {
+		if IsEncrypted(token) || token == "" {
+			return token, false, nil
+		}
+		encrypted, err := EncryptToken(token, passphrase)
+		return encrypted, true, err
+	})
+}
+
+// DecryptFile stores all the tokens of the config file in plain text again,
+// and returns the number of decrypted tokens
+func DecryptFile(filename string, passphrase string) (int, error) {
+	return transformTokens(filename, func(token string) (string, bool, error) {
+		if !IsEncrypted(token) {
+			return token, false, nil
+		}
+		decrypted, err := DecryptToken(token, passphrase)
+		return decrypted, true, err
+	})
+}
+
+// transformTokens applies the transformation to every token of the config file. The
+// file is only written when all the tokens are transformed successfully.
+func transformTokens(filename string, transform func(token string) (string, bool, error)) (int, error) {
+	obj, err := YamltoObject(filename)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	for i, account := range obj.Accounts {
+		for j, user := range account.Users {
+			token, changed, err := transform(user.Token)
+			if err != nil {
+				return 0, errors.New("account " + user.Username + "@" + account.Endpoint + ": " + err.Error())
+			}
+			if changed {
+				obj.Accounts[i].Users[j].Token = token
+				count++
+			}
+		}
+	}
+
+	if count == 0 {
+		return 0, nil
+	}
+	return count, writeObjToFile(obj, filename)
+}
+
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, 1<<15, 8, 1, keySize)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,69 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"errors"
+
+	"github.com/litmuschaos/litmusctl/pkg/types"
+)
+
+const (
+	// APIVersion is the current schema version of the config file
+	APIVersion = "v1"
+	// Kind is the kind of the config file
+	Kind = "Config"
+)
+
+// migration upgrades the config file from one schema version to the next one
+type migration struct {
+	from    string
+	to      string
+	migrate func(obj *types.LitmuCtlConfig)
+}
+
+// migrations are applied in order, a new schema version only needs to add its
+// migration from the previous one here
+var migrations = []migration{
+	{
+		// Config files written before the schema was versioned
+		from: "",
+		to:   "v1",
+		migrate: func(obj *types.LitmuCtlConfig) {
+			obj.Kind = Kind
+		},
+	},
+}
+
+// Migrate upgrades the config to the current schema version, and returns whether it was changed
+func Migrate(obj *types.LitmuCtlConfig) (bool, error) {
+	if obj.APIVersion == APIVersion {
+		return false, nil
+	}
+
+	from := obj.APIVersion
+	for _, m := range migrations {
+		if obj.APIVersion == m.from {
+			m.migrate(obj)
+			obj.APIVersion = m.to
+		}
+	}
+
+	if obj.APIVersion != APIVersion {
+		return false, errors.New("unsupported config file version " + from + ", it may have been written by a newer litmusctl. Please upgrade litmusctl")
+	}
+	return true, nil
+}
@@ -0,0 +1,147 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mitchellh/go-homedir"
+)
+
+// NetrcEnv overrides the path of the netrc file, like it does for curl and git
+const NetrcEnv = "NETRC"
+
+// NetrcEntry holds the credentials of a machine in the netrc file. The password may
+// also be a ChaosCenter token.
+type NetrcEntry struct {
+	Machine  string
+	Login    string
+	Password string
+}
+
+// NetrcPath returns the path of the netrc file, $NETRC or ~/.netrc
+func NetrcPath() (string, error) {
+	if path := os.Getenv(NetrcEnv); path != "" {
+		return path, nil
+	}
+
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".netrc"), nil
+}
+
+// NetrcCredentials returns the netrc entry of the endpoint's host, matching "host:port"
+// before "host" and falling back to the default entry. A missing netrc file isn't an error.
+func NetrcCredentials(endpoint string) (NetrcEntry, bool, error) {
+	path, err := NetrcPath()
+	if err != nil {
+		return NetrcEntry{}, false, err
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return NetrcEntry{}, false, nil
+	}
+	if err != nil {
+		return NetrcEntry{}, false, err
+	}
+
+	entries, err := parseNetrc(data)
+	if err != nil {
+		return NetrcEntry{}, false, errors.New("invalid netrc file " + path + ": " + err.Error())
+	}
+
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return NetrcEntry{}, false, err
+	}
+
+	for _, machine := range []string{u.Host, u.Hostname(), ""} {
+		for _, entry := range entries {
+			if entry.Machine == machine {
+				return entry, true, nil
+			}
+		}
+	}
+	return NetrcEntry{}, false, nil
+}
+
+// parseNetrc parses the machine, default, login and password tokens of a netrc file,
+// the entry of the default token has an empty machine. Macro definitions are skipped.
+func parseNetrc(data []byte) ([]NetrcEntry, error) {
+	var tokens []string
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	inMacro := false
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if inMacro {
+			// A macro definition ends with an empty line
+			inMacro = len(fields) > 0
+			continue
+		}
+		for i, field := range fields {
+			if strings.HasPrefix(field, "#") {
+				break
+			}
+			if field == "macdef" {
+				inMacro = true
+				break
+			}
+			tokens = append(tokens, fields[i])
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	var entries []NetrcEntry
+	for i := 0; i < len(tokens); i++ {
+		switch token := tokens[i]; token {
+		case "default":
+			entries = append(entries, NetrcEntry{})
+
+		case "machine", "login", "password", "account":
+			if i+1 >= len(tokens) {
+				return nil, errors.New("missing value of " + token)
+			}
+			i++
+			value := tokens[i]
+
+			if token == "machine" {
+				entries = append(entries, NetrcEntry{Machine: value})
+				continue
+			}
+			if len(entries) == 0 {
+				return nil, errors.New(token + " outside of a machine entry")
+			}
+			if token == "login" {
+				entries[len(entries)-1].Login = value
+			} else if token == "password" {
+				entries[len(entries)-1].Password = value
+			}
+		}
+	}
+	return entries, nil
+}
@@ -19,6 +19,8 @@ import (
 	"errors"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/litmuschaos/litmusctl/pkg/types"
 	"gopkg.in/yaml.v2"
@@ -36,6 +38,11 @@ func CreateNewLitmusCtlConfig(filename string, config types.LitmuCtlConfig) erro
 		return err
 	}
 
+	// The XDG config directory may not exist yet
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return err
+	}
+
 	_, err = os.OpenFile(filename, os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
 		return err
@@ -79,9 +86,37 @@ func YamltoObject(filename string) (types.LitmuCtlConfig, error) {
 		return types.LitmuCtlConfig{}, errors.New("File format not correct " + err.Error())
 	}
 
+	// Older config files are migrated in memory, and persisted on the next write
+	if _, err := Migrate(obj); err != nil {
+		return types.LitmuCtlConfig{}, err
+	}
+
 	return *obj, nil
 }
 
+// MigrateFile upgrades the config file to the current schema version, and returns
+// the version it was upgraded from and whether it was changed
+func MigrateFile(filename string) (string, bool, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return "", false, errors.New("File reading error " + err.Error())
+	}
+
+	obj := &types.LitmuCtlConfig{}
+	err = yaml.Unmarshal(data, obj)
+	if err != nil {
+		return "", false, errors.New("File format not correct " + err.Error())
+	}
+
+	from := obj.APIVersion
+	migrated, err := Migrate(obj)
+	if err != nil || !migrated {
+		return from, false, err
+	}
+
+	return from, true, writeObjToFile(*obj, filename)
+}
+
 func ConfigSyntaxCheck(filename string) error {
 
 	obj, err := YamltoObject(filename)
@@ -89,7 +124,7 @@ func ConfigSyntaxCheck(filename string) error {
 		return err
 	}
 
-	if obj.APIVersion != "v1" || obj.Kind != "Config" {
+	if obj.APIVersion != APIVersion || obj.Kind != Kind {
 		return errors.New("File format not correct")
 	}
 
@@ -154,6 +189,117 @@ func UpdateCurrent(current types.Current, filename string) error {
 	return nil
 }
 
+// SetDefaultProject stores the default project of the given user, which is used
+// by the commands when the --project-id flag is not passed
+func SetDefaultProject(current types.Current, projectID string, filename string) error {
+	obj, err := YamltoObject(filename)
+	if err != nil {
+		return err
+	}
+
+	var found = false
+	for i, account := range obj.Accounts {
+		if account.Endpoint == current.CurrentAccount {
+			for j, user := range account.Users {
+				if user.Username == current.CurrentUser {
+					obj.Accounts[i].Users[j].DefaultProject = projectID
+					found = true
+				}
+			}
+		}
+	}
+
+	if !found {
+		return errors.New("account " + current.CurrentUser + "@" + current.CurrentAccount + " not found in the config file")
+	}
+
+	return writeObjToFile(obj, filename)
+}
+
+// SetAccountDefaults sets and unsets the default flags of the account
+func SetAccountDefaults(endpoint string, defaults map[string]string, unset []string, filename string) error {
+	obj, err := YamltoObject(filename)
+	if err != nil {
+		return err
+	}
+
+	var found = false
+	for i, account := range obj.Accounts {
+		if account.Endpoint == endpoint {
+			if obj.Accounts[i].Defaults == nil {
+				obj.Accounts[i].Defaults = make(map[string]string)
+			}
+			for name, value := range defaults {
+				obj.Accounts[i].Defaults[name] = value
+			}
+			for _, name := range unset {
+				delete(obj.Accounts[i].Defaults, name)
+			}
+			found = true
+		}
+	}
+
+	if !found {
+		return errors.New("account " + endpoint + " not found in the config file")
+	}
+
+	return writeObjToFile(obj, filename)
+}
+
+// SetAccountHeaders sets and unsets the headers sent with the requests to the ChaosCenter of the account
+func SetAccountHeaders(endpoint string, headers map[string]string, unset []string, filename string) error {
+	obj, err := YamltoObject(filename)
+	if err != nil {
+		return err
+	}
+
+	var found = false
+	for i, account := range obj.Accounts {
+		if account.Endpoint == endpoint {
+			if obj.Accounts[i].Headers == nil {
+				obj.Accounts[i].Headers = make(map[string]string)
+			}
+			for _, name := range unset {
+				for header := range obj.Accounts[i].Headers {
+					if strings.EqualFold(header, name) {
+						delete(obj.Accounts[i].Headers, header)
+					}
+				}
+			}
+			for name, value := range headers {
+				obj.Accounts[i].Headers[name] = value
+			}
+			found = true
+		}
+	}
+
+	if !found {
+		return errors.New("account " + endpoint + " not found in the config file")
+	}
+
+	return writeObjToFile(obj, filename)
+}
+
+// SetPreference sets the preference, unknown preferences and values are rejected
+func SetPreference(name string, value string, filename string) error {
+	obj, err := YamltoObject(filename)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "telemetry":
+		if value != "on" && value != "off" {
+			return errors.New("invalid value " + value + " of telemetry, expected on or off")
+		}
+		obj.Preferences.Telemetry = value
+	default:
+		return errors.New("unknown preference " + name + ", supported preferences are telemetry")
+	}
+
+	return writeObjToFile(obj, filename)
+}
+
 func writeObjToFile(obj types.LitmuCtlConfig, filename string) error {
 	_, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY, 0644)
 	if err != nil {
@@ -186,3 +332,81 @@ func IsAccountExists(obj types.LitmuCtlConfig, username string, endpoint string)
 
 	return false
 }
+
+// Redact removes the tokens and the custom headers of the config, which may hold secrets of a
+// reverse proxy, so that it can be shared with the endpoint and default project presets only
+func Redact(obj types.LitmuCtlConfig) types.LitmuCtlConfig {
+	redacted := obj
+	redacted.Accounts = make([]types.Account, len(obj.Accounts))
+	for i, account := range obj.Accounts {
+		redacted.Accounts[i] = account
+		redacted.Accounts[i].Headers = nil
+		redacted.Accounts[i].Users = make([]types.User, len(account.Users))
+		for j, user := range account.Users {
+			user.Token = ""
+			user.ExpiresIn = "0"
+			redacted.Accounts[i].Users[j] = user
+		}
+	}
+	return redacted
+}
+
+// ImportConfig merges the accounts of the imported config into the config file. Users which
+// already exist keep their token, and only get the default project when they have none.
+// It returns the number of added users.
+func ImportConfig(imported types.LitmuCtlConfig, filename string) (int, error) {
+	obj := types.LitmuCtlConfig{APIVersion: APIVersion, Kind: Kind}
+	if FileExists(filename) {
+		length, err := GetFileLength(filename)
+		if err != nil {
+			return 0, err
+		}
+		if length > 0 {
+			if obj, err = YamltoObject(filename); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var added int
+	for _, account := range imported.Accounts {
+		accountIndex := -1
+		for i, act := range obj.Accounts {
+			if act.Endpoint == account.Endpoint {
+				accountIndex = i
+			}
+		}
+		if accountIndex == -1 {
+			obj.Accounts = append(obj.Accounts, types.Account{Endpoint: account.Endpoint})
+			accountIndex = len(obj.Accounts) - 1
+		}
+
+		for _, user := range account.Users {
+			var exists bool
+			for j, u := range obj.Accounts[accountIndex].Users {
+				if u.Username == user.Username {
+					exists = true
+					if u.DefaultProject == "" {
+						obj.Accounts[accountIndex].Users[j].DefaultProject = user.DefaultProject
+					}
+				}
+			}
+			if !exists {
+				if user.ExpiresIn == "" {
+					user.ExpiresIn = "0"
+				}
+				obj.Accounts[accountIndex].Users = append(obj.Accounts[accountIndex].Users, user)
+				added++
+			}
+		}
+	}
+
+	if obj.CurrentAccount == "" && obj.CurrentUser == "" {
+		obj.CurrentAccount, obj.CurrentUser = imported.CurrentAccount, imported.CurrentUser
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0700); err != nil {
+		return 0, err
+	}
+	return added, writeObjToFile(obj, filename)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/litmuschaos/litmusctl/pkg/types"
+)
+
+// TokenRenewalWindow is how long before its expiry a token is renewed
+const TokenRenewalWindow = 5 * time.Minute
+
+// TokenExpiry returns the expiry of the user's token, decoded from the JWT exp claim
+// and falling back to the stored expires_in for encrypted or opaque tokens
+func TokenExpiry(user types.User) (time.Time, error) {
+	if !IsEncrypted(user.Token) {
+		if expiry, err := JWTExpiry(user.Token); err == nil {
+			return expiry, nil
+		}
+	}
+
+	expiresIn, err := strconv.ParseInt(user.ExpiresIn, 10, 64)
+	if err != nil {
+		return time.Time{}, errors.New("invalid expires_in of " + user.Username + ": " + err.Error())
+	}
+	return time.Unix(expiresIn, 0), nil
+}
+
+// JWTExpiry decodes the exp claim of the token, without verifying its signature
+func JWTExpiry(token string) (time.Time, error) {
+	return jwtTime(token, "exp")
+}
+
+// JWTIssuedAt decodes the iat claim of the token, without verifying its signature
+func JWTIssuedAt(token string) (time.Time, error) {
+	return jwtTime(token, "iat")
+}
+
+// JWTClaims decodes the claims of the token, without verifying its signature
+func JWTClaims(token string) (jwt.MapClaims, error) {
+	parsed, _ := jwt.Parse(token, nil)
+	if parsed == nil {
+		return nil, errors.New("the token is not a JWT")
+	}
+
+	claims, ok := parsed.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("the token has no claims")
+	}
+	return claims, nil
+}
+
+func jwtTime(token string, claim string) (time.Time, error) {
+	claims, err := JWTClaims(token)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	value, ok := claims[claim].(float64)
+	if !ok {
+		return time.Time{}, errors.New("the token has no " + claim + " claim")
+	}
+	return time.Unix(int64(value), 0), nil
+}
+
+// HumanizeExpiry describes the time to the expiry, e.g. "expires in 2h0m0s" or "expired 5m0s ago"
+func HumanizeExpiry(expiry time.Time) string {
+	remaining := time.Until(expiry).Round(time.Second)
+	if remaining <= 0 {
+		return "expired " + (-remaining).String() + " ago"
+	}
+	return "expires in " + remaining.String()
+}
@@ -0,0 +1,170 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"regexp"
+	"strconv"
+
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	yamlv3 "gopkg.in/yaml.v3"
+)
+
+// ValidationError is a problem of the config file, at the given line when it's known
+type ValidationError struct {
+	Line    int    `json:"line,omitempty"`
+	Message string `json:"message"`
+}
+
+func (e ValidationError) Error() string {
+	if e.Line == 0 {
+		return e.Message
+	}
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// yamlLineError matches the line number of the yaml errors, e.g. "yaml: line 3: mapping values are not allowed"
+var yamlLineError = regexp.MustCompile(`^(?:yaml: )?line (\d+): (.*)$`)
+
+// Validate checks the config file for syntax errors, unknown fields, duplicate accounts and
+// users, invalid values and a current account which doesn't exist. An error is returned when
+// the file can't be read.
+func Validate(filename string) ([]ValidationError, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, errors.New("File reading error " + err.Error())
+	}
+
+	var root yamlv3.Node
+	if err := yamlv3.Unmarshal(data, &root); err != nil {
+		return []ValidationError{toValidationError(err.Error())}, nil
+	}
+	if len(root.Content) == 0 {
+		return []ValidationError{{Message: "the config file is empty"}}, nil
+	}
+
+	var validationErrors []ValidationError
+
+	// Unknown fields and invalid types
+	decoder := yamlv3.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	var obj types.LitmuCtlConfig
+	if err := decoder.Decode(&obj); err != nil {
+		var typeErr *yamlv3.TypeError
+		if errors.As(err, &typeErr) {
+			for _, message := range typeErr.Errors {
+				validationErrors = append(validationErrors, toValidationError(message))
+			}
+		} else {
+			validationErrors = append(validationErrors, toValidationError(err.Error()))
+		}
+	}
+
+	doc := root.Content[0]
+	if doc.Kind != yamlv3.MappingNode {
+		return append(validationErrors, ValidationError{Line: doc.Line, Message: "the config file should be a mapping"}), nil
+	}
+
+	if node := mappingValue(doc, "apiVersion"); node == nil {
+		validationErrors = append(validationErrors, ValidationError{Message: "apiVersion is missing, run litmusctl config migrate"})
+	} else if node.Value != APIVersion {
+		validationErrors = append(validationErrors, ValidationError{Line: node.Line, Message: "unsupported apiVersion " + node.Value + ", expected " + APIVersion})
+	}
+	if node := mappingValue(doc, "kind"); node == nil {
+		validationErrors = append(validationErrors, ValidationError{Message: "kind is missing, run litmusctl config migrate"})
+	} else if node.Value != Kind {
+		validationErrors = append(validationErrors, ValidationError{Line: node.Line, Message: "unsupported kind " + node.Value + ", expected " + Kind})
+	}
+
+	// Duplicate accounts and users, and invalid values
+	users := make(map[string]bool)
+	if accounts := mappingValue(doc, "accounts"); accounts != nil && accounts.Kind == yamlv3.SequenceNode {
+		endpoints := make(map[string]int)
+		for _, account := range accounts.Content {
+			endpoint := mappingValue(account, "endpoint")
+			if endpoint == nil {
+				validationErrors = append(validationErrors, ValidationError{Line: account.Line, Message: "the account has no endpoint"})
+				continue
+			}
+			if line, ok := endpoints[endpoint.Value]; ok {
+				validationErrors = append(validationErrors, ValidationError{Line: endpoint.Line, Message: fmt.Sprintf("duplicate account %s, already defined at line %d", endpoint.Value, line)})
+			}
+			endpoints[endpoint.Value] = endpoint.Line
+
+			if u, err := url.Parse(endpoint.Value); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				validationErrors = append(validationErrors, ValidationError{Line: endpoint.Line, Message: "invalid endpoint " + endpoint.Value + ", it should be an http(s) URL"})
+			}
+
+			accountUsers := mappingValue(account, "users")
+			if accountUsers == nil || accountUsers.Kind != yamlv3.SequenceNode {
+				continue
+			}
+			usernames := make(map[string]int)
+			for _, user := range accountUsers.Content {
+				username := mappingValue(user, "username")
+				if username == nil || username.Value == "" {
+					validationErrors = append(validationErrors, ValidationError{Line: user.Line, Message: "the user has no username"})
+					continue
+				}
+				if line, ok := usernames[username.Value]; ok {
+					validationErrors = append(validationErrors, ValidationError{Line: username.Line, Message: fmt.Sprintf("duplicate user %s of account %s, already defined at line %d", username.Value, endpoint.Value, line)})
+				}
+				usernames[username.Value] = username.Line
+				users[endpoint.Value+"/"+username.Value] = true
+
+				if expiresIn := mappingValue(user, "expires_in"); expiresIn != nil {
+					if _, err := strconv.ParseInt(expiresIn.Value, 10, 64); err != nil {
+						validationErrors = append(validationErrors, ValidationError{Line: expiresIn.Line, Message: "invalid expires_in " + expiresIn.Value + ", it should be a unix timestamp"})
+					}
+				}
+			}
+		}
+	}
+
+	// The current account should be one of the accounts
+	currentAccount, currentUser := mappingValue(doc, "current-account"), mappingValue(doc, "current-user")
+	if currentAccount != nil && currentUser != nil && currentAccount.Value != "" && !users[currentAccount.Value+"/"+currentUser.Value] {
+		validationErrors = append(validationErrors, ValidationError{Line: currentUser.Line, Message: "the current user " + currentUser.Value + "@" + currentAccount.Value + " is not one of the accounts"})
+	}
+
+	return validationErrors, nil
+}
+
+// mappingValue returns the value node of the key in the mapping node
+func mappingValue(node *yamlv3.Node, key string) *yamlv3.Node {
+	if node.Kind != yamlv3.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+func toValidationError(message string) ValidationError {
+	if match := yamlLineError.FindStringSubmatch(message); match != nil {
+		line, _ := strconv.Atoi(match[1])
+		return ValidationError{Line: line, Message: match[2]}
+	}
+	return ValidationError{Message: message}
+}
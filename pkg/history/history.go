@@ -0,0 +1,196 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// PathEnv overrides the path of the audit log, e.g. to keep it on a shared volume
+const PathEnv = "LITMUSCTL_AUDIT_LOG"
+
+const (
+	// Started is recorded before a mutating command runs
+	Started = "started"
+
+	// Succeeded and Failed are recorded once the command is done
+	Succeeded = "succeeded"
+	Failed    = "failed"
+
+	// Unknown is the result of the commands which exited without recording their result
+	Unknown = "unknown"
+)
+
+// Entry is a line of the audit log. Every mutating command appends a started entry before it runs,
+// and a succeeded or failed entry once it's done, both sharing the request ID of the invocation.
+type Entry struct {
+	ID        string    `json:"id"`
+	Time      time.Time `json:"time"`
+	Event     string    `json:"event"`
+	Account   string    `json:"account,omitempty"`
+	Username  string    `json:"username,omitempty"`
+	Operation string    `json:"operation,omitempty"`
+	Target    string    `json:"target,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Operation is a mutating command, merged from its entries
+type Operation struct {
+	ID        string    `json:"id" yaml:"id"`
+	Time      time.Time `json:"time" yaml:"time"`
+	Account   string    `json:"account" yaml:"account"`
+	Username  string    `json:"username" yaml:"username"`
+	Operation string    `json:"operation" yaml:"operation"`
+	Target    string    `json:"target" yaml:"target"`
+	Result    string    `json:"result" yaml:"result"`
+	Error     string    `json:"error,omitempty" yaml:"error,omitempty"`
+	Duration  string    `json:"duration,omitempty" yaml:"duration,omitempty"`
+}
+
+var state struct {
+	sync.Mutex
+	started  *Entry
+	recorded bool
+}
+
+// Path returns the path of the audit log
+func Path() (string, error) {
+	if path := os.Getenv(PathEnv); path != "" {
+		return path, nil
+	}
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(configDir, "litmusctl", "audit.jsonl"), nil
+}
+
+// Start records that the mutating operation is about to run
+func Start(id string, account string, username string, operation string, target string) error {
+	state.Lock()
+	defer state.Unlock()
+
+	entry := Entry{
+		ID:        id,
+		Time:      time.Now().UTC(),
+		Event:     Started,
+		Account:   account,
+		Username:  username,
+		Operation: operation,
+		Target:    target,
+	}
+	if err := appendEntry(entry); err != nil {
+		return err
+	}
+	state.started = &entry
+	return nil
+}
+
+// Record records the result of the operation started with Start, once
+func Record(err error) {
+	state.Lock()
+	defer state.Unlock()
+
+	if state.started == nil || state.recorded {
+		return
+	}
+	state.recorded = true
+
+	entry := Entry{ID: state.started.ID, Time: time.Now().UTC(), Event: Succeeded}
+	if err != nil {
+		entry.Event, entry.Error = Failed, err.Error()
+	}
+	// The started entry is already written, a lost result shows up as unknown
+	_ = appendEntry(entry)
+}
+
+// appendEntry appends the entry to the audit log, which is only ever appended to
+func appendEntry(entry Entry) error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// Read returns the operations of the audit log, from the oldest to the latest. Lines which can't
+// be parsed are skipped, so that a truncated write doesn't hide the rest of the log.
+func Read() ([]Operation, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var operations []Operation
+	index := make(map[string]int)
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var entry Entry
+		if json.Unmarshal(scanner.Bytes(), &entry) != nil {
+			continue
+		}
+
+		if entry.Event == Started {
+			index[entry.ID] = len(operations)
+			operations = append(operations, Operation{
+				ID:        entry.ID,
+				Time:      entry.Time,
+				Account:   entry.Account,
+				Username:  entry.Username,
+				Operation: entry.Operation,
+				Target:    entry.Target,
+				Result:    Unknown,
+			})
+			continue
+		}
+		if i, ok := index[entry.ID]; ok {
+			operations[i].Result = entry.Event
+			operations[i].Error = entry.Error
+			operations[i].Duration = entry.Time.Sub(operations[i].Time).Round(time.Millisecond).String()
+		}
+	}
+	return operations, scanner.Err()
+}
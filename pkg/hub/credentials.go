@@ -0,0 +1,153 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package hub
+
+import (
+	"errors"
+	"io/ioutil"
+	"strings"
+
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+// Credentials holds the authentication details of a private ChaosHub
+type Credentials struct {
+	IsPrivate     bool
+	AuthType      model.AuthType
+	Token         *string
+	UserName      *string
+	Password      *string
+	SSHPrivateKey *string
+	SSHPublicKey  *string
+}
+
+// AddCredentialFlags registers the flags used to supply the credentials of a private ChaosHub
+func AddCredentialFlags(cmd *cobra.Command) {
+	cmd.Flags().String("auth-type", "none", "Set the authentication type of a private ChaosHub | Supported=none/basic/token/ssh")
+	cmd.Flags().String("hub-token", "", "Set the personal access token for a private ChaosHub. Prefer --token-file to keep it out of the shell history")
+	cmd.Flags().String("token-file", "", "Set the path of a file containing the personal access token for a private ChaosHub")
+	cmd.Flags().String("username", "", "Set the git username for a private ChaosHub with basic authentication")
+	cmd.Flags().String("password-file", "", "Set the path of a file containing the git password for a private ChaosHub with basic authentication")
+	cmd.Flags().String("ssh-private-key-file", "", "Set the path of the SSH private key for a private ChaosHub")
+	cmd.Flags().String("ssh-public-key-file", "", "Set the path of the SSH public key for a private ChaosHub")
+}
+
+// GetCredentials reads the credentials of a private ChaosHub from the flags.
+// Secrets which are not passed via flags or files are prompted for without echo.
+func GetCredentials(cmd *cobra.Command) (Credentials, error) {
+	authType, err := cmd.Flags().GetString("auth-type")
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	var creds = Credentials{AuthType: model.AuthType(strings.ToUpper(authType))}
+	if !creds.AuthType.IsValid() {
+		return Credentials{}, errors.New("invalid --auth-type " + authType + ", supported types are none/basic/token/ssh")
+	}
+
+	switch creds.AuthType {
+	case model.AuthTypeToken:
+		token, err := readSecret(cmd, "hub-token", "token-file", "Personal access token")
+		if err != nil {
+			return Credentials{}, err
+		}
+		creds.Token = &token
+
+	case model.AuthTypeBasic:
+		username, err := cmd.Flags().GetString("username")
+		if err != nil {
+			return Credentials{}, err
+		}
+		if username == "" {
+			return Credentials{}, errors.New("--username flag is empty")
+		}
+		password, err := readSecret(cmd, "", "password-file", "Git password")
+		if err != nil {
+			return Credentials{}, err
+		}
+		creds.UserName, creds.Password = &username, &password
+
+	case model.AuthTypeSSH:
+		privateKey, err := readFileFlag(cmd, "ssh-private-key-file")
+		if err != nil {
+			return Credentials{}, err
+		}
+		if privateKey == "" {
+			return Credentials{}, errors.New("--ssh-private-key-file flag is empty")
+		}
+		creds.SSHPrivateKey = &privateKey
+
+		publicKey, err := readFileFlag(cmd, "ssh-public-key-file")
+		if err != nil {
+			return Credentials{}, err
+		}
+		if publicKey != "" {
+			creds.SSHPublicKey = &publicKey
+		}
+	}
+
+	creds.IsPrivate = creds.AuthType != model.AuthTypeNone
+	return creds, nil
+}
+
+// readSecret returns the secret passed via the value flag or the file flag,
+// falling back to a password prompt when neither of them is set
+func readSecret(cmd *cobra.Command, valueFlag string, fileFlag string, prompt string) (string, error) {
+	if valueFlag != "" {
+		value, err := cmd.Flags().GetString(valueFlag)
+		if err != nil {
+			return "", err
+		}
+		if value != "" {
+			return value, nil
+		}
+	}
+
+	value, err := readFileFlag(cmd, fileFlag)
+	if err != nil || value != "" {
+		return strings.TrimSpace(value), err
+	}
+
+	utils.White_B.Print("\n" + prompt + ": ")
+	secret, err := term.ReadPassword(0)
+	utils.White_B.Println()
+	if err != nil {
+		return "", err
+	}
+	if len(secret) == 0 {
+		return "", errors.New(prompt + " cannot be empty")
+	}
+
+	return string(secret), nil
+}
+
+// readFileFlag returns the content of the file passed via the given flag
+func readFileFlag(cmd *cobra.Command, fileFlag string) (string, error) {
+	path, err := cmd.Flags().GetString(fileFlag)
+	if err != nil || path == "" {
+		return "", err
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
@@ -16,41 +16,244 @@ limitations under the License.
 package k8s
 
 import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 
-	"github.com/litmuschaos/litmusctl/pkg/utils"
-
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"k8s.io/client-go/util/homedir"
 )
 
-// Returns a new kubernetes client set
-func ClientSet(kubeconfig *string) (*kubernetes.Clientset, error) {
-	if *kubeconfig == "" {
-		if home := homedir.HomeDir(); home != "" {
-			kcfg := filepath.Join(home, ".kube", "config")
-			kubeconfig = &kcfg
-		} else {
-			utils.Red.Println("ERROR: Clientset generation failed!")
-			os.Exit(1)
+// clientFactory resolves the kubeconfig and builds the clients only once per
+// kubeconfig path, so that all the operations of a command share them
+var clientFactory = struct {
+	sync.Mutex
+	configs    map[string]*rest.Config
+	clientsets map[string]*kubernetes.Clientset
+}{
+	configs:    map[string]*rest.Config{},
+	clientsets: map[string]*kubernetes.Clientset{},
+}
+
+const (
+	// StdinKubeconfig is the kubeconfig value to read the kubeconfig from stdin
+	StdinKubeconfig = "-"
+	// KubeconfigDataEnv is the environment variable holding a base64 encoded kubeconfig,
+	// which is used when no kubeconfig is passed
+	KubeconfigDataEnv = "KUBECONFIG_DATA"
+)
+
+// impersonation is applied to all the clients and kubectl invocations of a command
+var impersonation rest.ImpersonationConfig
+
+// AddImpersonationFlags registers the flags used to impersonate a user for the Kubernetes operations
+func AddImpersonationFlags(cmd *cobra.Command) {
+	cmd.Flags().String("as", "", "Set the user to impersonate for the Kubernetes operations, e.g. to verify the permissions of a less-privileged user")
+	cmd.Flags().StringSlice("as-group", nil, "Set the group to impersonate for the Kubernetes operations, can be repeated. Requires --as")
+}
+
+// Impersonate reads the impersonation flags. It must be called before the clients are built.
+func Impersonate(cmd *cobra.Command) error {
+	user, err := cmd.Flags().GetString("as")
+	if err != nil {
+		return err
+	}
+	groups, err := cmd.Flags().GetStringSlice("as-group")
+	if err != nil {
+		return err
+	}
+	if user == "" && len(groups) > 0 {
+		return errors.New("--as-group requires --as to be set")
+	}
+
+	impersonation = rest.ImpersonationConfig{UserName: user, Groups: groups}
+	return nil
+}
+
+// impersonationArgs returns the kubectl arguments to impersonate the same user as the clients
+func impersonationArgs() []string {
+	var args []string
+	if impersonation.UserName != "" {
+		args = append(args, "--as", impersonation.UserName)
+	}
+	for _, group := range impersonation.Groups {
+		args = append(args, "--as-group", group)
+	}
+	return args
+}
+
+// stdinKubeconfig holds the kubeconfig read from stdin, as stdin can only be read once
+var stdinKubeconfig struct {
+	once sync.Once
+	data []byte
+	err  error
+}
+
+// LoadKubeconfig reads the kubeconfig from stdin or KUBECONFIG_DATA, if it's passed
+// that way, so that the commands fail early on an invalid kubeconfig and stdin is
+// consumed before any prompt reads from it
+func LoadKubeconfig(kubeconfig *string) error {
+	_, _, err := kubeconfigData(kubeconfig)
+	return err
+}
+
+// kubeconfigData returns the content of the kubeconfig when it isn't passed as a file
+func kubeconfigData(kubeconfig *string) ([]byte, bool, error) {
+	if kubeconfig != nil && *kubeconfig == StdinKubeconfig {
+		stdinKubeconfig.once.Do(func() {
+			stdinKubeconfig.data, stdinKubeconfig.err = ioutil.ReadAll(os.Stdin)
+			if stdinKubeconfig.err == nil && len(stdinKubeconfig.data) == 0 {
+				stdinKubeconfig.err = errors.New("no kubeconfig was passed on stdin")
+			}
+		})
+		return stdinKubeconfig.data, true, stdinKubeconfig.err
+	}
+
+	if kubeconfig == nil || *kubeconfig == "" {
+		if encoded := os.Getenv(KubeconfigDataEnv); encoded != "" {
+			data, err := base64.StdEncoding.DecodeString(encoded)
+			if err != nil {
+				return nil, true, fmt.Errorf("invalid %s, it should be a base64 encoded kubeconfig: %w", KubeconfigDataEnv, err)
+			}
+			return data, true, nil
 		}
 	}
 
-	// create the config
-	config, err := clientcmd.BuildConfigFromFlags("", *kubeconfig)
+	return nil, false, nil
+}
+
+// KubeconfigFile returns the path of a kubeconfig file for tools like kubectl. A kubeconfig
+// read from stdin or KUBECONFIG_DATA is written to a private temporary file, which is
+// removed by the returned cleanup function.
+func KubeconfigFile(kubeconfig *string) (string, func(), error) {
+	data, ok, err := kubeconfigData(kubeconfig)
+	if err != nil || !ok {
+		if kubeconfig == nil {
+			return "", func() {}, err
+		}
+		return *kubeconfig, func() {}, err
+	}
+
+	// ioutil.TempFile creates the file with 0600 permissions
+	file, err := ioutil.TempFile("", "kubeconfig-*")
 	if err != nil {
-		utils.Red.Println("ERROR: ", err.Error())
-		os.Exit(1)
+		return "", func() {}, err
+	}
+	cleanup := func() { os.Remove(file.Name()) }
+
+	_, err = file.Write(data)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		cleanup()
+		return "", func() {}, err
+	}
+
+	return file.Name(), cleanup, nil
+}
+
+// kubeconfigPath returns the given kubeconfig path, defaulting to $HOME/.kube/config.
+// It's also the key of the clients in the clientFactory.
+func kubeconfigPath(kubeconfig *string) string {
+	if kubeconfig != nil && *kubeconfig != "" {
+		return *kubeconfig
+	}
+	if os.Getenv(KubeconfigDataEnv) != "" {
+		return "$" + KubeconfigDataEnv
+	}
+	if home := homedir.HomeDir(); home != "" {
+		return filepath.Join(home, ".kube", "config")
+	}
+	return ""
+}
+
+// RestConfig returns the rest config for the given kubeconfig
+func RestConfig(kubeconfig *string) (*rest.Config, error) {
+	path := kubeconfigPath(kubeconfig)
+
+	clientFactory.Lock()
+	defer clientFactory.Unlock()
+
+	if config, ok := clientFactory.configs[path]; ok {
+		return config, nil
+	}
+
+	config, err := buildConfig(kubeconfig, path)
+	if err != nil {
+		return nil, err
+	}
+	config.Impersonate = impersonation
+	clientFactory.configs[path] = config
+
+	return config, nil
+}
+
+// buildConfig builds the rest config from the kubeconfig passed on stdin or via
+// KUBECONFIG_DATA, or else from the kubeconfig file. When no kubeconfig
+// is passed and the default one doesn't exist, e.g. when litmusctl runs inside a
+// pod as a CI runner or a Job, the in-cluster config is used instead.
+func buildConfig(kubeconfig *string, path string) (*rest.Config, error) {
+	data, ok, err := kubeconfigData(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return clientcmd.RESTConfigFromKubeConfig(data)
+	}
+
+	if kubeconfig == nil || *kubeconfig == "" {
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			if config, err := rest.InClusterConfig(); err == nil {
+				return config, nil
+			}
+		}
+	}
+
+	return clientcmd.BuildConfigFromFlags("", path)
+}
+
+// Returns a new kubernetes client set
+func ClientSet(kubeconfig *string) (*kubernetes.Clientset, error) {
+	config, err := RestConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	path := kubeconfigPath(kubeconfig)
+
+	clientFactory.Lock()
+	defer clientFactory.Unlock()
+
+	if clientset, ok := clientFactory.clientsets[path]; ok {
+		return clientset, nil
 	}
 
 	// create the clientset
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
-		utils.Red.Println("ERROR: ", err.Error())
-		os.Exit(1)
+		return nil, err
 	}
-	return clientset, err
+	clientFactory.clientsets[path] = clientset
+
+	return clientset, nil
+}
+
+// Returns a new dynamic kubernetes client
+func DynamicClientSet(kubeconfig *string) (dynamic.Interface, error) {
+	config, err := RestConfig(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return dynamic.NewForConfig(config)
 }
@@ -0,0 +1,120 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package k8s
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// ClusterCapabilities are the version and the API groups served by a cluster
+type ClusterCapabilities struct {
+	Version   version.Info
+	APIGroups map[string]bool
+}
+
+// GetClusterCapabilities queries the discovery endpoints of the cluster for its
+// version and the API groups it serves
+func GetClusterCapabilities(ctx context.Context, kubeconfig *string) (ClusterCapabilities, error) {
+	defer utils.ProfileSpan("kubernetes", "discover cluster capabilities")()
+	clientset, err := ClientSet(kubeconfig)
+	if err != nil {
+		return ClusterCapabilities{}, err
+	}
+	client := clientset.Discovery().RESTClient()
+
+	ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+
+	body, err := client.Get().AbsPath("/version").Do(ctx).Raw()
+	if err != nil {
+		return ClusterCapabilities{}, err
+	}
+	var capabilities ClusterCapabilities
+	if err := json.Unmarshal(body, &capabilities.Version); err != nil {
+		return ClusterCapabilities{}, err
+	}
+
+	var groups metav1.APIGroupList
+	if err := client.Get().AbsPath("/apis").Do(ctx).Into(&groups); err != nil {
+		return ClusterCapabilities{}, err
+	}
+	capabilities.APIGroups = make(map[string]bool, len(groups.Groups))
+	for _, group := range groups.Groups {
+		capabilities.APIGroups[group.Name] = true
+	}
+
+	return capabilities, nil
+}
+
+// LitmusInstallation describes the Litmus resources already present in a cluster
+type LitmusInstallation struct {
+	CRDs      []string
+	Operators []string
+}
+
+// Exists returns true when any Litmus resource was found
+func (l LitmusInstallation) Exists() bool {
+	return len(l.CRDs) > 0 || len(l.Operators) > 0
+}
+
+// FindLitmusInstallation looks for the litmuschaos.io CRDs and the chaos-operator
+// deployments of an existing Litmus installation
+func FindLitmusInstallation(ctx context.Context, kubeconfig *string) (LitmusInstallation, error) {
+	defer utils.ProfileSpan("kubernetes", "find litmus installation")()
+	clientset, err := ClientSet(kubeconfig)
+	if err != nil {
+		return LitmusInstallation{}, err
+	}
+	dynamicClient, err := DynamicClientSet(kubeconfig)
+	if err != nil {
+		return LitmusInstallation{}, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+
+	var installation LitmusInstallation
+	crds, err := dynamicClient.Resource(schema.GroupVersionResource{
+		Group:    "apiextensions.k8s.io",
+		Version:  "v1",
+		Resource: "customresourcedefinitions",
+	}).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return LitmusInstallation{}, err
+	}
+	for _, crd := range crds.Items {
+		if strings.HasSuffix(crd.GetName(), ".litmuschaos.io") {
+			installation.CRDs = append(installation.CRDs, crd.GetName())
+		}
+	}
+
+	deployments, err := clientset.AppsV1().Deployments(metav1.NamespaceAll).List(ctx, metav1.ListOptions{LabelSelector: utils.ChaosOperatorLabel})
+	if err != nil {
+		return LitmusInstallation{}, err
+	}
+	for _, deployment := range deployments.Items {
+		installation.Operators = append(installation.Operators, deployment.Namespace+"/"+deployment.Name)
+	}
+
+	return installation, nil
+}
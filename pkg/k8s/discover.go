@@ -0,0 +1,169 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ChaosCenterURL is a candidate URL of the ChaosCenter frontend found in a cluster
+type ChaosCenterURL struct {
+	URL       string `json:"url"`
+	Source    string `json:"source"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	// InCluster is set for the URLs only reachable from inside the cluster
+	InCluster bool `json:"inCluster,omitempty"`
+}
+
+// DiscoverChaosCenter looks for the ChaosCenter frontend services in the given namespace, or in
+// all the namespaces if no namespace is given, and returns the URLs they are exposed at: the
+// ingresses routing to them first, then their load balancers, node ports and cluster IPs.
+func DiscoverChaosCenter(ctx context.Context, namespace string, kubeconfig *string) ([]ChaosCenterURL, error) {
+	defer utils.ProfileSpan("kubernetes", "discover ChaosCenter")()
+	clientset, err := ClientSet(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+
+	services, err := clientset.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{LabelSelector: utils.ChaosCenterFrontendLabel})
+	if err != nil {
+		return nil, err
+	}
+	if len(services.Items) == 0 {
+		return nil, errors.New("no ChaosCenter frontend service found with the label " + utils.ChaosCenterFrontendLabel)
+	}
+
+	var ingressURLs, loadBalancerURLs, nodePortURLs, clusterURLs []ChaosCenterURL
+	for _, service := range services.Items {
+		if len(service.Spec.Ports) == 0 {
+			continue
+		}
+		port := service.Spec.Ports[0]
+		candidate := func(source string, host string, port int32) ChaosCenterURL {
+			return ChaosCenterURL{
+				URL:       "http://" + net.JoinHostPort(host, strconv.Itoa(int(port))),
+				Source:    source,
+				Namespace: service.Namespace,
+				Name:      service.Name,
+			}
+		}
+
+		// The ingresses routing to the service
+		ingresses, err := clientset.NetworkingV1().Ingresses(service.Namespace).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, err
+		}
+		for _, ingress := range ingresses.Items {
+			tlsHosts := make(map[string]bool)
+			for _, tls := range ingress.Spec.TLS {
+				for _, host := range tls.Hosts {
+					tlsHosts[host] = true
+				}
+			}
+			for _, rule := range ingress.Spec.Rules {
+				if rule.HTTP == nil {
+					continue
+				}
+				host := rule.Host
+				if host == "" && len(ingress.Status.LoadBalancer.Ingress) > 0 {
+					host = ingress.Status.LoadBalancer.Ingress[0].IP
+					if host == "" {
+						host = ingress.Status.LoadBalancer.Ingress[0].Hostname
+					}
+				}
+				if host == "" {
+					continue
+				}
+				for _, path := range rule.HTTP.Paths {
+					if path.Backend.Service == nil || path.Backend.Service.Name != service.Name {
+						continue
+					}
+					scheme := "http://"
+					if tlsHosts[rule.Host] {
+						scheme = "https://"
+					}
+					ingressPath := path.Path
+					if ingressPath == "/" {
+						ingressPath = ""
+					}
+					ingressURLs = append(ingressURLs, ChaosCenterURL{
+						URL:       scheme + host + ingressPath,
+						Source:    "Ingress " + ingress.Name,
+						Namespace: service.Namespace,
+						Name:      service.Name,
+					})
+				}
+			}
+		}
+
+		switch service.Spec.Type {
+		case v1.ServiceTypeLoadBalancer:
+			for _, ingress := range service.Status.LoadBalancer.Ingress {
+				host := ingress.IP
+				if host == "" {
+					host = ingress.Hostname
+				}
+				loadBalancerURLs = append(loadBalancerURLs, candidate("LoadBalancer", host, port.Port))
+			}
+			fallthrough
+
+		case v1.ServiceTypeNodePort:
+			nodes, err := clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, err
+			}
+			for _, node := range nodes.Items {
+				if address := nodeAddress(node); address != "" && port.NodePort != 0 {
+					nodePortURLs = append(nodePortURLs, candidate("NodePort", address, port.NodePort))
+				}
+			}
+		}
+
+		clusterURL := candidate("ClusterIP", fmt.Sprintf("%s.%s.svc.cluster.local", service.Name, service.Namespace), port.Port)
+		clusterURL.InCluster = true
+		clusterURLs = append(clusterURLs, clusterURL)
+	}
+
+	urls := append(ingressURLs, loadBalancerURLs...)
+	urls = append(urls, nodePortURLs...)
+	return append(urls, clusterURLs...), nil
+}
+
+// nodeAddress returns the external address of the node, falling back to its internal one
+func nodeAddress(node v1.Node) string {
+	var internal string
+	for _, address := range node.Status.Addresses {
+		switch address.Type {
+		case v1.NodeExternalIP:
+			return address.Address
+		case v1.NodeInternalIP:
+			internal = address.Address
+		}
+	}
+	return internal
+}
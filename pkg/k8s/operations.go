@@ -18,46 +18,54 @@ package k8s
 import (
 	"bytes"
 	"context"
-	"flag"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
-	"log"
+	"mime"
 	"net/http"
 	"os"
 	"os/exec"
-	"path/filepath"
-
-	"k8s.io/client-go/util/homedir"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/litmuschaos/litmusctl/pkg/utils"
 	authorizationv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/api/core/v1"
 	k8serror "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime/schema"
-	"k8s.io/client-go/discovery"
-	authorizationv1client "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/restmapper"
+	"sigs.k8s.io/yaml"
 )
 
-type CanIOptions struct {
-	NoHeaders       bool
-	Namespace       string
-	AuthClient      authorizationv1client.AuthorizationV1Interface
-	DiscoveryClient discovery.DiscoveryInterface
-
-	Verb         string
-	Resource     schema.GroupVersionResource
-	Subresource  string
-	ResourceName string
-}
+// RequestTimeout bounds every single request sent to the Kubernetes API server
+var RequestTimeout = 30 * time.Second
 
 // NsExists checks if the given namespace already exists
-func NsExists(namespace string, kubeconfig *string) (bool, error) {
+func NsExists(ctx context.Context, namespace string, kubeconfig *string) (bool, error) {
+	defer utils.ProfileSpan("kubernetes", "get namespace "+namespace)()
 	clientset, err := ClientSet(kubeconfig)
 	if err != nil {
 		return false, err
 	}
-	ns, err := clientset.CoreV1().Namespaces().Get(context.TODO(), namespace, metav1.GetOptions{})
+
+	ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+
+	ns, err := clientset.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
 	if k8serror.IsNotFound(err) {
 		return false, nil
 	}
@@ -72,90 +80,162 @@ func NsExists(namespace string, kubeconfig *string) (bool, error) {
 type CheckSAPermissionsParams struct {
 	Verb      string
 	Resource  string
-	Print     bool
+	Group     string
 	Namespace string
 }
 
-func CheckSAPermissions(params CheckSAPermissionsParams, kubeconfig *string) (bool, error) {
+// PermissionResult is the outcome of a single permission check
+type PermissionResult struct {
+	CheckSAPermissionsParams
+	Allowed         bool
+	Reason          string
+	EvaluationError string
+	Err             error
+}
 
-	var o CanIOptions
-	o.Verb = params.Verb
-	o.Resource.Resource = params.Resource
-	o.Namespace = params.Namespace
+// PermissionReport is the outcome of a batch of permission checks, in the order they were requested
+type PermissionReport []PermissionResult
+
+// Allowed returns true when all the checks of the report are allowed
+func (r PermissionReport) Allowed() bool {
+	for _, result := range r {
+		if !result.Allowed {
+			return false
+		}
+	}
+	return true
+}
+
+// Denied returns the checks of the report which are not allowed
+func (r PermissionReport) Denied() []PermissionResult {
+	var denied []PermissionResult
+	for _, result := range r {
+		if !result.Allowed {
+			denied = append(denied, result)
+		}
+	}
+	return denied
+}
+
+// CheckSAPermissions checks whether the current user is allowed to perform the given
+// verb/resource pairs, running the SelfSubjectAccessReviews concurrently
+func CheckSAPermissions(ctx context.Context, params []CheckSAPermissionsParams, kubeconfig *string) (PermissionReport, error) {
+	defer utils.ProfileSpan("kubernetes", "check permissions")()
 	client, err := ClientSet(kubeconfig)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	AuthClient := client.AuthorizationV1()
 
-	sar := &authorizationv1.SelfSubjectAccessReview{
-		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
-			ResourceAttributes: &authorizationv1.ResourceAttributes{
-				Namespace:   o.Namespace,
-				Verb:        o.Verb,
-				Group:       o.Resource.Group,
-				Resource:    o.Resource.Resource,
-				Subresource: o.Subresource,
-				Name:        o.ResourceName,
+	return checkPermissions(ctx, params, func(ctx context.Context, attributes *authorizationv1.ResourceAttributes) (authorizationv1.SubjectAccessReviewStatus, error) {
+		sar := &authorizationv1.SelfSubjectAccessReview{
+			Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: attributes,
 			},
-		},
-	}
+		}
+		response, err := AuthClient.SelfSubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+		if err != nil {
+			return authorizationv1.SubjectAccessReviewStatus{}, err
+		}
+		return response.Status, nil
+	}), nil
+}
 
-	response, err := AuthClient.SelfSubjectAccessReviews().Create(context.TODO(), sar, metav1.CreateOptions{})
+// CheckServiceAccountPermissions checks whether the given service account is allowed to
+// perform the given verb/resource pairs, running the SubjectAccessReviews concurrently
+func CheckServiceAccountPermissions(ctx context.Context, namespace string, serviceAccount string, params []CheckSAPermissionsParams, kubeconfig *string) (PermissionReport, error) {
+	defer utils.ProfileSpan("kubernetes", "check permissions of "+namespace+"/"+serviceAccount)()
+	client, err := ClientSet(kubeconfig)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	if response.Status.Allowed {
-		if params.Print {
-			utils.White_B.Print("\n🔑 ", params.Resource, " ✅")
-		}
-	} else {
-		if params.Print {
-			utils.White_B.Print("\n🔑 ", params.Resource, " ❌")
-		}
-		if len(response.Status.Reason) > 0 {
-			utils.White_B.Println(response.Status.Reason)
+	AuthClient := client.AuthorizationV1()
+
+	return checkPermissions(ctx, params, func(ctx context.Context, attributes *authorizationv1.ResourceAttributes) (authorizationv1.SubjectAccessReviewStatus, error) {
+		sar := &authorizationv1.SubjectAccessReview{
+			Spec: authorizationv1.SubjectAccessReviewSpec{
+				ResourceAttributes: attributes,
+				User:               "system:serviceaccount:" + namespace + ":" + serviceAccount,
+				Groups:             []string{"system:serviceaccounts", "system:serviceaccounts:" + namespace, "system:authenticated"},
+			},
 		}
-		if len(response.Status.EvaluationError) > 0 {
-			utils.Red.Println(response.Status.EvaluationError)
+		response, err := AuthClient.SubjectAccessReviews().Create(ctx, sar, metav1.CreateOptions{})
+		if err != nil {
+			return authorizationv1.SubjectAccessReviewStatus{}, err
 		}
+		return response.Status, nil
+	}), nil
+}
+
+// checkPermissions runs the given access review for each of the params concurrently
+func checkPermissions(ctx context.Context, params []CheckSAPermissionsParams, review func(context.Context, *authorizationv1.ResourceAttributes) (authorizationv1.SubjectAccessReviewStatus, error)) PermissionReport {
+	report := make(PermissionReport, len(params))
+
+	var wg sync.WaitGroup
+	for i, param := range params {
+		wg.Add(1)
+		go func(i int, param CheckSAPermissionsParams) {
+			defer wg.Done()
+
+			report[i].CheckSAPermissionsParams = param
+
+			ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
+			defer cancel()
+
+			status, err := review(ctx, &authorizationv1.ResourceAttributes{
+				Namespace: param.Namespace,
+				Verb:      param.Verb,
+				Group:     param.Group,
+				Resource:  param.Resource,
+			})
+			if err != nil {
+				report[i].Err = err
+				return
+			}
+
+			report[i].Allowed = status.Allowed
+			report[i].Reason = status.Reason
+			report[i].EvaluationError = status.EvaluationError
+		}(i, param)
 	}
+	wg.Wait()
 
-	return response.Status.Allowed, nil
+	return report
 }
 
 // ValidNs takes a valid namespace as input from user
-func ValidNs(mode string, label string, kubeconfig *string) (string, bool) {
+func ValidNs(ctx context.Context, mode string, label string, kubeconfig *string) (string, bool, error) {
 start:
 	var (
 		namespace string
 		nsExists  bool
 	)
 
+	// The namespaces are listed to select from, they are entered instead if they can't be listed
+	namespaces, _ := ListNamespaces(ctx, kubeconfig)
+	var err error
 	if mode == "namespace" {
-		utils.White_B.Print("\nEnter the namespace (existing namespace) [Default: ", utils.DefaultNs, "]: ")
-		fmt.Scanln(&namespace)
-
+		namespace, err = promptName("Select the namespace (existing namespace)", namespaces, utils.DefaultNs, "", ValidateNsName)
 	} else if mode == "cluster" {
-		utils.White_B.Print("\nEnter the namespace (new or existing namespace) [Default: ", utils.DefaultNs, "]: ")
-		fmt.Scanln(&namespace)
+		namespace, err = promptName("Select the namespace (new or existing namespace)", namespaces, utils.DefaultNs, "Enter a new namespace", ValidateNsName)
 	} else {
-		utils.Red.Printf("\n 🚫 No mode selected \n")
-		os.Exit(1)
+		return "", false, errors.New("no installation mode selected")
 	}
-
-	if namespace == "" {
-		namespace = utils.DefaultNs
+	if err != nil {
+		return "", false, err
 	}
-	ok, err := NsExists(namespace, kubeconfig)
+	ok, err := NsExists(ctx, namespace, kubeconfig)
 	if err != nil {
-		utils.Red.Printf("\n 🚫 Namespace existence check failed: {%s}\n", err.Error())
-		os.Exit(1)
+		return "", false, fmt.Errorf("namespace existence check failed: %w", err)
 	}
 	if ok {
-		if podExists(podExistsParams{namespace, label}, kubeconfig) {
+		exists, err := podExists(ctx, podExistsParams{Namespace: namespace, Labels: []string{label}, FieldSelectors: ActivePodFieldSelectors}, kubeconfig)
+		if err != nil {
+			return "", false, fmt.Errorf("Chaos Delegate existence check failed: %w", err)
+		}
+		if exists {
 			utils.Red.Println("\n🚫 There is a Chaos Delegate already present in this namespace. Please enter a different namespace")
 			goto start
 		} else {
@@ -163,45 +243,180 @@ start:
 			utils.White_B.Println("👍 Continuing with", namespace, "namespace")
 		}
 	} else {
-		if val, _ := CheckSAPermissions(CheckSAPermissionsParams{"create", "namespace", false, namespace}, kubeconfig); !val {
+		if report, _ := CheckSAPermissions(ctx, []CheckSAPermissionsParams{{Verb: "create", Resource: "namespace", Namespace: namespace}}, kubeconfig); !report.Allowed() {
 			utils.Red.Println("🚫 You don't have permissions to create a namespace.\n Please enter an existing namespace.")
 			goto start
 		}
 		nsExists = false
 	}
 
-	return namespace, nsExists
+	return namespace, nsExists, nil
 }
 
+// ValidateNsName checks that the namespace name is a valid RFC 1123 label
+func ValidateNsName(namespace string) error {
+	if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return fmt.Errorf("invalid namespace name %q: %s", namespace, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// CreateNs creates the namespace with the given labels and annotations, e.g. the
+// pod-security.kubernetes.io/enforce label required by the policies of the cluster
+func CreateNs(ctx context.Context, namespace string, labels map[string]string, annotations map[string]string, kubeconfig *string) error {
+	defer utils.ProfileSpan("kubernetes", "create namespace "+namespace)()
+	clientset, err := ClientSet(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+
+	_, err = clientset.CoreV1().Namespaces().Create(ctx, &v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        namespace,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+	}, metav1.CreateOptions{})
+	return err
+}
+
+// DeleteNs deletes the namespace, without waiting for it to be terminated
+func DeleteNs(ctx context.Context, namespace string, kubeconfig *string) error {
+	defer utils.ProfileSpan("kubernetes", "delete namespace "+namespace)()
+	clientset, err := ClientSet(kubeconfig)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+
+	return clientset.CoreV1().Namespaces().Delete(ctx, namespace, metav1.DeleteOptions{})
+}
+
+// ActivePodFieldSelectors exclude the completed pods, e.g. of jobs with matching labels
+var ActivePodFieldSelectors = []string{"status.phase!=Succeeded", "status.phase!=Failed"}
+
+// WatchPodParams selects the pods matching all the label and field selectors
 type WatchPodParams struct {
-	Namespace string
-	Label     string
+	Namespace      string
+	Labels         []string
+	FieldSelectors []string
 }
 
-// WatchPod watches for the pod status
-func WatchPod(params WatchPodParams, kubeconfig *string) {
+// WatchPod watches for the pod status until the pod is running or the context is done
+func WatchPod(ctx context.Context, params WatchPodParams, kubeconfig *string) error {
+	defer utils.ProfileSpan("kubernetes", "watch pods")()
 	clientset, err := ClientSet(kubeconfig)
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
-	watch, err := clientset.CoreV1().Pods(params.Namespace).Watch(context.TODO(), metav1.ListOptions{
-		LabelSelector: params.Label,
-	})
+	watch, err := clientset.CoreV1().Pods(params.Namespace).Watch(ctx, podListOptions(params.Labels, params.FieldSelectors))
 	if err != nil {
-		log.Fatal(err.Error())
+		return fmt.Errorf("failed to watch the Chaos Delegate pods: %w", err)
+	}
+	defer watch.Stop()
+
+	spinner := utils.StartSpinner("Waiting for the Chaos Delegate pods to run")
+	defer spinner.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("stopped watching the Chaos Delegate pods: %w", ctx.Err())
+		case event, ok := <-watch.ResultChan():
+			if !ok {
+				return errors.New("watch on the Chaos Delegate pods was closed")
+			}
+			p, ok := event.Object.(*v1.Pod)
+			if !ok {
+				return fmt.Errorf("unexpected object of type %T in the pod watch", event.Object)
+			}
+			if spinner.Active() {
+				spinner.Update("Waiting for the Chaos Delegate pod " + p.Name + " to run: " + podStatus(p))
+			} else {
+				utils.White_B.Println("💡 Connecting Chaos Delegate to ChaosCenter.")
+			}
+			if p.Status.Phase == "Running" {
+				spinner.Stop()
+				utils.White_B.Println("🏃 Chaos Delegate is running!!")
+				return nil
+			}
+			if reason := podFailureReason(p); reason != "" {
+				return fmt.Errorf("pod %s failed: %s", p.Name, reason)
+			}
+		}
+	}
+}
+
+// podStatus returns the status of the pod, e.g. Pending or ContainerCreating, like kubectl get pods
+func podStatus(pod *v1.Pod) string {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Waiting != nil && status.State.Waiting.Reason != "" {
+			return status.State.Waiting.Reason
+		}
 	}
-	for event := range watch.ResultChan() {
-		p, ok := event.Object.(*v1.Pod)
-		if !ok {
-			log.Fatal("unexpected type")
+	return string(pod.Status.Phase)
+}
+
+// podFailureReason returns the reason why the pod can't start, if it's known to not recover on its own
+func podFailureReason(pod *v1.Pod) string {
+	if pod.Status.Phase == v1.PodFailed {
+		return pod.Status.Reason
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Waiting == nil {
+			continue
 		}
-		utils.White_B.Println("💡 Connecting Chaos Delegate to ChaosCenter.")
-		if p.Status.Phase == "Running" {
-			utils.White_B.Println("🏃 Chaos Delegate is running!!")
-			watch.Stop()
-			break
+		switch status.State.Waiting.Reason {
+		case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "CrashLoopBackOff", "CreateContainerConfigError":
+			return status.State.Waiting.Reason + ": " + status.State.Waiting.Message
+		}
+	}
+	return ""
+}
+
+// PodWarningEvents returns the warning events of the pods matching the given selectors,
+// e.g. FailedScheduling or ErrImagePull, which explain why the pods aren't running
+func PodWarningEvents(ctx context.Context, params WatchPodParams, kubeconfig *string) ([]v1.Event, error) {
+	defer utils.ProfileSpan("kubernetes", "list pod events")()
+	clientset, err := ClientSet(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+
+	pods, err := clientset.CoreV1().Pods(params.Namespace).List(ctx, podListOptions(params.Labels, params.FieldSelectors))
+	if err != nil {
+		return nil, err
+	}
+	podNames := make(map[string]bool, len(pods.Items))
+	for _, pod := range pods.Items {
+		podNames[pod.Name] = true
+	}
+
+	events, err := clientset.CoreV1().Events(params.Namespace).List(ctx, metav1.ListOptions{
+		FieldSelector: "involvedObject.kind=Pod,type=" + v1.EventTypeWarning,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var podEvents []v1.Event
+	for _, event := range events.Items {
+		if podNames[event.InvolvedObject.Name] {
+			podEvents = append(podEvents, event)
 		}
 	}
+	sort.Slice(podEvents, func(i, j int) bool {
+		return podEvents[i].LastTimestamp.Before(&podEvents[j].LastTimestamp)
+	})
+
+	return podEvents, nil
 }
 
 type PodList struct {
@@ -209,29 +424,34 @@ type PodList struct {
 }
 
 type podExistsParams struct {
-	Namespace string
-	Label     string
+	Namespace      string
+	Labels         []string
+	FieldSelectors []string
 }
 
-// PodExists checks if the pod with the given label already exists in the given namespace
-func podExists(params podExistsParams, kubeconfig *string) bool {
+// podListOptions returns the list options matching all the given label and field selectors
+func podListOptions(labels []string, fieldSelectors []string) metav1.ListOptions {
+	return metav1.ListOptions{
+		LabelSelector: strings.Join(labels, ","),
+		FieldSelector: strings.Join(fieldSelectors, ","),
+	}
+}
+
+// PodExists checks if a pod matching the given selectors already exists in the given namespace
+func podExists(ctx context.Context, params podExistsParams, kubeconfig *string) (bool, error) {
 	clientset, err := ClientSet(kubeconfig)
 	if err != nil {
-		log.Fatal(err)
-		return false
+		return false, err
 	}
-	watch, err := clientset.CoreV1().Pods(params.Namespace).List(context.TODO(), metav1.ListOptions{
-		LabelSelector: params.Label,
-	})
+	ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+
+	pods, err := clientset.CoreV1().Pods(params.Namespace).List(ctx, podListOptions(params.Labels, params.FieldSelectors))
 	if err != nil {
-		log.Fatal(err.Error())
-		return false
-	}
-	if len(watch.Items) >= 1 {
-		return true
+		return false, err
 	}
 
-	return false
+	return len(pods.Items) >= 1, nil
 }
 
 type SAExistsParams struct {
@@ -240,82 +460,273 @@ type SAExistsParams struct {
 }
 
 // SAExists checks if the given service account exists in the given namespace
-func SAExists(params SAExistsParams, kubeconfig *string) bool {
+func SAExists(ctx context.Context, params SAExistsParams, kubeconfig *string) (bool, error) {
+	defer utils.ProfileSpan("kubernetes", "get service account")()
 	clientset, err := ClientSet(kubeconfig)
 	if err != nil {
-		log.Fatal(err)
+		return false, err
 	}
-	msg := fmt.Sprintf("serviceaccounts \"%s\" not found", params.Serviceaccount)
-	_, newErr := clientset.CoreV1().ServiceAccounts(params.Namespace).Get(context.TODO(), params.Serviceaccount, metav1.GetOptions{})
-	if newErr != nil {
-		if newErr.Error() == msg {
-			return false
+	ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+
+	_, err = clientset.CoreV1().ServiceAccounts(params.Namespace).Get(ctx, params.Serviceaccount, metav1.GetOptions{})
+	if k8serror.IsNotFound(err) {
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("service account existence check failed: %w", err)
+	}
+	return true, nil
+}
+
+// ListNamespaces returns the names of the namespaces of the cluster
+func ListNamespaces(ctx context.Context, kubeconfig *string) ([]string, error) {
+	defer utils.ProfileSpan("kubernetes", "list namespaces")()
+	clientset, err := ClientSet(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+
+	list, err := clientset.CoreV1().Namespaces().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, ns := range list.Items {
+		names = append(names, ns.Name)
+	}
+	return names, nil
+}
+
+// ListServiceAccounts returns the names of the service accounts of the namespace
+func ListServiceAccounts(ctx context.Context, namespace string, kubeconfig *string) ([]string, error) {
+	defer utils.ProfileSpan("kubernetes", "list service accounts")()
+	clientset, err := ClientSet(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+
+	list, err := clientset.CoreV1().ServiceAccounts(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, sa := range list.Items {
+		names = append(names, sa.Name)
+	}
+	return names, nil
+}
+
+// promptName selects the name of a namespace or a service account from the existing ones, with the
+// default one highlighted. The newOption, if set, is offered to enter a new name instead. The name is
+// entered when there are no existing ones, e.g. when they can't be listed.
+func promptName(message string, names []string, defaultName string, newOption string, validate utils.Validator) (string, error) {
+	if len(names) == 0 {
+		return utils.PromptInput(strings.Replace(message, "Select", "Enter", 1), defaultName, validate)
+	}
+
+	options := append([]string{}, names...)
+	defaultIndex := -1
+	for i, name := range names {
+		if name == defaultName {
+			defaultIndex = i
 		}
-		log.Fatal(newErr)
 	}
-	return true
+	if newOption != "" {
+		options = append(options, newOption)
+		if defaultIndex < 0 {
+			defaultIndex = len(names)
+		}
+	}
+
+	index, err := utils.PromptSelect(message, options, defaultIndex)
+	if err != nil {
+		return "", err
+	}
+	if index == len(names) {
+		return utils.PromptInput(newOption, defaultName, validate)
+	}
+	return names[index], nil
 }
 
 // ValidSA gets a valid service account as input
-func ValidSA(namespace string, kubeconfig *string) (string, bool) {
-	var sa string
-	utils.White_B.Print("\nEnter service account [Default: ", utils.DefaultSA, "]: ")
-	fmt.Scanln(&sa)
-	if sa == "" {
-		sa = utils.DefaultSA
-	}
-	if SAExists(SAExistsParams{namespace, sa}, kubeconfig) {
+func ValidSA(ctx context.Context, namespace string, kubeconfig *string) (string, bool, error) {
+	// The service accounts of the namespace are listed to select from, if it exists yet
+	serviceAccounts, _ := ListServiceAccounts(ctx, namespace, kubeconfig)
+	sa, err := promptName("Select the service account", serviceAccounts, utils.DefaultSA, "Enter a new service account", func(name string) error {
+		if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+			return fmt.Errorf("invalid service account name %q: %s", name, strings.Join(errs, ", "))
+		}
+		return nil
+	})
+	if err != nil {
+		return "", false, err
+	}
+	exists, err := SAExists(ctx, SAExistsParams{namespace, sa}, kubeconfig)
+	if err != nil {
+		return "", false, err
+	}
+	if exists {
 		utils.White_B.Print("\n👍 Using the existing service account")
-		return sa, true
+		return sa, true, nil
 	}
-	return sa, false
+	return sa, false, nil
 }
 
 // Token: Authorization token
 // EndPoint: Endpoint in .litmusconfig
 // YamlPath: Path of yaml file
+// SaveManifest: Path to keep a copy of the downloaded manifest at, if set
+// ServerSide: Apply server-side, so that the fields owned by another installation are reported as conflicts instead of overwritten
+// Adopt: Take over the conflicting fields of the namespaced objects, the cluster-scoped ones, e.g. the CRDs, are never taken over
+// DryRun: Set to "server" to only validate the documents against the cluster, without persisting them
 type ApplyYamlPrams struct {
-	Token    string
-	Endpoint string
-	YamlPath string
+	Token        string
+	Endpoint     string
+	YamlPath     string
+	SaveManifest string
+	ServerSide   bool
+	Adopt        bool
+	DryRun       string
+	Channel      string
+}
+
+// subscriberImage matches the subscriber image of the manifest, to find the release of the manifest
+var subscriberImage = regexp.MustCompile(`([\w.\-/]*litmuschaos/)` + utils.SubscriberImage + `:([\w.\-]+)`)
+
+// RenderChannel switches the images of the release of the manifest to the tag of the release channel.
+// Only the litmuschaos images tagged with the release are switched, e.g. the argo images keep their tags.
+func RenderChannel(manifest []byte, channel string) ([]byte, error) {
+	if channel == "" || channel == utils.StableChannel {
+		return manifest, nil
+	}
+	tag, ok := utils.ChannelImageTags[channel]
+	if !ok {
+		return nil, errors.New("unknown channel " + channel + ", supported channels are stable/ci/edge")
+	}
+
+	match := subscriberImage.FindSubmatch(manifest)
+	if match == nil {
+		return nil, errors.New("unable to find the release of the Chaos Delegate manifest, the " + utils.SubscriberImage + " image is missing")
+	}
+	release := string(match[2])
+
+	images := regexp.MustCompile(`([\w.\-/]*litmuschaos/[\w.\-]+):` + regexp.QuoteMeta(release) + `\b`)
+	return images.ReplaceAll(manifest, []byte("${1}:"+tag)), nil
 }
 
-func ApplyYaml(params ApplyYamlPrams, kubeconfig string, isLocal bool) (output string, err error) {
+func ApplyYaml(ctx context.Context, params ApplyYamlPrams, kubeconfig string, isLocal bool) (output string, err error) {
+	defer utils.ProfileSpan("kubernetes", "apply manifest")()
 	path := params.YamlPath
 	if !isLocal {
-		path = fmt.Sprintf("%s/%s/%s.yaml", params.Endpoint, params.YamlPath, params.Token)
-		req, err := http.NewRequest("GET", path, nil)
+		manifest, err := downloadManifest(ctx, fmt.Sprintf("%s/%s/%s.yaml", params.Endpoint, params.YamlPath, params.Token))
 		if err != nil {
 			return "", err
 		}
-		resp, err := http.DefaultClient.Do(req)
+
+		manifest, err = RenderChannel(manifest, params.Channel)
 		if err != nil {
 			return "", err
 		}
-		defer resp.Body.Close()
-		resp_body, err := ioutil.ReadAll(resp.Body)
+
+		if params.SaveManifest != "" {
+			err = ioutil.WriteFile(params.SaveManifest, manifest, 0600)
+			if err != nil {
+				return "", err
+			}
+			path = params.SaveManifest
+		} else {
+			file, err := ioutil.TempFile("", "chaos-delegate-manifest-*.yaml")
+			if err != nil {
+				return "", err
+			}
+			defer os.Remove(file.Name())
+
+			_, err = file.Write(manifest)
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+			if err != nil {
+				return "", err
+			}
+			path = file.Name()
+		}
+	}
+
+	kubeconfigFile, cleanup, err := KubeconfigFile(&kubeconfig)
+	if err != nil {
+		return "", err
+	}
+	defer cleanup()
+
+	var args []string
+	if params.ServerSide || params.Adopt {
+		args = append(args, "--server-side", "--field-manager=litmusctl")
+	}
+	if params.DryRun != "" {
+		args = append(args, "--dry-run="+params.DryRun)
+	}
+	args = append(args, impersonationArgs()...)
+	if kubeconfigFile != "" {
+		args = append(args, []string{"--kubeconfig", kubeconfigFile}...)
+	}
+
+	if !params.Adopt {
+		return kubectlApply(ctx, path, args)
+	}
+
+	// Only the conflicts of the namespaced objects are forced, the cluster-scoped ones, e.g. the CRDs,
+	// are shared with the existing installation and stay owned by it
+	manifest, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+	mapper, err := restMapper(&kubeconfig)
+	if err != nil {
+		return "", err
+	}
+	clusterScoped, namespaced, err := splitManifestByScope(manifest, mapper)
+	if err != nil {
+		return "", err
+	}
+
+	for _, part := range []struct {
+		manifest []byte
+		args     []string
+	}{
+		{clusterScoped, args},
+		{namespaced, append(args, "--force-conflicts")},
+	} {
+		if len(part.manifest) == 0 {
+			continue
+		}
+		partPath, removePart, err := tempManifest(part.manifest)
 		if err != nil {
-			return "", err
+			return output, err
 		}
-		err = ioutil.WriteFile("chaos-delegate-manifest.yaml", resp_body, 0644)
+		partOutput, err := kubectlApply(ctx, partPath, part.args)
+		removePart()
+		output += partOutput
 		if err != nil {
-			return "", err
+			return output, err
 		}
-		path = "chaos-delegate-manifest.yaml"
-	}
-
-	args := []string{"kubectl", "apply", "-f", path}
-	if kubeconfig != "" {
-		args = append(args, []string{"--kubeconfig", kubeconfig}...)
-	} else {
-		args = []string{"kubectl", "apply", "-f", path}
 	}
+	return output, nil
+}
 
-	cmd := exec.Command(args[0], args[1:]...)
+// kubectlApply applies the manifest at the path with kubectl apply and the extra arguments
+func kubectlApply(ctx context.Context, path string, extraArgs []string) (string, error) {
+	args := append([]string{"kubectl", "apply", "-f", path}, extraArgs...)
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
 	var stdout, stderr bytes.Buffer
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
-	err = cmd.Run()
+	spinner := utils.StartSpinner("Applying the manifest")
+	err := cmd.Run()
+	spinner.Stop()
 	outStr, errStr := stdout.String(), stderr.String()
 
 	// err, can have exit status 1
@@ -333,21 +744,297 @@ func ApplyYaml(params ApplyYamlPrams, kubeconfig string, isLocal bool) (output s
 	return outStr, nil
 }
 
-// GetConfigMap returns config map for a given name and namespace
-func GetConfigMap(c context.Context, name string, namespace string) (map[string]string, error) {
-	var kubeconfig *string
+// splitManifestByScope splits a multi-document manifest into its cluster-scoped objects, e.g. the CRDs
+// and the namespaces, and the objects of a namespace. The scope is the one of the kind in the cluster,
+// as the namespaced objects may leave their namespace to the apply. A kind the cluster doesn't know yet,
+// e.g. of a CRD in the same manifest, is namespaced, its pass comes after the CRDs are created.
+func splitManifestByScope(manifest []byte, mapper meta.RESTMapper) (clusterScoped []byte, namespaced []byte, err error) {
+	objects, err := decodeManifest(manifest)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	if home := homedir.HomeDir(); home != "" {
-		kubeconfig = flag.String("configmap", filepath.Join(home, ".kube", "config"), "")
-	} else {
-		kubeconfig = flag.String("configmap", "", "")
+	for _, obj := range objects {
+		document, err := yaml.Marshal(obj.Object)
+		if err != nil {
+			return nil, nil, err
+		}
+		document = append([]byte("---\n"), document...)
+
+		gvk := obj.GroupVersionKind()
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if err != nil && !meta.IsNoMatchError(err) {
+			return nil, nil, err
+		}
+		if err == nil && mapping.Scope.Name() == meta.RESTScopeNameRoot {
+			clusterScoped = append(clusterScoped, document...)
+		} else {
+			namespaced = append(namespaced, document...)
+		}
+	}
+	return clusterScoped, namespaced, nil
+}
+
+// restMapper maps the kinds to the resources served by the cluster, and to their scope
+func restMapper(kubeconfig *string) (meta.RESTMapper, error) {
+	clientset, err := ClientSet(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	groupResources, err := restmapper.GetAPIGroupResources(clientset.Discovery())
+	if err != nil {
+		return nil, err
+	}
+	return restmapper.NewDiscoveryRESTMapper(groupResources), nil
+}
+
+// tempManifest writes the manifest to a temporary file, removed by the returned function
+func tempManifest(manifest []byte) (string, func(), error) {
+	file, err := ioutil.TempFile("", "chaos-delegate-manifest-*.yaml")
+	if err != nil {
+		return "", nil, err
+	}
+	remove := func() { os.Remove(file.Name()) }
+
+	_, err = file.Write(manifest)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		remove()
+		return "", nil, err
+	}
+	return file.Name(), remove, nil
+}
+
+// DiffYaml returns the unified diff of the live resources against the manifest at the path, i.e. the
+// changes applying it would make, with kubectl diff. It's empty when there are no changes.
+func DiffYaml(ctx context.Context, path string, kubeconfig string) (string, error) {
+	defer utils.ProfileSpan("kubernetes", "diff manifest")()
+	kubeconfigFile, cleanup, err := KubeconfigFile(&kubeconfig)
+	if err != nil {
+		return "", err
+	}
+	defer cleanup()
+
+	args := []string{"kubectl", "diff", "-f", path}
+	args = append(args, impersonationArgs()...)
+	if kubeconfigFile != "" {
+		args = append(args, []string{"--kubeconfig", kubeconfigFile}...)
+	}
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	// The diff is parsed as a unified diff, whatever the diff program of the user is
+	cmd.Env = append(os.Environ(), "KUBECTL_EXTERNAL_DIFF=diff -u -N")
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	spinner := utils.StartSpinner("Comparing the manifest with the cluster")
+	err = cmd.Run()
+	spinner.Stop()
+
+	// kubectl diff exits with 1 when there are changes, and above 1 when it fails
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+		return stdout.String(), nil
+	}
+	if err != nil {
+		if stderr.Len() > 0 {
+			return "", errors.New(stderr.String())
+		}
+		return "", err
+	}
+	return stdout.String(), nil
+}
+
+// ManifestResources lists the resources of a multi-document manifest, e.g. deployment/subscriber in litmus
+func ManifestResources(manifest []byte) ([]string, error) {
+	objects, err := decodeManifest(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []string
+	for _, obj := range objects {
+		resource := strings.ToLower(obj.GetKind()) + "/" + obj.GetName()
+		if obj.GetNamespace() != "" {
+			resource += " in " + obj.GetNamespace()
+		}
+		resources = append(resources, resource)
+	}
+	return resources, nil
+}
+
+// decodeManifest decodes the objects of a multi-document manifest, skipping the empty documents
+func decodeManifest(manifest []byte) ([]*unstructured.Unstructured, error) {
+	var objects []*unstructured.Unstructured
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if err == io.EOF {
+				return objects, nil
+			}
+			return nil, err
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		objects = append(objects, obj)
+	}
+}
+
+// DeleteYaml deletes all the resources of a multi-document manifest, in the reverse
+// order of their definition, and waits for the namespaces and CRDs to terminate
+func DeleteYaml(ctx context.Context, manifest []byte, kubeconfig *string) (string, error) {
+	defer utils.ProfileSpan("kubernetes", "delete manifest")()
+	objects, err := decodeManifest(manifest)
+	if err != nil {
+		return "", err
+	}
+
+	dynamicClient, err := DynamicClientSet(kubeconfig)
+	if err != nil {
+		return "", err
+	}
+	mapper, err := restMapper(kubeconfig)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		output      strings.Builder
+		errs        []error
+		terminating []dynamic.ResourceInterface
+		names       []string
+	)
+	propagation := metav1.DeletePropagationBackground
+	for i := len(objects) - 1; i >= 0; i-- {
+		obj := objects[i]
+		gvk := obj.GroupVersionKind()
+		mapping, err := mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		if meta.IsNoMatchError(err) {
+			// The CRD of the resource is already gone, and the resource with it
+			continue
+		} else if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		var resource dynamic.ResourceInterface = dynamicClient.Resource(mapping.Resource)
+		if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+			namespace := obj.GetNamespace()
+			if namespace == "" {
+				namespace = metav1.NamespaceDefault
+			}
+			resource = dynamicClient.Resource(mapping.Resource).Namespace(namespace)
+		}
+
+		reqCtx, cancel := context.WithTimeout(ctx, RequestTimeout)
+		err = resource.Delete(reqCtx, obj.GetName(), metav1.DeleteOptions{PropagationPolicy: &propagation})
+		cancel()
+		if k8serror.IsNotFound(err) {
+			continue
+		} else if err != nil {
+			errs = append(errs, fmt.Errorf("%s/%s: %w", strings.ToLower(gvk.Kind), obj.GetName(), err))
+			continue
+		}
+		fmt.Fprintf(&output, "%s/%s deleted\n", strings.ToLower(gvk.Kind), obj.GetName())
+
+		if gvk.Kind == "Namespace" || gvk.Kind == "CustomResourceDefinition" {
+			terminating = append(terminating, resource)
+			names = append(names, obj.GetName())
+		}
+	}
+
+	// Wait for the namespaces and CRDs to be terminated, so that the same
+	// manifest can be applied again right away
+	for i, resource := range terminating {
+		err := wait.PollImmediateUntil(2*time.Second, func() (bool, error) {
+			reqCtx, cancel := context.WithTimeout(ctx, RequestTimeout)
+			defer cancel()
+			_, err := resource.Get(reqCtx, names[i], metav1.GetOptions{})
+			if k8serror.IsNotFound(err) {
+				return true, nil
+			}
+			return false, err
+		}, ctx.Done())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("waiting for %s to terminate: %w", names[i], err))
+		}
+	}
+
+	return output.String(), utilerrors.NewAggregate(errs)
+}
+
+// downloadManifest fetches the manifest from the given URL, making sure that the
+// server returned a manifest and not an error page, and that it matches the
+// checksum provided by the server, if any
+func downloadManifest(ctx context.Context, url string) ([]byte, error) {
+	defer utils.ProfileSpan("download", "Chaos Delegate manifest")()
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, err
 	}
-	flag.Parse()
+	utils.SetRequestHeaders(req.Header, url)
+
+	// The manifest holds the access key of the Chaos Delegate, and its URL the registration token, it's
+	// never cached. The copies cached by earlier versions are removed.
+	utils.RemoveCachedDownloads(func(body []byte) bool {
+		return bytes.Contains(body, []byte("ACCESS_KEY"))
+	})
 
+	resp, err := utils.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download the manifest: %s", resp.Status)
+	}
+
+	body, err := ioutil.ReadAll(utils.NewProgressReader(resp.Body, resp.ContentLength, "⏳ Downloading the Chaos Delegate manifest"))
+	if err != nil {
+		return nil, err
+	}
+
+	if contentType := resp.Header.Get("Content-Type"); contentType != "" {
+		// Error pages of proxies and the server are returned as HTML or JSON
+		mediaType, _, err := mime.ParseMediaType(contentType)
+		if err != nil || mediaType == "text/html" || mediaType == "application/json" {
+			return nil, fmt.Errorf("unexpected content type %q of the manifest", contentType)
+		}
+	}
+
+	sum := sha256.Sum256(body)
+	if expected := resp.Header.Get("X-Checksum-Sha256"); expected != "" {
+		if !strings.EqualFold(expected, hex.EncodeToString(sum[:])) {
+			return nil, errors.New("checksum of the downloaded manifest doesn't match the one provided by the server")
+		}
+	}
+	for _, digest := range strings.Split(resp.Header.Get("Digest"), ",") {
+		digest = strings.TrimSpace(digest)
+		if len(digest) > 8 && strings.EqualFold(digest[:8], "sha-256=") {
+			if digest[8:] != base64.StdEncoding.EncodeToString(sum[:]) {
+				return nil, errors.New("digest of the downloaded manifest doesn't match the one provided by the server")
+			}
+		}
+	}
+
+	return body, nil
+}
+
+// GetConfigMap returns config map for a given name and namespace
+func GetConfigMap(c context.Context, name string, namespace string, kubeconfig *string) (map[string]string, error) {
+	defer utils.ProfileSpan("kubernetes", "get configmap "+namespace+"/"+name)()
 	clientset, err := ClientSet(kubeconfig)
 	if err != nil {
 		return nil, err
 	}
+	c, cancel := context.WithTimeout(c, RequestTimeout)
+	defer cancel()
+
 	x, err := clientset.CoreV1().ConfigMaps(namespace).Get(c, name, metav1.GetOptions{})
 	if err != nil {
 		return nil, err
@@ -355,3 +1042,28 @@ func GetConfigMap(c context.Context, name string, namespace string) (map[string]
 	}
 	return x.Data, nil
 }
+
+// GetSecret returns the data of the secret for a given name and namespace
+func GetSecret(c context.Context, name string, namespace string, kubeconfig *string) (map[string]string, error) {
+	defer utils.ProfileSpan("kubernetes", "get secret "+namespace+"/"+name)()
+	clientset, err := ClientSet(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	c, cancel := context.WithTimeout(c, RequestTimeout)
+	defer cancel()
+
+	secret, err := clientset.CoreV1().Secrets(namespace).Get(c, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]string, len(secret.Data)+len(secret.StringData))
+	for k, v := range secret.Data {
+		data[k] = string(v)
+	}
+	for k, v := range secret.StringData {
+		data[k] = v
+	}
+	return data, nil
+}
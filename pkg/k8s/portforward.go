@@ -0,0 +1,132 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package k8s
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+)
+
+// FindService returns the first service matching the label selector in the given
+// namespace, or in all the namespaces if no namespace is given
+func FindService(ctx context.Context, namespace string, labelSelector string, kubeconfig *string) (*v1.Service, error) {
+	clientset, err := ClientSet(kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+
+	services, err := clientset.CoreV1().Services(namespace).List(ctx, metav1.ListOptions{LabelSelector: labelSelector})
+	if err != nil {
+		return nil, err
+	}
+	if len(services.Items) == 0 {
+		return nil, fmt.Errorf("no service found with the label %s", labelSelector)
+	}
+
+	return &services.Items[0], nil
+}
+
+// PortForwardService forwards the local port to the first port of the service, through one
+// of its running pods, until the context is done. The ready channel is closed once the
+// port-forward is established.
+func PortForwardService(ctx context.Context, service *v1.Service, localPort int, ready chan struct{}, kubeconfig *string) error {
+	clientset, err := ClientSet(kubeconfig)
+	if err != nil {
+		return err
+	}
+	if len(service.Spec.Ports) == 0 {
+		return fmt.Errorf("service %s has no ports", service.Name)
+	}
+
+	listCtx, cancel := context.WithTimeout(ctx, RequestTimeout)
+	defer cancel()
+
+	pods, err := clientset.CoreV1().Pods(service.Namespace).List(listCtx, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(service.Spec.Selector).String(),
+		FieldSelector: "status.phase=Running",
+	})
+	if err != nil {
+		return err
+	}
+	if len(pods.Items) == 0 {
+		return fmt.Errorf("no running pod found for the service %s", service.Name)
+	}
+	pod := pods.Items[0]
+
+	targetPort, err := podPort(pod, service.Spec.Ports[0])
+	if err != nil {
+		return err
+	}
+
+	config, err := RestConfig(kubeconfig)
+	if err != nil {
+		return err
+	}
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return err
+	}
+
+	url := clientset.CoreV1().RESTClient().Post().
+		Resource("pods").
+		Namespace(pod.Namespace).
+		Name(pod.Name).
+		SubResource("portforward").
+		URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+
+	forwarder, err := portforward.New(dialer, []string{fmt.Sprintf("%d:%d", localPort, targetPort)}, ctx.Done(), ready, ioutil.Discard, os.Stderr)
+	if err != nil {
+		return err
+	}
+
+	return forwarder.ForwardPorts()
+}
+
+// podPort resolves the target port of the service port to a container port of the pod
+func podPort(pod v1.Pod, servicePort v1.ServicePort) (int32, error) {
+	if servicePort.TargetPort.Type == intstr.Int {
+		if servicePort.TargetPort.IntVal == 0 {
+			// The target port defaults to the port of the service
+			return servicePort.Port, nil
+		}
+		return servicePort.TargetPort.IntVal, nil
+	}
+
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.Name == servicePort.TargetPort.StrVal {
+				return port.ContainerPort, nil
+			}
+		}
+	}
+
+	return 0, errors.New("no container port named " + servicePort.TargetPort.StrVal + " found in pod " + pod.Name)
+}
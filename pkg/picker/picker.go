@@ -0,0 +1,108 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package picker
+
+import (
+	"errors"
+
+	"github.com/litmuschaos/litmus/litmus-portal/graphql-server/graph/model"
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+)
+
+// ProjectID asks for a project of the user, for the commands run without one, and returns its ID. On a
+// terminal, the project is picked from a list filtered with a fuzzy search, otherwise its ID is read from
+// stdin, e.g. when it's piped.
+func ProjectID(credentials types.Credentials) (string, error) {
+	if !utils.IsInteractive() {
+		return utils.PromptInput("Enter the Project ID", "", utils.NotEmpty("Project ID"))
+	}
+
+	userDetails, err := apis.GetProjectDetails(credentials)
+	if err != nil {
+		return "", err
+	}
+	projects := userDetails.Data.Projects
+	if len(projects) == 0 {
+		return "", errors.New("no projects found, create one with litmusctl create project")
+	}
+
+	var options []string
+	for _, project := range projects {
+		options = append(options, project.Name+"  "+project.ID)
+	}
+	index, err := utils.PromptSelect("Select the project", options, 0)
+	if err != nil {
+		return "", err
+	}
+	return projects[index].ID, nil
+}
+
+// AgentID asks for a Chaos Delegate of the project like ProjectID, and returns its ID
+func AgentID(projectID string, credentials types.Credentials) (string, error) {
+	if !utils.IsInteractive() {
+		return utils.PromptInput("Enter the Chaos Delegate ID", "", utils.NotEmpty("Chaos Delegate ID"))
+	}
+
+	agents, err := apis.GetAgentList(credentials, projectID)
+	if err != nil {
+		return "", err
+	}
+	if len(agents.Data.GetAgent) == 0 {
+		return "", errors.New("no Chaos Delegates found in the project, connect one with litmusctl connect chaos-delegate")
+	}
+
+	var options []string
+	for _, agent := range agents.Data.GetAgent {
+		status := "INACTIVE"
+		if agent.IsActive {
+			status = "ACTIVE"
+		}
+		options = append(options, agent.AgentName+"  "+status+"  "+agent.ClusterID)
+	}
+	index, err := utils.PromptSelect("Select the Chaos Delegate", options, 0)
+	if err != nil {
+		return "", err
+	}
+	return agents.Data.GetAgent[index].ClusterID, nil
+}
+
+// WorkflowID asks for a Chaos Scenario of the project like ProjectID, and returns its ID
+func WorkflowID(projectID string, credentials types.Credentials) (string, error) {
+	if !utils.IsInteractive() {
+		return utils.PromptInput("Enter the Chaos Scenario ID", "", utils.NotEmpty("Chaos Scenario ID"))
+	}
+
+	workflows, err := apis.GetAllWorkflows(model.ListWorkflowsRequest{ProjectID: projectID}, nil, credentials)
+	if err != nil {
+		return "", err
+	}
+	scenarios := workflows.Data.ListWorkflowDetails.Workflows
+	if len(scenarios) == 0 {
+		return "", errors.New("no Chaos Scenarios found in the project, create one with litmusctl create chaos-scenario")
+	}
+
+	var options []string
+	for _, scenario := range scenarios {
+		options = append(options, scenario.WorkflowName+"  "+scenario.ClusterName+"  "+scenario.WorkflowID)
+	}
+	index, err := utils.PromptSelect("Select the Chaos Scenario", options, 0)
+	if err != nil {
+		return "", err
+	}
+	return scenarios[index].WorkflowID, nil
+}
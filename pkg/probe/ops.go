@@ -0,0 +1,324 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package probe
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/litmuschaos/litmusctl/pkg/apis"
+	"github.com/litmuschaos/litmusctl/pkg/k8s"
+	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/litmuschaos/litmusctl/pkg/utils"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Result is the outcome of a single out-of-band probe execution
+type Result struct {
+	Passed      bool
+	Executed    bool
+	Description string
+}
+
+// Test validates the probe definition and, for the probe types which can be
+// evaluated from the CLI host, executes the probe once and reports the verdict.
+// CMD probes and mutating K8s probes are only validated, since they have to
+// run inside the target cluster.
+func Test(probe apis.Probe, kubeconfig *string) (Result, error) {
+	err := utils.ValidateProbeProperties(types.ProbeRequest{
+		Name:                     probe.Name,
+		Type:                     probe.Type,
+		KubernetesHTTPProperties: probe.KubernetesHTTPProperties,
+		KubernetesCMDProperties:  probe.KubernetesCMDProperties,
+		PromProperties:           probe.PromProperties,
+		K8sProperties:            probe.K8sProperties,
+	})
+	if err != nil {
+		return Result{}, err
+	}
+
+	switch probe.Type {
+	case types.HTTPProbe:
+		return testHTTPProbe(*probe.KubernetesHTTPProperties)
+	case types.PrometheusProbe:
+		return testPromProbe(*probe.PromProperties)
+	case types.K8sProbe:
+		return testK8sProbe(*probe.K8sProperties, kubeconfig)
+	default:
+		return Result{Passed: true, Description: "cmdProbe syntax is valid, the command can only be executed inside the target cluster"}, nil
+	}
+}
+
+// probeTimeout parses the probe timeout, falling back to a sane default
+func probeTimeout(timeout string) time.Duration {
+	if d, err := time.ParseDuration(timeout); err == nil && d > 0 {
+		return d
+	}
+	return 10 * time.Second
+}
+
+func testHTTPProbe(properties types.KubernetesHTTPProperties) (Result, error) {
+	client := &http.Client{Timeout: probeTimeout(properties.ProbeTimeout)}
+	if properties.InsecureSkipVerify != nil && *properties.InsecureSkipVerify {
+		client.Transport = &http.Transport{TLSClientConfig: &tls.Config{InsecureSkipVerify: true}}
+	}
+
+	var (
+		req          *http.Request
+		err          error
+		criteria     string
+		responseCode string
+	)
+	if properties.Method.Post != nil {
+		var body string
+		if properties.Method.Post.Body != nil {
+			body = *properties.Method.Post.Body
+		}
+		req, err = http.NewRequest(http.MethodPost, properties.URL, bytes.NewBufferString(body))
+		if err == nil && properties.Method.Post.ContentType != nil {
+			req.Header.Set("Content-Type", *properties.Method.Post.ContentType)
+		}
+		criteria, responseCode = properties.Method.Post.Criteria, properties.Method.Post.ResponseCode
+	} else if properties.Method.Get != nil {
+		req, err = http.NewRequest(http.MethodGet, properties.URL, nil)
+		criteria, responseCode = properties.Method.Get.Criteria, properties.Method.Get.ResponseCode
+	} else {
+		return Result{}, errors.New("either get or post method is required for " + string(types.HTTPProbe))
+	}
+	if err != nil {
+		return Result{}, err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Result{Executed: true, Description: "endpoint is not reachable: " + err.Error()}, nil
+	}
+	defer resp.Body.Close()
+
+	passed, err := compare(types.Comparator{Type: "int", Criteria: criteria, Value: responseCode}, strconv.Itoa(resp.StatusCode))
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Result{
+		Passed:      passed,
+		Executed:    true,
+		Description: fmt.Sprintf("received response code %d, expected %s %s", resp.StatusCode, criteria, responseCode),
+	}, nil
+}
+
+type promQueryResponse struct {
+	Status string `json:"status"`
+	Data   struct {
+		ResultType string `json:"resultType"`
+		Result     []struct {
+			Value []interface{} `json:"value"`
+		} `json:"result"`
+	} `json:"data"`
+	Error string `json:"error"`
+}
+
+func testPromProbe(properties types.PromProperties) (Result, error) {
+	if properties.Query == nil {
+		return Result{Passed: true, Description: "promProbe syntax is valid, queries read from queryPath can only be executed inside the target cluster"}, nil
+	}
+
+	client := &http.Client{Timeout: probeTimeout(properties.ProbeTimeout)}
+	resp, err := client.Get(strings.TrimRight(properties.Endpoint, "/") + "/api/v1/query?query=" + url.QueryEscape(*properties.Query))
+	if err != nil {
+		return Result{Executed: true, Description: "prometheus endpoint is not reachable: " + err.Error()}, nil
+	}
+	defer resp.Body.Close()
+
+	bodyBytes, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var queryResponse promQueryResponse
+	if err := json.Unmarshal(bodyBytes, &queryResponse); err != nil {
+		return Result{Executed: true, Description: "invalid response from prometheus endpoint: " + err.Error()}, nil
+	}
+	if queryResponse.Status != "success" {
+		return Result{Executed: true, Description: "query failed: " + queryResponse.Error}, nil
+	}
+	if len(queryResponse.Data.Result) == 0 || len(queryResponse.Data.Result[0].Value) < 2 {
+		return Result{Executed: true, Description: "query returned no data"}, nil
+	}
+
+	value := fmt.Sprint(queryResponse.Data.Result[0].Value[1])
+	passed, err := compare(*properties.Comparator, value)
+	if err != nil {
+		return Result{}, err
+	}
+
+	return Result{
+		Passed:      passed,
+		Executed:    true,
+		Description: fmt.Sprintf("query returned %s, expected %s %s", value, properties.Comparator.Criteria, properties.Comparator.Value),
+	}, nil
+}
+
+func testK8sProbe(properties types.K8sProperties, kubeconfig *string) (Result, error) {
+	if properties.Operation != "present" && properties.Operation != "absent" {
+		return Result{Passed: true, Description: "k8sProbe syntax is valid, the " + properties.Operation + " operation is not executed out-of-band as it mutates the cluster"}, nil
+	}
+
+	client, err := k8s.DynamicClientSet(kubeconfig)
+	if err != nil {
+		return Result{}, err
+	}
+
+	var group, namespace string
+	if properties.Group != nil {
+		group = *properties.Group
+	}
+	if properties.Namespace != nil {
+		namespace = *properties.Namespace
+	}
+
+	listOptions := metav1.ListOptions{}
+	if properties.FieldSelector != nil {
+		listOptions.FieldSelector = *properties.FieldSelector
+	}
+	if properties.LabelSelector != nil {
+		listOptions.LabelSelector = *properties.LabelSelector
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), probeTimeout(properties.ProbeTimeout))
+	defer cancel()
+
+	resources, err := client.Resource(schema.GroupVersionResource{
+		Group:    group,
+		Version:  properties.Version,
+		Resource: properties.Resource,
+	}).Namespace(namespace).List(ctx, listOptions)
+	if err != nil {
+		return Result{Executed: true, Description: "failed to list resources: " + err.Error()}, nil
+	}
+
+	count := len(resources.Items)
+	if properties.ResourceNames != nil && *properties.ResourceNames != "" {
+		names := strings.Split(*properties.ResourceNames, ",")
+		count = 0
+		for _, item := range resources.Items {
+			for _, name := range names {
+				if item.GetName() == strings.TrimSpace(name) {
+					count++
+				}
+			}
+		}
+	}
+
+	passed := (properties.Operation == "present" && count > 0) || (properties.Operation == "absent" && count == 0)
+	return Result{
+		Passed:      passed,
+		Executed:    true,
+		Description: fmt.Sprintf("found %d matching %s, expected them to be %s", count, properties.Resource, properties.Operation),
+	}, nil
+}
+
+// compare evaluates the actual value against the comparator, following the
+// semantics of the litmus probe comparators
+func compare(comparator types.Comparator, actual string) (bool, error) {
+	switch comparator.Type {
+	case "int", "float":
+		actualValue, err := strconv.ParseFloat(actual, 64)
+		if err != nil {
+			return false, errors.New("unable to parse " + actual + " as a number")
+		}
+
+		if comparator.Criteria == "OneOf" || comparator.Criteria == "Between" {
+			var expected []float64
+			if err := json.Unmarshal([]byte(comparator.Value), &expected); err != nil {
+				return false, errors.New("the value for " + comparator.Criteria + " criteria should be a list, like [200,201]")
+			}
+			if comparator.Criteria == "Between" {
+				if len(expected) != 2 {
+					return false, errors.New("the value for Between criteria should contain two numbers")
+				}
+				return actualValue >= expected[0] && actualValue <= expected[1], nil
+			}
+			for _, v := range expected {
+				if actualValue == v {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+
+		expectedValue, err := strconv.ParseFloat(comparator.Value, 64)
+		if err != nil {
+			return false, errors.New("unable to parse " + comparator.Value + " as a number")
+		}
+
+		switch comparator.Criteria {
+		case "==":
+			return actualValue == expectedValue, nil
+		case "!=":
+			return actualValue != expectedValue, nil
+		case ">":
+			return actualValue > expectedValue, nil
+		case "<":
+			return actualValue < expectedValue, nil
+		case ">=":
+			return actualValue >= expectedValue, nil
+		case "<=":
+			return actualValue <= expectedValue, nil
+		}
+
+	case "string":
+		switch comparator.Criteria {
+		case "equal":
+			return actual == comparator.Value, nil
+		case "notEqual":
+			return actual != comparator.Value, nil
+		case "contains":
+			return strings.Contains(actual, comparator.Value), nil
+		case "matches", "notMatches":
+			re, err := regexp.Compile(comparator.Value)
+			if err != nil {
+				return false, err
+			}
+			return re.MatchString(actual) == (comparator.Criteria == "matches"), nil
+		case "oneOf":
+			var expected []string
+			if err := json.Unmarshal([]byte(comparator.Value), &expected); err != nil {
+				return false, errors.New("the value for oneOf criteria should be a list, like [\"a\",\"b\"]")
+			}
+			for _, v := range expected {
+				if actual == v {
+					return true, nil
+				}
+			}
+			return false, nil
+		}
+	}
+
+	return false, errors.New("unsupported comparator " + comparator.Type + "/" + comparator.Criteria)
+}
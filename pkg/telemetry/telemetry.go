@@ -0,0 +1,240 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Package telemetry records anonymized usage of litmusctl, when the user opts in with
+// litmusctl config set telemetry=on. Events are appended to a local spool and sent by a
+// detached process, so that recording never blocks a command.
+package telemetry
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// FlushCommand is the hidden command which sends the spooled events
+	FlushCommand = "telemetry-flush"
+
+	// maxSpoolEvents bounds the spool when the events can't be sent, the oldest ones are dropped
+	maxSpoolEvents = 1000
+
+	// flushThreshold is the number of spooled events which triggers a flush
+	flushThreshold = 20
+)
+
+// Endpoint is where the events are sent to. It's set at build time, the events are only
+// spooled without it.
+var Endpoint string
+
+// Event is a single command execution. It holds no arguments, flags, endpoints or error
+// messages, which could identify the user or their infrastructure.
+type Event struct {
+	Command    string `json:"command"`
+	DurationMs int64  `json:"durationMs"`
+	ErrorClass string `json:"errorClass,omitempty"`
+	CLIVersion string `json:"cliVersion"`
+	OS         string `json:"os"`
+	Arch       string `json:"arch"`
+	Timestamp  int64  `json:"timestamp"`
+}
+
+var state struct {
+	sync.Mutex
+	enabled  bool
+	recorded bool
+	command  string
+	start    time.Time
+}
+
+// Start begins recording the execution of the command, when telemetry is enabled
+func Start(command string, enabled bool) {
+	state.Lock()
+	defer state.Unlock()
+
+	state.enabled = enabled
+	state.command = command
+	state.start = time.Now()
+}
+
+// Record spools the event of the command started with Start, once. Errors are
+// recorded by their type only.
+func Record(err error) {
+	state.Lock()
+	defer state.Unlock()
+
+	if !state.enabled || state.recorded {
+		return
+	}
+	state.recorded = true
+
+	event := Event{
+		Command:    state.command,
+		DurationMs: time.Since(state.start).Milliseconds(),
+		CLIVersion: os.Getenv("CLIVersion"),
+		OS:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+		Timestamp:  time.Now().Unix(),
+	}
+	if err != nil {
+		event.ErrorClass = fmt.Sprintf("%T", err)
+	}
+
+	// Telemetry failures are never surfaced to the user
+	count, spoolErr := spool(event)
+	if spoolErr == nil && count >= flushThreshold && Endpoint != "" {
+		startFlush()
+	}
+}
+
+// SpoolPath returns the path of the local spool of the events
+func SpoolPath() (string, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cacheDir, "litmusctl", "telemetry", "events.jsonl"), nil
+}
+
+// Flush sends the spooled events, and empties the spool when they are accepted
+func Flush() error {
+	if Endpoint == "" {
+		return errors.New("no telemetry endpoint")
+	}
+
+	path, err := SpoolPath()
+	if err != nil {
+		return err
+	}
+
+	// The spool is moved aside, so that the events recorded while sending aren't lost
+	flushing := path + ".flushing"
+	if err := os.Rename(path, flushing); err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	events, err := readSpool(flushing)
+	if err != nil || len(events) == 0 {
+		_ = os.Remove(flushing)
+		return err
+	}
+
+	if err := send(events); err != nil {
+		// The events are sent with the next flush
+		_, _ = spool(events...)
+		_ = os.Remove(flushing)
+		return err
+	}
+	return os.Remove(flushing)
+}
+
+func send(events []Event) error {
+	payload, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Post(Endpoint, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return errors.New("unexpected response " + resp.Status)
+	}
+	return nil
+}
+
+// spool appends the events to the spool, and returns the number of spooled events
+func spool(newEvents ...Event) (int, error) {
+	path, err := SpoolPath()
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return 0, err
+	}
+
+	events, err := readSpool(path)
+	if err != nil {
+		// A corrupted spool is dropped
+		events = nil
+	}
+	events = append(events, newEvents...)
+	if len(events) > maxSpoolEvents {
+		events = events[len(events)-maxSpoolEvents:]
+	}
+
+	var data bytes.Buffer
+	for _, e := range events {
+		line, err := json.Marshal(e)
+		if err != nil {
+			return 0, err
+		}
+		data.Write(append(line, '\n'))
+	}
+	return len(events), ioutil.WriteFile(path, data.Bytes(), 0600)
+}
+
+func readSpool(path string) ([]Event, error) {
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var events []Event
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var event Event
+		if err := json.Unmarshal([]byte(line), &event); err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, scanner.Err()
+}
+
+// startFlush sends the spooled events from a detached process, which outlives the command
+func startFlush() {
+	executable, err := os.Executable()
+	if err != nil {
+		return
+	}
+	cmd := exec.Command(executable, FlushCommand)
+	if err := cmd.Start(); err == nil {
+		_ = cmd.Process.Release()
+	}
+}
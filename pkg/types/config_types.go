@@ -16,22 +16,31 @@ limitations under the License.
 package types
 
 type User struct {
-	ExpiresIn string `yaml:"expires_in" json:"expires_in"`
-	Token     string `yaml:"token" json:"token"`
-	Username  string `yaml:"username" json:"username"`
+	ExpiresIn      string `yaml:"expires_in" json:"expires_in"`
+	Token          string `yaml:"token" json:"token"`
+	Username       string `yaml:"username" json:"username"`
+	DefaultProject string `yaml:"default-project,omitempty" json:"default-project,omitempty"`
 }
 
 type Account struct {
-	Users    []User `yaml:"users" json:"users"`
-	Endpoint string `yaml:"endpoint" json:"endpoint"`
+	Users    []User            `yaml:"users" json:"users"`
+	Endpoint string            `yaml:"endpoint" json:"endpoint"`
+	Defaults map[string]string `yaml:"defaults,omitempty" json:"defaults,omitempty"`
+	Headers  map[string]string `yaml:"headers,omitempty" json:"headers,omitempty"`
 }
 
 type LitmuCtlConfig struct {
-	Accounts       []Account `yaml:"accounts" json:"accounts"`
-	APIVersion     string    `yaml:"apiVersion" json:"apiVersion"`
-	CurrentAccount string    `yaml:"current-account" json:"current-account"`
-	CurrentUser    string    `yaml:"current-user" json:"current-user"`
-	Kind           string    `yaml:"kind" json:"kind"`
+	Accounts       []Account   `yaml:"accounts" json:"accounts"`
+	APIVersion     string      `yaml:"apiVersion" json:"apiVersion"`
+	CurrentAccount string      `yaml:"current-account" json:"current-account"`
+	CurrentUser    string      `yaml:"current-user" json:"current-user"`
+	Kind           string      `yaml:"kind" json:"kind"`
+	Preferences    Preferences `yaml:"preferences,omitempty" json:"preferences,omitempty"`
+}
+
+// Preferences are the settings of litmusctl which are independent of the accounts
+type Preferences struct {
+	Telemetry string `yaml:"telemetry,omitempty" json:"telemetry,omitempty"`
 }
 
 type Current struct {
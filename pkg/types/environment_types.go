@@ -0,0 +1,43 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package types
+
+type EnvironmentType string
+
+const (
+	ProductionEnvironment    EnvironmentType = "PROD"
+	NonProductionEnvironment EnvironmentType = "NON_PROD"
+)
+
+// DisplayName returns the human readable type of an environment
+func (t EnvironmentType) DisplayName() string {
+	switch t {
+	case ProductionEnvironment:
+		return "production"
+	case NonProductionEnvironment:
+		return "non-production"
+	}
+	return string(t)
+}
+
+// CreateEnvironmentRequest is the environment definition accepted by ChaosCenter
+type CreateEnvironmentRequest struct {
+	EnvironmentID string          `json:"environmentID"`
+	Name          string          `json:"name"`
+	Type          EnvironmentType `json:"type"`
+	Description   *string         `json:"description,omitempty"`
+	Tags          []string        `json:"tags,omitempty"`
+}
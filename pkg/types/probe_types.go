@@ -0,0 +1,108 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package types
+
+type ProbeType string
+
+const (
+	HTTPProbe       ProbeType = "httpProbe"
+	CMDProbe        ProbeType = "cmdProbe"
+	PrometheusProbe ProbeType = "promProbe"
+	K8sProbe        ProbeType = "k8sProbe"
+)
+
+// ProbeRequest is the resilience probe definition accepted by ChaosCenter
+type ProbeRequest struct {
+	Name                     string                    `json:"name"`
+	Description              *string                   `json:"description,omitempty"`
+	Tags                     []string                  `json:"tags,omitempty"`
+	Type                     ProbeType                 `json:"type"`
+	InfrastructureType       string                    `json:"infrastructureType"`
+	KubernetesHTTPProperties *KubernetesHTTPProperties `json:"kubernetesHTTPProperties,omitempty"`
+	KubernetesCMDProperties  *KubernetesCMDProperties  `json:"kubernetesCMDProperties,omitempty"`
+	PromProperties           *PromProperties           `json:"promProperties,omitempty"`
+	K8sProperties            *K8sProperties            `json:"k8sProperties,omitempty"`
+}
+
+// ProbeRunProperties are the run properties common to all the probe types
+type ProbeRunProperties struct {
+	ProbeTimeout         string  `json:"probeTimeout"`
+	Interval             string  `json:"interval"`
+	Retry                *int    `json:"retry,omitempty"`
+	Attempt              *int    `json:"attempt,omitempty"`
+	ProbePollingInterval *string `json:"probePollingInterval,omitempty"`
+	InitialDelay         *string `json:"initialDelay,omitempty"`
+	EvaluationTimeout    *string `json:"evaluationTimeout,omitempty"`
+	StopOnFailure        *bool   `json:"stopOnFailure,omitempty"`
+}
+
+type KubernetesHTTPProperties struct {
+	ProbeRunProperties
+	URL                string      `json:"url"`
+	Method             *HTTPMethod `json:"method"`
+	InsecureSkipVerify *bool       `json:"insecureSkipVerify,omitempty"`
+}
+
+type HTTPMethod struct {
+	Get  *GETMethod  `json:"get,omitempty"`
+	Post *POSTMethod `json:"post,omitempty"`
+}
+
+type GETMethod struct {
+	Criteria     string `json:"criteria"`
+	ResponseCode string `json:"responseCode"`
+}
+
+type POSTMethod struct {
+	ContentType  *string `json:"contentType,omitempty"`
+	Body         *string `json:"body,omitempty"`
+	BodyPath     *string `json:"bodyPath,omitempty"`
+	Criteria     string  `json:"criteria"`
+	ResponseCode string  `json:"responseCode"`
+}
+
+type KubernetesCMDProperties struct {
+	ProbeRunProperties
+	Command    string      `json:"command"`
+	Comparator *Comparator `json:"comparator"`
+	Source     *string     `json:"source,omitempty"`
+}
+
+type PromProperties struct {
+	ProbeRunProperties
+	Endpoint   string      `json:"endpoint"`
+	Query      *string     `json:"query,omitempty"`
+	QueryPath  *string     `json:"queryPath,omitempty"`
+	Comparator *Comparator `json:"comparator"`
+}
+
+type K8sProperties struct {
+	ProbeRunProperties
+	Group         *string `json:"group,omitempty"`
+	Version       string  `json:"version"`
+	Resource      string  `json:"resource"`
+	Namespace     *string `json:"namespace,omitempty"`
+	ResourceNames *string `json:"resourceNames,omitempty"`
+	FieldSelector *string `json:"fieldSelector,omitempty"`
+	LabelSelector *string `json:"labelSelector,omitempty"`
+	Operation     string  `json:"operation"`
+}
+
+type Comparator struct {
+	Type     string `json:"type"`
+	Value    string `json:"value"`
+	Criteria string `json:"criteria"`
+}
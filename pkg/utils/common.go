@@ -16,21 +16,28 @@ limitations under the License.
 package utils
 
 import (
-	"bufio"
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 	"os"
+	"os/signal"
+	"path/filepath"
 	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/litmuschaos/litmusctl/pkg/config"
 	"github.com/litmuschaos/litmusctl/pkg/types"
+	"github.com/mattn/go-colorable"
 	"github.com/mitchellh/go-homedir"
 	"github.com/spf13/cobra"
+	"golang.org/x/term"
 	"gopkg.in/yaml.v2"
 )
 
@@ -38,40 +45,103 @@ var (
 	Red     = color.New(color.FgRed)
 	White_B = color.New(color.FgWhite, color.Bold)
 	White   = color.New(color.FgWhite)
+
+	// Quiet suppresses the warnings, and makes the create, connect and list commands print only the
+	// IDs of their resources. It's set by the --quiet flag.
+	Quiet bool
+
+	// EndpointOverride and TokenOverride are used instead of the config file, set by the --endpoint and --token flags
+	EndpointOverride string
+	TokenOverride    string
+
+	// ExitHook is called with the error before PrintError exits, it's set by the root command to record the telemetry
+	ExitHook func(err error)
 )
 
-func Scanner() string {
-	scanner := bufio.NewScanner(os.Stdin)
-	for scanner.Scan() {
-		return scanner.Text()
-	}
-	if err := scanner.Err(); err != nil {
-		fmt.Fprintln(os.Stderr, "reading standard input:", err)
-	}
-	return ""
-}
 func PrintError(err error) {
 	if err != nil {
-		Red.Println(err)
+		message, hint := TranslateError(err)
+		Red.Println(message)
+		if hint != "" {
+			White.Println("👉 " + hint)
+		}
+		printRequestID()
+		if ExitHook != nil {
+			ExitHook(err)
+		}
 		os.Exit(1)
 	}
 }
 
+// PrintIDs prints the IDs of the resources created, connected or listed by the command one per line,
+// which is the only output of these commands with --quiet
+func PrintIDs(ids ...string) {
+	for _, id := range ids {
+		fmt.Println(id)
+	}
+}
+
+// QuietMessages sends the messages of the create and connect commands to stderr with --quiet, so that
+// the IDs printed by PrintIDs are all that scripts capture from stdout
+func QuietMessages() {
+	if Quiet {
+		color.Output = colorable.NewColorableStderr()
+	}
+}
+
 func GetLitmusConfigPath(cmd *cobra.Command) string {
 	configFilePath, err := cmd.Flags().GetString("config")
 	PrintError(err)
 
 	if configFilePath == "" {
-		home, err := homedir.Dir()
+		configFilePath, err = DefaultLitmusConfigPath()
 		PrintError(err)
-
-		configFilePath = home + "/" + DefaultFileName
 	}
 
 	return configFilePath
 }
 
+// DefaultLitmusConfigPath returns the config file used when the --config flag is not set:
+//  1. the LITMUSCONFIG environment variable
+//  2. $XDG_CONFIG_HOME/litmusctl/config (defaulting to $HOME/.config), if it exists
+//  3. $HOME/.litmusconfig, if it exists or XDG_CONFIG_HOME is not set
+//  4. $XDG_CONFIG_HOME/litmusctl/config otherwise
+func DefaultLitmusConfigPath() (string, error) {
+	if configFilePath := os.Getenv(LitmusConfigEnv); configFilePath != "" {
+		return configFilePath, nil
+	}
+
+	home, err := homedir.Dir()
+	if err != nil {
+		return "", err
+	}
+	legacyPath := filepath.Join(home, DefaultFileName)
+
+	xdgConfigHome := os.Getenv("XDG_CONFIG_HOME")
+	configHome := xdgConfigHome
+	if configHome == "" {
+		configHome = filepath.Join(home, ".config")
+	}
+	xdgPath := filepath.Join(configHome, filepath.FromSlash(XDGConfigFileName))
+
+	if config.FileExists(xdgPath) {
+		return xdgPath, nil
+	}
+	if config.FileExists(legacyPath) || xdgConfigHome == "" {
+		return legacyPath, nil
+	}
+	return xdgPath, nil
+}
+
 func GetCredentials(cmd *cobra.Command) (types.Credentials, error) {
+	if Stateless() {
+		credentials, err := statelessCredentials()
+		if err != nil {
+			return types.Credentials{}, err
+		}
+		return credentials, CheckVersionSkew(credentials.Endpoint)
+	}
+
 	configFilePath := GetLitmusConfigPath(cmd)
 
 	obj, err := config.YamltoObject(configFilePath)
@@ -81,22 +151,293 @@ func GetCredentials(cmd *cobra.Command) (types.Credentials, error) {
 		return types.Credentials{}, errors.New("Current user or current account is not set")
 	}
 
-	var token string
+	var (
+		currentUser    types.User
+		token          string
+		defaultProject string
+	)
 	for _, account := range obj.Accounts {
 		if account.Endpoint == obj.CurrentAccount {
 			for _, user := range account.Users {
 				if user.Username == obj.CurrentUser {
+					currentUser = user
 					token = user.Token
+					defaultProject = user.DefaultProject
 				}
 			}
 		}
 	}
 
-	return types.Credentials{
+	// Fall back to the default project of the account, unless the project is passed explicitly
+	if flag := cmd.Flags().Lookup("project-id"); flag != nil && !flag.Changed && flag.Value.String() == "" && defaultProject != "" {
+		PrintError(cmd.Flags().Set("project-id", defaultProject))
+	}
+
+	if config.IsEncrypted(token) {
+		passphrase, err := ConfigPassphrase()
+		if err != nil {
+			return types.Credentials{}, err
+		}
+		token, err = config.DecryptToken(token, passphrase)
+		if err != nil {
+			return types.Credentials{}, err
+		}
+	}
+
+	credentials := types.Credentials{
 		Username: obj.CurrentUser,
 		Token:    token,
 		Endpoint: obj.CurrentAccount,
-	}, nil
+	}
+
+	if err := CheckVersionSkew(credentials.Endpoint); err != nil {
+		return types.Credentials{}, err
+	}
+
+	// Renew the token before it's rejected by the ChaosCenter
+	currentUser.Token = token
+	if expiry, err := config.TokenExpiry(currentUser); err == nil && time.Until(expiry) < config.TokenRenewalWindow {
+		return renewToken(credentials, expiry, configFilePath, config.HasEncryptedTokens(obj))
+	}
+
+	return credentials, nil
+}
+
+// accountOverride returns the endpoint and token passed by the flags, or the environment variables
+func accountOverride() (string, string) {
+	endpoint, token := EndpointOverride, TokenOverride
+	if endpoint == "" {
+		endpoint = os.Getenv(EndpointEnv)
+	}
+	if token == "" {
+		token = os.Getenv(TokenEnv)
+	}
+	return strings.TrimRight(endpoint, "/"), token
+}
+
+// Stateless returns whether the endpoint and token are passed with --endpoint and --token, or their
+// environment variables. The config file is then neither read nor written.
+func Stateless() bool {
+	endpoint, token := accountOverride()
+	return endpoint != "" || token != ""
+}
+
+// statelessCredentials returns the credentials passed with --endpoint and --token, the username is
+// read from the token
+func statelessCredentials() (types.Credentials, error) {
+	endpoint, token := accountOverride()
+	if endpoint == "" {
+		return types.Credentials{}, errors.New("--endpoint is required with --token, or set the " + EndpointEnv + " environment variable")
+	}
+	if token == "" {
+		return types.Credentials{}, errors.New("--token is required with --endpoint, or set the " + TokenEnv + " environment variable")
+	}
+
+	credentials := types.Credentials{Endpoint: endpoint, Token: token}
+	if claims, err := config.JWTClaims(token); err == nil {
+		if username, ok := claims["username"].(string); ok {
+			credentials.Username = username
+		}
+	}
+	return credentials, nil
+}
+
+// AccountDefaultFlags are the flags whose defaults can be stored per account
+var AccountDefaultFlags = []string{"output", "installation-mode", "namespace", "skipSSL", "sort-by", "order", "limit"}
+
+// ApplyAccountDefaults sets the flags of the command to the defaults of the current account,
+// unless they are passed on the command line
+func ApplyAccountDefaults(cmd *cobra.Command) error {
+	obj, ok := optionalConfig(cmd)
+	if !ok {
+		return nil
+	}
+
+	for _, account := range obj.Accounts {
+		if account.Endpoint != obj.CurrentAccount {
+			continue
+		}
+		for name, value := range account.Defaults {
+			flag := cmd.Flags().Lookup(name)
+			if flag == nil || flag.Changed {
+				continue
+			}
+			if _, ok := flag.Annotations[NoAccountDefaultsAnnotation]; ok {
+				continue
+			}
+			if err := flag.Value.Set(value); err != nil {
+				return errors.New("invalid default " + name + "=" + value + " of the account " + account.Endpoint + ": " + err.Error())
+			}
+		}
+	}
+	return nil
+}
+
+// TelemetryEnabled returns whether the user opted in to the telemetry with litmusctl config set telemetry=on
+func TelemetryEnabled(cmd *cobra.Command) bool {
+	obj, ok := optionalConfig(cmd)
+	return ok && obj.Preferences.Telemetry == "on"
+}
+
+// CurrentAccount returns the endpoint and the username the command runs as, without failing when
+// there's no account
+func CurrentAccount(cmd *cobra.Command) (string, string) {
+	if Stateless() {
+		credentials, _ := statelessCredentials()
+		return credentials.Endpoint, credentials.Username
+	}
+	obj, ok := optionalConfig(cmd)
+	if !ok {
+		return "", ""
+	}
+	return obj.CurrentAccount, obj.CurrentUser
+}
+
+// optionalConfig reads the config file, if it exists and is valid. Invalid config files are
+// reported by the commands which use them.
+func optionalConfig(cmd *cobra.Command) (types.LitmuCtlConfig, bool) {
+	if Stateless() {
+		return types.LitmuCtlConfig{}, false
+	}
+	configFilePath, err := cmd.Flags().GetString("config")
+	if err != nil {
+		return types.LitmuCtlConfig{}, false
+	}
+	if configFilePath == "" {
+		if configFilePath, err = DefaultLitmusConfigPath(); err != nil {
+			return types.LitmuCtlConfig{}, false
+		}
+	}
+	if !config.FileExists(configFilePath) {
+		return types.LitmuCtlConfig{}, false
+	}
+
+	obj, err := config.YamltoObject(configFilePath)
+	return obj, err == nil
+}
+
+// Login authenticates against the ChaosCenter, it's set by the root command to apis.Auth
+var Login func(input types.AuthInput) (types.AuthResponse, error)
+
+// renewToken renews an expired or nearly expired token with the credentials of the endpoint in the
+// netrc file, or prompts for the password. Nearly expired tokens are used as they are when there is
+// no terminal to prompt on.
+func renewToken(credentials types.Credentials, expiry time.Time, configFilePath string, encrypt bool) (types.Credentials, error) {
+	expired := time.Now().After(expiry)
+
+	entry, found, err := config.NetrcCredentials(credentials.Endpoint)
+	if err != nil {
+		return types.Credentials{}, err
+	}
+	if found && entry.Password != "" && (entry.Login == "" || entry.Login == credentials.Username) {
+		// The password of the netrc entry may be a token itself
+		if tokenExpiry, err := config.JWTExpiry(entry.Password); err == nil {
+			if time.Until(tokenExpiry) > config.TokenRenewalWindow {
+				credentials.Token = entry.Password
+				return credentials, storeToken(credentials, tokenExpiry, configFilePath, encrypt)
+			}
+		} else if Login != nil {
+			return login(credentials, entry.Password, configFilePath, encrypt)
+		}
+	}
+
+	if Login == nil || !term.IsTerminal(int(os.Stdin.Fd())) {
+		if expired {
+			return types.Credentials{}, errors.New("the token of " + credentials.Username + "@" + credentials.Endpoint + " has expired, run litmusctl config set-account to log in again")
+		}
+		return credentials, nil
+	}
+
+	Red.Fprintln(os.Stderr, "\n⚠️  The token of "+credentials.Username+"@"+credentials.Endpoint+" "+config.HumanizeExpiry(expiry))
+	White_B.Fprint(os.Stderr, "Password to log in again: ")
+	password, err := term.ReadPassword(int(os.Stdin.Fd()))
+	White_B.Fprintln(os.Stderr)
+	if err != nil {
+		return types.Credentials{}, err
+	}
+	if len(password) == 0 {
+		if expired {
+			return types.Credentials{}, errors.New("password cannot be empty")
+		}
+		return credentials, nil
+	}
+
+	return login(credentials, string(password), configFilePath, encrypt)
+}
+
+// login logs in again with the password, and stores the new token
+func login(credentials types.Credentials, password string, configFilePath string, encrypt bool) (types.Credentials, error) {
+	resp, err := Login(types.AuthInput{Endpoint: credentials.Endpoint, Username: credentials.Username, Password: password})
+	if err != nil {
+		return types.Credentials{}, err
+	}
+
+	credentials.Token = resp.AccessToken
+	return credentials, storeToken(credentials, time.Now().Add(time.Second*time.Duration(resp.ExpiresIn)), configFilePath, encrypt)
+}
+
+// storeToken stores the token of the credentials in the config file
+func storeToken(credentials types.Credentials, expiry time.Time, configFilePath string, encrypt bool) error {
+	// Keep the tokens encrypted at rest once the config file is encrypted
+	storedToken := credentials.Token
+	if encrypt {
+		passphrase, err := ConfigPassphrase()
+		if err != nil {
+			return err
+		}
+		if storedToken, err = config.EncryptToken(storedToken, passphrase); err != nil {
+			return err
+		}
+	}
+
+	return config.UpdateLitmusCtlConfig(types.UpdateLitmusCtlConfig{
+		CurrentAccount: credentials.Endpoint,
+		CurrentUser:    credentials.Username,
+		Account: types.Account{
+			Endpoint: credentials.Endpoint,
+			Users: []types.User{{
+				ExpiresIn: fmt.Sprint(expiry.Unix()),
+				Token:     storedToken,
+				Username:  credentials.Username,
+			}},
+		},
+	}, configFilePath)
+}
+
+var configPassphrase struct {
+	once       sync.Once
+	passphrase string
+	err        error
+}
+
+// ConfigPassphrase returns the passphrase of the encrypted config file tokens, read from the
+// LITMUSCTL_PASSPHRASE environment variable or prompted for without echo once per command
+func ConfigPassphrase() (string, error) {
+	configPassphrase.once.Do(func() {
+		if passphrase := os.Getenv(PassphraseEnv); passphrase != "" {
+			configPassphrase.passphrase = passphrase
+			return
+		}
+
+		if !term.IsTerminal(int(os.Stdin.Fd())) {
+			configPassphrase.err = errors.New("the config file tokens are encrypted, set the " + PassphraseEnv + " environment variable to decrypt them")
+			return
+		}
+
+		White_B.Fprint(os.Stderr, "\nConfig file passphrase: ")
+		passphrase, err := term.ReadPassword(int(os.Stdin.Fd()))
+		White_B.Fprintln(os.Stderr)
+		if err != nil {
+			configPassphrase.err = err
+			return
+		}
+		if len(passphrase) == 0 {
+			configPassphrase.err = errors.New("passphrase cannot be empty")
+			return
+		}
+		configPassphrase.passphrase = string(passphrase)
+	})
+	return configPassphrase.passphrase, configPassphrase.err
 }
 
 func PrintInJsonFormat(inf interface{}) {
@@ -149,3 +490,89 @@ func CheckKeyValueFormat(str string) bool {
 	}
 	return true
 }
+
+// GetMemberRole validates the role of a project member and returns it in the
+// format expected by the auth server. The owner role can't be assigned.
+func GetMemberRole(role string) (string, error) {
+	switch strings.ToLower(role) {
+	case "editor":
+		return "Editor", nil
+	case "viewer":
+		return "Viewer", nil
+	}
+	return "", errors.New("invalid role " + role + ", supported roles are editor/viewer")
+}
+
+// Cleanup undoes a temporary change made by a command, e.g. a temporary registration
+type Cleanup struct {
+	once sync.Once
+	undo func()
+}
+
+var (
+	interruptMu       sync.Mutex
+	interruptCleanups []*Cleanup
+)
+
+// OnInterrupt registers the undo function of a temporary change, it's run when the command is interrupted
+// with Ctrl-C / SIGTERM, see CommandContext. The command runs it with Run otherwise, it's only run once.
+func OnInterrupt(undo func()) *Cleanup {
+	cleanup := &Cleanup{undo: undo}
+	interruptMu.Lock()
+	defer interruptMu.Unlock()
+	interruptCleanups = append(interruptCleanups, cleanup)
+	return cleanup
+}
+
+// Run undoes the temporary change, unless it was undone already
+func (c *Cleanup) Run() {
+	c.once.Do(c.undo)
+}
+
+// runInterruptCleanups undoes the temporary changes, the latest first
+func runInterruptCleanups() {
+	interruptMu.Lock()
+	cleanups := append([]*Cleanup{}, interruptCleanups...)
+	interruptMu.Unlock()
+	for i := len(cleanups) - 1; i >= 0; i-- {
+		cleanups[i].Run()
+	}
+}
+
+// CommandContext returns a context which is cancelled on Ctrl-C / SIGTERM and,
+// if the command has a --timeout flag set, when the timeout expires.
+// The process still exits on Ctrl-C, so that blocking prompts can be interrupted.
+func CommandContext(cmd *cobra.Command) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var timeout time.Duration
+	if flag := cmd.Flags().Lookup("timeout"); flag != nil {
+		var err error
+		timeout, err = cmd.Flags().GetDuration("timeout")
+		PrintError(err)
+	}
+
+	cancelTimeout := func() {}
+	if timeout > 0 {
+		ctx, cancelTimeout = context.WithTimeout(ctx, timeout)
+	}
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+	go func() {
+		select {
+		case <-signals:
+			cancel()
+			Red.Println("\n✋ Interrupted")
+			runInterruptCleanups()
+			os.Exit(130)
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, func() {
+		signal.Stop(signals)
+		cancelTimeout()
+		cancel()
+	}
+}
@@ -1,23 +1,326 @@
 package utils
 
-var (
-	// CompatibilityMatrix to store the compatible versions of litmusctl and ChaosCenter
-	CompatibilityMatrix = map[string][]string{
-		"0.6.0":  {"2.2.0", "2.3.0"},
-		"0.7.0":  {"2.4.0", "2.5.0", "2.6.0", "2.7.0", "2.8.0"},
-		"0.8.0":  {"2.4.0", "2.5.0", "2.6.0", "2.7.0", "2.8.0"},
-		"0.9.0":  {"2.4.0", "2.5.0", "2.6.0", "2.7.0", "2.8.0"},
-		"0.10.0": {"2.9.0", "2.10.0", "2.11.0", "2.12.0", "2.13.0", "2.14.0", "3.0-beta1", "3.0.0-beta2", "3.0.0-beta3", "3.0.0-beta4", "3.0.0-beta5", "3.0.0-beta6", "3.0.0-beta7", "3.0.0-beta8"},
-		"0.11.0": {"2.9.0", "2.10.0", "2.11.0", "2.12.0", "2.13.0", "2.14.0", "3.0-beta1", "3.0.0-beta2", "3.0.0-beta3", "3.0.0-beta4", "3.0.0-beta5", "3.0.0-beta6", "3.0.0-beta7", "3.0.0-beta8"},
-		"0.12.0": {"2.9.0", "2.10.0", "2.11.0", "2.12.0", "2.13.0", "2.14.0", "3.0-beta1", "3.0.0-beta2", "3.0.0-beta3", "3.0.0-beta4", "3.0.0-beta5", "3.0.0-beta6", "3.0.0-beta7", "3.0.0-beta8"},
-		"0.13.0": {"2.9.0", "2.10.0", "2.11.0", "2.12.0", "2.13.0", "2.14.0", "3.0-beta1", "3.0.0-beta2", "3.0.0-beta3", "3.0.0-beta4", "3.0.0-beta5", "3.0.0-beta6", "3.0.0-beta7", "3.0.0-beta8"},
-		"0.14.0": {"2.9.0", "2.10.0", "2.11.0", "2.12.0", "2.13.0", "2.14.0", "3.0-beta1", "3.0.0-beta2", "3.0.0-beta3", "3.0.0-beta4", "3.0.0-beta5", "3.0.0-beta6", "3.0.0-beta7", "3.0.0-beta8"},
-		"0.15.0": {"2.9.0", "2.10.0", "2.11.0", "2.12.0", "2.13.0", "2.14.0", "3.0-beta1", "3.0.0-beta2", "3.0.0-beta3", "3.0.0-beta4", "3.0.0-beta5", "3.0.0-beta6", "3.0.0-beta7", "3.0.0-beta8"},
-		"0.16.0": {"2.9.0", "2.10.0", "2.11.0", "2.12.0", "2.13.0", "2.14.0", "3.0-beta1", "3.0.0-beta2", "3.0.0-beta3", "3.0.0-beta4", "3.0.0-beta5", "3.0.0-beta6", "3.0.0-beta7", "3.0.0-beta8"},
-		"0.17.0": {"2.9.0", "2.10.0", "2.11.0", "2.12.0", "2.13.0", "2.14.0", "3.0-beta1", "3.0.0-beta2", "3.0.0-beta3", "3.0.0-beta4", "3.0.0-beta5", "3.0.0-beta6", "3.0.0-beta7", "3.0.0-beta8"},
-		"0.18.0": {"2.9.0", "2.10.0", "2.11.0", "2.12.0", "2.13.0", "2.14.0", "3.0-beta1", "3.0.0-beta2", "3.0.0-beta3", "3.0.0-beta4", "3.0.0-beta5", "3.0.0-beta6", "3.0.0-beta7", "3.0.0-beta8"},
-		"0.19.0": {"2.9.0", "2.10.0", "2.11.0", "2.12.0", "2.13.0", "2.14.0", "3.0-beta1", "3.0.0-beta2", "3.0.0-beta3", "3.0.0-beta4", "3.0.0-beta5", "3.0.0-beta6", "3.0.0-beta7", "3.0.0-beta8"},
-		"0.20.0": {"2.9.0", "2.10.0", "2.11.0", "2.12.0", "2.13.0", "2.14.0", "3.0-beta1", "3.0.0-beta2", "3.0.0-beta3", "3.0.0-beta4", "3.0.0-beta5", "3.0.0-beta6", "3.0.0-beta7", "3.0.0-beta8"},
-		"0.21.0": {"2.9.0", "2.10.0", "2.11.0", "2.12.0", "2.13.0", "2.14.0", "3.0-beta1", "3.0.0-beta2", "3.0.0-beta3", "3.0.0-beta4", "3.0.0-beta5", "3.0.0-beta6", "3.0.0-beta7", "3.0.0-beta8"},
+import (
+	"crypto/ed25519"
+	_ "embed"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// CompatibilityMatrixURL is the compatibility matrix published with the litmusctl releases,
+	// the signature is published next to it with the .sig suffix
+	CompatibilityMatrixURL = "https://github.com/litmuschaos/litmusctl/releases/latest/download/compatibility-matrix-v2.json"
+
+	// CompatibilityMatrixTTL is the time the fetched compatibility matrix is cached for
+	CompatibilityMatrixTTL = 24 * time.Hour
+)
+
+// CompatibilityMatrixPublicKey is the base64 encoded ed25519 key the compatibility matrix is
+// signed with. It's set at build time, the remote matrix isn't used without it.
+var CompatibilityMatrixPublicKey string
+
+// embeddedCompatibilityMatrix is the compatibility matrix at the time of the release, used
+// when the remote one can't be fetched or verified
+//
+//go:embed compatibility.json
+var embeddedCompatibilityMatrix []byte
+
+// CompatibilityRule states the ChaosCenter versions supported by a range of litmusctl versions,
+// both as semver constraints, e.g. {"cli": "0.13.x", "chaosCenter": ">=2.9.0 <3.0.0"}
+type CompatibilityRule struct {
+	CLI         string `json:"cli"`
+	ChaosCenter string `json:"chaosCenter"`
+
+	cli         VersionConstraint
+	chaosCenter VersionConstraint
+}
+
+var compatibilityMatrix struct {
+	once  sync.Once
+	rules []CompatibilityRule
+}
+
+// CompatibilityMatrix returns the compatibility rules, ordered from the oldest to the latest litmusctl
+// versions. The matrix is read from the local cache, fetched from the latest release when the cache is
+// expired, and falls back to the one embedded at build time.
+func CompatibilityMatrix() []CompatibilityRule {
+	compatibilityMatrix.once.Do(func() {
+		data, err := cachedCompatibilityMatrix()
+		rules, parseErr := parseCompatibilityMatrix(data)
+		if err != nil || parseErr != nil {
+			// The embedded matrix is valid, it's checked when building the release
+			rules, _ = parseCompatibilityMatrix(embeddedCompatibilityMatrix)
+		}
+		compatibilityMatrix.rules = rules
+	})
+	return compatibilityMatrix.rules
+}
+
+// IsCompatible returns whether the litmusctl version supports the ChaosCenter version
+func IsCompatible(cliVersion string, serverVersion string) bool {
+	for _, rule := range CompatibilityMatrix() {
+		if rule.cli.Check(cliVersion) && rule.chaosCenter.Check(serverVersion) {
+			return true
+		}
+	}
+	return false
+}
+
+// CompatibleVersions returns the range of ChaosCenter versions compatible with the given litmusctl version
+func CompatibleVersions(cliVersion string) string {
+	var constraints []string
+	for _, rule := range CompatibilityMatrix() {
+		if rule.cli.Check(cliVersion) {
+			constraints = append(constraints, rule.ChaosCenter)
+		}
+	}
+	return strings.Join(constraints, " || ")
+}
+
+// CompatibleCLIVersions returns the ranges of litmusctl versions compatible with the given ChaosCenter version,
+// from the oldest to the latest
+func CompatibleCLIVersions(serverVersion string) []string {
+	var cliVersions []string
+	for _, rule := range CompatibilityMatrix() {
+		if rule.chaosCenter.Check(serverVersion) {
+			cliVersions = append(cliVersions, rule.CLI)
+		}
+	}
+	return cliVersions
+}
+
+// Deprecation describes a ChaosCenter version which isn't supported by the latest litmusctl releases
+type Deprecation struct {
+	Code             string `json:"code"`
+	ServerVersion    string `json:"serverVersion"`
+	LatestCLIVersion string `json:"latestCLIVersion"`
+	UpgradeTo        string `json:"upgradeTo,omitempty"`
+	Message          string `json:"message"`
+}
+
+// ServerDeprecation checks whether the ChaosCenter version is only supported by old
+// litmusctl releases, or isn't known at all, and returns the upgrade path
+func ServerDeprecation(serverVersion string) (Deprecation, bool) {
+	rules := CompatibilityMatrix()
+	if len(rules) == 0 {
+		return Deprecation{}, false
+	}
+	latest := rules[len(rules)-1]
+	if latest.chaosCenter.Check(serverVersion) {
+		return Deprecation{}, false
+	}
+
+	// The upgrade path is the lowest ChaosCenter version supported by the latest litmusctl releases
+	upgradeTo, _ := latest.chaosCenter.MinVersion()
+
+	deprecation := Deprecation{
+		Code:             "DeprecatedServerVersion",
+		ServerVersion:    serverVersion,
+		LatestCLIVersion: latest.CLI,
+		UpgradeTo:        upgradeTo,
+	}
+	if len(CompatibleCLIVersions(serverVersion)) > 0 {
+		deprecation.Message = "ChaosCenter " + serverVersion + " is only supported by older litmusctl releases."
+	} else {
+		deprecation.Message = "ChaosCenter " + serverVersion + " is not supported by any litmusctl release, it may have reached its end of life."
+	}
+	if upgradeTo != "" {
+		deprecation.Message += " Upgrade the ChaosCenter to " + upgradeTo + " or newer to use litmusctl " + latest.CLI + "."
+	}
+
+	return deprecation, true
+}
+
+// PrintDeprecationWarning warns about a deprecated ChaosCenter version, unless --quiet is set
+func PrintDeprecationWarning(serverVersion string) {
+	if Quiet {
+		return
+	}
+	if deprecation, ok := ServerDeprecation(serverVersion); ok {
+		Red.Fprintln(os.Stderr, "\n⚠️  Warning ["+deprecation.Code+"]: "+deprecation.Message)
+	}
+}
+
+// parseCompatibilityMatrix parses the compatibility rules and their constraints
+func parseCompatibilityMatrix(data []byte) ([]CompatibilityRule, error) {
+	var rules []CompatibilityRule
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return nil, err
+	}
+
+	for i := range rules {
+		var err error
+		if rules[i].cli, err = ParseVersionConstraint(rules[i].CLI); err != nil {
+			return nil, err
+		}
+		if rules[i].chaosCenter, err = ParseVersionConstraint(rules[i].ChaosCenter); err != nil {
+			return nil, err
+		}
+	}
+	return rules, nil
+}
+
+// cachedCompatibilityMatrix returns the cached compatibility matrix, refreshing the cache
+// from the latest release when it's expired
+func cachedCompatibilityMatrix() ([]byte, error) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return nil, err
+	}
+	cacheFile := filepath.Join(cacheDir, "litmusctl", "compatibility-matrix-v2.json")
+
+	var data []byte
+	info, err := os.Stat(cacheFile)
+	if err == nil {
+		data, err = ioutil.ReadFile(cacheFile)
+	}
+	if err != nil || time.Since(info.ModTime()) > CompatibilityMatrixTTL {
+		fetched, fetchErr := fetchCompatibilityMatrix()
+		switch {
+		case fetchErr == nil:
+			data = fetched
+			if err := os.MkdirAll(filepath.Dir(cacheFile), 0700); err == nil {
+				_ = ioutil.WriteFile(cacheFile, data, 0600)
+			}
+		case err != nil:
+			return nil, fetchErr
+		}
+		// An expired cache is still more recent than the embedded matrix
 	}
+
+	return data, nil
+}
+
+// fetchCompatibilityMatrix downloads the compatibility matrix of the latest release and verifies its signature
+func fetchCompatibilityMatrix() ([]byte, error) {
+	if CompatibilityMatrixPublicKey == "" {
+		return nil, errors.New("no public key to verify the compatibility matrix with")
+	}
+	publicKey, err := base64.StdEncoding.DecodeString(CompatibilityMatrixPublicKey)
+	if err != nil || len(publicKey) != ed25519.PublicKeySize {
+		return nil, errors.New("invalid public key of the compatibility matrix")
+	}
+
+	client := &http.Client{Transport: Transport, Timeout: 5 * time.Second}
+	data, err := download(client, CompatibilityMatrixURL)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := download(client, CompatibilityMatrixURL+".sig")
+	if err != nil {
+		return nil, err
+	}
+	signature, err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(signature)))
+	if err != nil {
+		return nil, err
+	}
+
+	if !ed25519.Verify(publicKey, data, signature) {
+		return nil, errors.New("invalid signature of the compatibility matrix")
+	}
+	return data, nil
+}
+
+// download fetches the URL, reusing the previous download when the server reports it's not modified
+func download(client *http.Client, url string) ([]byte, error) {
+	defer ProfileSpan("download", url)()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := ConditionalDownload(client, req, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to download %s: %s", url, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// ServerVersionCacheTTL is the time the version of the ChaosCenter of an account is cached for
+const ServerVersionCacheTTL = time.Hour
+
+var (
+	// ServerVersion fetches the version of the ChaosCenter, it's set by the root command
+	ServerVersion func(endpoint string) (string, error)
+
+	// StrictCompat turns the version skew warnings into errors, set by the --strict-compat flag
+	StrictCompat bool
 )
+
+type cachedServerVersion struct {
+	Version   string `json:"version"`
+	CheckedAt int64  `json:"checkedAt"`
+}
+
+// CheckVersionSkew warns when the ChaosCenter of the endpoint isn't supported by this litmusctl
+// version, or fails with --strict-compat. The version of the ChaosCenter is cached per endpoint,
+// and the check is skipped when it can't be fetched.
+func CheckVersionSkew(endpoint string) error {
+	cliVersion := os.Getenv("CLIVersion")
+	if cliVersion == "" {
+		// Development builds have no version to check
+		return nil
+	}
+
+	serverVersion, ok := serverVersionOf(endpoint)
+	if !ok || IsCompatible(cliVersion, serverVersion) {
+		return nil
+	}
+
+	message := "ChaosCenter " + serverVersion + " at " + endpoint + " is not supported by litmusctl " + cliVersion + ", supported versions are " + CompatibleVersions(cliVersion)
+	if StrictCompat {
+		return errors.New(message)
+	}
+	if !Quiet {
+		Red.Fprintln(os.Stderr, "⚠️  "+message)
+	}
+	return nil
+}
+
+// serverVersionOf returns the cached version of the ChaosCenter, refreshing it when it's expired
+func serverVersionOf(endpoint string) (string, bool) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", false
+	}
+	cacheFile := filepath.Join(cacheDir, "litmusctl", "server-versions.json")
+
+	versions := make(map[string]cachedServerVersion)
+	if data, err := ioutil.ReadFile(cacheFile); err == nil {
+		_ = json.Unmarshal(data, &versions)
+	}
+
+	cached, ok := versions[endpoint]
+	if ok && time.Since(time.Unix(cached.CheckedAt, 0)) < ServerVersionCacheTTL {
+		return cached.Version, true
+	}
+	if ServerVersion == nil {
+		return "", false
+	}
+
+	serverVersion, err := ServerVersion(endpoint)
+	if err != nil {
+		return "", false
+	}
+
+	versions[endpoint] = cachedServerVersion{Version: serverVersion, CheckedAt: time.Now().Unix()}
+	if data, err := json.Marshal(versions); err == nil {
+		if err := os.MkdirAll(filepath.Dir(cacheFile), 0700); err == nil {
+			_ = ioutil.WriteFile(cacheFile, data, 0600)
+		}
+	}
+	return serverVersion, true
+}
@@ -0,0 +1,69 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+// AddYesFlag registers the --yes flag skipping the confirmation of a destructive command
+func AddYesFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolP("yes", "y", false, "Set to true to skip the confirmation prompt, e.g. in automation")
+}
+
+// ConfirmDestructive lists the resources a delete or disconnect command is about to affect, and asks to
+// confirm it unless --yes is set. The command exits if it isn't confirmed, or if no answer can be given,
+// e.g. in automation without --yes.
+func ConfirmDestructive(cmd *cobra.Command, action string, affected []string) {
+	confirmDestructive(cmd, action, affected, func() (bool, error) {
+		return PromptConfirm("🤷 Do you want to continue?", false)
+	})
+}
+
+// ConfirmDestructiveByName is ConfirmDestructive for the resources which can't be recovered at all, e.g.
+// a project, the name of the resource has to be typed to confirm it
+func ConfirmDestructiveByName(cmd *cobra.Command, action string, affected []string, name string) {
+	confirmDestructive(cmd, action, affected, func() (bool, error) {
+		answer, err := PromptInput("Type "+name+" to confirm", "", nil)
+		return answer == name, err
+	})
+}
+
+func confirmDestructive(cmd *cobra.Command, action string, affected []string, confirm func() (bool, error)) {
+	yes, err := cmd.Flags().GetBool("yes")
+	PrintError(err)
+	if yes {
+		return
+	}
+
+	White_B.Println("\nThe following will be " + action + ":")
+	for _, item := range affected {
+		White.Println("- " + item)
+	}
+
+	confirmed, err := confirm()
+	if err == errInputEnded {
+		err = errors.New("the confirmation can't be read, set --yes to skip it")
+	}
+	PrintError(err)
+	if !confirmed {
+		Red.Println("✋ Exiting without any change!!")
+		os.Exit(1)
+	}
+}
@@ -18,15 +18,18 @@ package utils
 const (
 	DefaultFileName = ".litmusconfig"
 
+	// Environment variable overriding the path of the config file
+	LitmusConfigEnv = "LITMUSCONFIG"
+
+	// Config file path within the XDG config directory
+	XDGConfigFileName = "litmusctl/config"
+
 	// Default username
 	DefaultUsername = "admin"
 
 	// Default installation mode
 	DefaultMode = "cluster"
 
-	// Platform list
-	PlatformList = "1. AWS\n2. GKE\n3. Openshift\n4. Rancher\n5. Others"
-
 	// AWS identifier
 	AWSIdentifier = "aws://"
 
@@ -42,9 +45,21 @@ const (
 	// Label of subscriber agent being deployed
 	ChaosAgentLabel = "app=subscriber"
 
+	// Label of the chaos-operator deployment
+	ChaosOperatorLabel = "name=chaos-operator"
+
+	// Secret holding the credentials the subscriber registers with
+	ChaosAgentSecret = "agent-secret"
+
+	// Label of the ChaosCenter frontend service
+	ChaosCenterFrontendLabel = "component=litmusportal-frontend"
+
 	// Agent type is "external" for agents connected via litmusctl
 	AgentType = "external"
 
+	// Minimum Kubernetes version supported by the Chaos Delegate
+	MinKubernetesVersion = "v1.17.0"
+
 	// Default namespace for agent installation
 	DefaultNs = "litmus"
 
@@ -61,4 +76,39 @@ const (
 
 	// Auth server api path
 	AuthAPIPath = "/auth"
+
+	// Default ChaosHub available in every project
+	DefaultHubName = "Litmus ChaosHub"
+
+	// Default image registry and repository used by the Chaos Scenarios
+	DefaultImageRegistry = "docker.io"
+	DefaultImageRepo     = "litmuschaos"
+
+	// Environment variable holding the passphrase of the encrypted config file tokens
+	PassphraseEnv = "LITMUSCTL_PASSPHRASE"
+
+	// Environment variables overriding the endpoint and token of the config file, like --endpoint and --token
+	EndpointEnv = "LITMUSCTL_ENDPOINT"
+	TokenEnv    = "LITMUSCTL_TOKEN"
+
+	// Flag annotation which excludes a flag from the per-account defaults
+	NoAccountDefaultsAnnotation = "litmusctl/no-account-defaults"
+
+	// Release channels of the Chaos Delegate images
+	StableChannel = "stable"
+	CIChannel     = "ci"
+	EdgeChannel   = "edge"
+
+	// Image of the Chaos Delegate component whose tag is the release of the manifest
+	SubscriberImage = "litmusportal-subscriber"
 )
+
+// ChannelImageTags are the image tags of the release channels, the stable channel keeps the
+// release of the ChaosCenter
+var ChannelImageTags = map[string]string{
+	CIChannel:   "ci",
+	EdgeChannel: "latest",
+}
+
+// PlatformList are the platforms a Chaos Delegate can be connected on, DefaultPlatform is the last one
+var PlatformList = []string{"AWS", "GKE", "Openshift", "Rancher", DefaultPlatform}
@@ -0,0 +1,195 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+// VersionConstraint is a semver range like ">=2.9.0 <3.0.0 || 3.1.x". The comparators of an
+// alternative are separated by spaces and all have to match, the alternatives are separated by "||".
+// Supported comparators are =, >, >=, <, <= and x or * wildcards, e.g. "2.14.x" or "3.*".
+// Like in node-semver, a prerelease only satisfies an alternative naming a prerelease of the same
+// version, so that e.g. "<3.0.0" and "2.14.x" don't admit 3.0.0-beta1 and 2.15.0-rc.1, while
+// ">=3.0.0-beta1 <=3.0.0-beta8" admits 3.0.0-beta5.
+type VersionConstraint struct {
+	raw          string
+	alternatives [][]comparator
+}
+
+type comparator struct {
+	op      string
+	version *version.Version
+}
+
+// ParseVersionConstraint parses the semver range
+func ParseVersionConstraint(constraint string) (VersionConstraint, error) {
+	parsed := VersionConstraint{raw: constraint}
+	for _, alternative := range strings.Split(constraint, "||") {
+		var comparators []comparator
+		for _, field := range strings.Fields(alternative) {
+			fieldComparators, err := parseComparator(field)
+			if err != nil {
+				return VersionConstraint{}, errors.New("invalid version constraint " + constraint + ": " + err.Error())
+			}
+			comparators = append(comparators, fieldComparators...)
+		}
+		if len(comparators) == 0 {
+			return VersionConstraint{}, errors.New("invalid version constraint " + constraint + ": empty alternative")
+		}
+		parsed.alternatives = append(parsed.alternatives, comparators)
+	}
+	return parsed, nil
+}
+
+// Check returns whether the version satisfies the constraint, versions which can't be parsed never do
+func (c VersionConstraint) Check(v string) bool {
+	parsed, err := ParseVersion(v)
+	if err != nil {
+		return false
+	}
+
+	for _, alternative := range c.alternatives {
+		if parsed.PreRelease() != "" && !namesPrerelease(alternative, parsed) {
+			continue
+		}
+		matches := true
+		for _, comp := range alternative {
+			matches = matches && comp.check(parsed)
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+// MinVersion returns the lowest version allowed by the constraint, when it has a lower bound
+func (c VersionConstraint) MinVersion() (string, bool) {
+	var min *version.Version
+	for _, alternative := range c.alternatives {
+		for _, comp := range alternative {
+			if (comp.op == ">=" || comp.op == "=") && (min == nil || comp.version.LessThan(min)) {
+				min = comp.version
+			}
+		}
+	}
+	if min == nil {
+		return "", false
+	}
+	return min.String(), true
+}
+
+func (c VersionConstraint) String() string {
+	return c.raw
+}
+
+// ParseVersion parses a semantic version, tolerating missing minor or patch components
+// like in "3.0-beta1"
+func ParseVersion(v string) (*version.Version, error) {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	core, suffix := v, ""
+	if i := strings.IndexAny(v, "-+"); i != -1 {
+		core, suffix = v[:i], v[i:]
+	}
+	for strings.Count(core, ".") < 2 {
+		core += ".0"
+	}
+	return version.ParseSemantic(core + suffix)
+}
+
+// namesPrerelease returns whether a comparator of the alternative is a prerelease of the same
+// major, minor and patch version as v
+func namesPrerelease(alternative []comparator, v *version.Version) bool {
+	for _, comp := range alternative {
+		if comp.version.PreRelease() != "" && comp.version.Major() == v.Major() && comp.version.Minor() == v.Minor() && comp.version.Patch() == v.Patch() {
+			return true
+		}
+	}
+	return false
+}
+
+func parseComparator(field string) ([]comparator, error) {
+	op := "="
+	for _, prefix := range []string{">=", "<=", ">", "<", "="} {
+		if strings.HasPrefix(field, prefix) {
+			op, field = prefix, strings.TrimPrefix(field, prefix)
+			break
+		}
+	}
+
+	// Wildcards are ranges from the given version to the next one, e.g. 2.14.x is >=2.14.0 <2.15.0
+	components := strings.Split(field, ".")
+	for i, component := range components {
+		if component != "x" && component != "X" && component != "*" {
+			continue
+		}
+		if op != "=" {
+			return nil, errors.New("wildcards can't be combined with " + op)
+		}
+		if i == 0 {
+			// Any version
+			lower, _ := ParseVersion("0.0.0")
+			return []comparator{{op: ">=", version: lower}}, nil
+		}
+
+		lower, err := ParseVersion(strings.Join(components[:i], "."))
+		if err != nil {
+			return nil, err
+		}
+		last, err := strconv.Atoi(components[i-1])
+		if err != nil {
+			return nil, err
+		}
+		upperComponents := append(append([]string{}, components[:i-1]...), strconv.Itoa(last+1))
+		upper, err := ParseVersion(strings.Join(upperComponents, "."))
+		if err != nil {
+			return nil, err
+		}
+		return []comparator{{op: ">=", version: lower}, {op: "<", version: upper}}, nil
+	}
+
+	v, err := ParseVersion(field)
+	if err != nil {
+		return nil, err
+	}
+	return []comparator{{op: op, version: v}}, nil
+}
+
+func (c comparator) check(v *version.Version) bool {
+	var cmp int
+	if v.LessThan(c.version) {
+		cmp = -1
+	} else if c.version.LessThan(v) {
+		cmp = 1
+	}
+	switch c.op {
+	case ">=":
+		return cmp >= 0
+	case ">":
+		return cmp > 0
+	case "<=":
+		return cmp <= 0
+	case "<":
+		return cmp < 0
+	default:
+		return cmp == 0
+	}
+}
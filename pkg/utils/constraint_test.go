@@ -0,0 +1,79 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import "testing"
+
+func TestVersionConstraintCheck(t *testing.T) {
+	tests := []struct {
+		constraint string
+		version    string
+		want       bool
+	}{
+		{">=2.9.0 <2.15.0", "2.9.0", true},
+		{">=2.9.0 <2.15.0", "2.14.2", true},
+		{">=2.9.0 <2.15.0", "2.15.0", false},
+		{">=2.9.0 <2.15.0", "2.8.9", false},
+		{">=2.9.0 <2.15.0", "v2.10", true},
+		{"2.14.x", "2.14.0", true},
+		{"2.14.x", "2.14.9", true},
+		{"2.14.x", "2.15.0", false},
+		{"3.*", "3.9.1", true},
+		{"3.*", "4.0.0", false},
+		{"*", "0.1.0", true},
+		{"=2.4.0", "2.4.0", true},
+		{">2.4.0", "2.4.0", false},
+		{"<=2.4.0", "2.4.0", true},
+		{"0.6.x || >=1.0.0 <1.2.0", "1.1.0", true},
+		{"0.6.x || >=1.0.0 <1.2.0", "0.7.0", false},
+		{">=2.9.0", "invalid", false},
+
+		// Prereleases of an upper bound are excluded, as they sort below their release
+		{"<2.15.0", "2.15.0-rc.1", false},
+		{"2.14.x", "2.15.0-rc.1", false},
+		{"<3.0.0", "3.0.0-beta1", false},
+		{">=2.9.0 <3.0.0", "3.0.0-beta8", false},
+		{">=2.9.0 <3.0.0", "2.10.0-rc1", false},
+
+		// unless the constraint names a prerelease of the same version
+		{">=3.0.0-beta1 <=3.0.0-beta8", "3.0.0-beta1", true},
+		{">=3.0.0-beta1 <=3.0.0-beta8", "3.0.0-beta5", true},
+		{">=3.0.0-beta1 <=3.0.0-beta8", "3.0.0-beta9", false},
+		{">=3.0.0-beta1 <=3.0.0-beta8", "3.0.0", false},
+		{">=2.9.0 <2.15.0 || >=3.0.0-beta1 <=3.0.0-beta8", "3.0.0-beta3", true},
+		{">=2.9.0 <2.15.0 || >=3.0.0-beta1 <=3.0.0-beta8", "2.15.0-rc.1", false},
+		{"<3.0.0-beta1", "3.0.0-alpha", true},
+		{">=3.0.0-beta1", "3.1.0-beta1", false},
+	}
+
+	for _, test := range tests {
+		constraint, err := ParseVersionConstraint(test.constraint)
+		if err != nil {
+			t.Fatalf("ParseVersionConstraint(%q): %v", test.constraint, err)
+		}
+		if got := constraint.Check(test.version); got != test.want {
+			t.Errorf("%q.Check(%q) = %v, want %v", test.constraint, test.version, got, test.want)
+		}
+	}
+}
+
+func TestParseVersionConstraintErrors(t *testing.T) {
+	for _, constraint := range []string{"", "||", ">=2.x", "2.a.x", ">=not-a-version"} {
+		if _, err := ParseVersionConstraint(constraint); err == nil {
+			t.Errorf("ParseVersionConstraint(%q) succeeded, want an error", constraint)
+		}
+	}
+}
@@ -0,0 +1,271 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// Styles of RenderDiff
+const (
+	UnifiedDiff    = "unified"
+	SideBySideDiff = "side-by-side"
+)
+
+var (
+	diffAdded   = color.New(color.FgGreen)
+	diffRemoved = color.New(color.FgRed)
+	diffChanged = color.New(color.FgYellow)
+	diffHunk    = color.New(color.FgCyan)
+	yamlKey     = color.New(color.FgBlue)
+	yamlComment = color.New(color.FgHiBlack)
+)
+
+// DiffFile is the diff of a file, or of a resource for kubectl diff
+type DiffFile struct {
+	Name  string
+	Hunks []DiffHunk
+}
+
+// DiffHunk is a hunk of a unified diff, starting at its @@ header
+type DiffHunk struct {
+	Header string
+	Lines  []DiffLine
+}
+
+// DiffLine is a line of a hunk, its Kind is ' ' for the context lines, '-' for the removed ones and '+' for the added ones
+type DiffLine struct {
+	Kind byte
+	Text string
+}
+
+// ParseUnifiedDiff parses the output of diff -u, including the one of kubectl diff. The files are named
+// after the last element of their path, e.g. apps.v1.Deployment.litmus.subscriber for kubectl diff.
+func ParseUnifiedDiff(diff string) []DiffFile {
+	var files []DiffFile
+	var file *DiffFile
+	var hunk *DiffHunk
+	newFile := func(name string) {
+		files = append(files, DiffFile{Name: name})
+		file, hunk = &files[len(files)-1], nil
+	}
+
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n") {
+		switch {
+		case strings.HasPrefix(line, "diff "):
+			fields := strings.Fields(line)
+			newFile(filepath.Base(fields[len(fields)-1]))
+		case strings.HasPrefix(line, "--- ") && hunk == nil:
+			if file == nil {
+				newFile(filepath.Base(strings.Fields(line[4:])[0]))
+			}
+		case strings.HasPrefix(line, "+++ ") && hunk == nil:
+		case strings.HasPrefix(line, "@@"):
+			if file == nil {
+				newFile("")
+			}
+			file.Hunks = append(file.Hunks, DiffHunk{Header: line})
+			hunk = &file.Hunks[len(file.Hunks)-1]
+		case hunk == nil || strings.HasPrefix(line, `\`):
+			// Lines outside of the hunks and "\ No newline at end of file"
+		case line == "":
+			hunk.Lines = append(hunk.Lines, DiffLine{Kind: ' '})
+		default:
+			hunk.Lines = append(hunk.Lines, DiffLine{Kind: line[0], Text: line[1:]})
+		}
+	}
+	return files
+}
+
+// Stats returns the number of added and removed lines of the file
+func (f DiffFile) Stats() (added int, removed int) {
+	for _, hunk := range f.Hunks {
+		for _, line := range hunk.Lines {
+			switch line.Kind {
+			case '+':
+				added++
+			case '-':
+				removed++
+			}
+		}
+	}
+	return added, removed
+}
+
+// diffRow is a row of the rendered diff, pairing a removed line with the added line replacing it
+type diffRow struct {
+	old, new *DiffLine
+}
+
+// rows pairs the removed lines of the hunk with the lines added right after them, as changed lines
+func (h DiffHunk) rows() []diffRow {
+	var rows []diffRow
+	for i := 0; i < len(h.Lines); {
+		line := &h.Lines[i]
+		if line.Kind == ' ' {
+			rows = append(rows, diffRow{old: line, new: line})
+			i++
+			continue
+		}
+
+		var removed, added []*DiffLine
+		for ; i < len(h.Lines) && h.Lines[i].Kind == '-'; i++ {
+			removed = append(removed, &h.Lines[i])
+		}
+		for ; i < len(h.Lines) && h.Lines[i].Kind == '+'; i++ {
+			added = append(added, &h.Lines[i])
+		}
+		for j := 0; j < len(removed) || j < len(added); j++ {
+			var row diffRow
+			if j < len(removed) {
+				row.old = removed[j]
+			}
+			if j < len(added) {
+				row.new = added[j]
+			}
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// RenderDiff renders the unified diff in the given style, with the added, removed and changed lines marked
+// and colored, and the keys of the YAML context lines highlighted. The side-by-side columns fit the width.
+func RenderDiff(w io.Writer, diff string, style string, width int) error {
+	if style != UnifiedDiff && style != SideBySideDiff {
+		return fmt.Errorf("unknown diff style %s, supported styles are %s/%s", style, UnifiedDiff, SideBySideDiff)
+	}
+
+	for _, file := range ParseUnifiedDiff(diff) {
+		added, removed := file.Stats()
+		White_B.Fprintf(w, "\n%s ", file.Name)
+		diffAdded.Fprintf(w, "+%d ", added)
+		diffRemoved.Fprintf(w, "-%d\n", removed)
+
+		for _, hunk := range file.Hunks {
+			diffHunk.Fprintln(w, hunk.Header)
+			for _, row := range hunk.rows() {
+				if style == UnifiedDiff {
+					renderUnifiedRow(w, row)
+				} else {
+					renderSideBySideRow(w, row, width)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// renderUnifiedRow renders a changed YAML value on a single line, e.g. ~ image: v1 → v2
+func renderUnifiedRow(w io.Writer, row diffRow) {
+	switch {
+	case row.old == row.new:
+		fmt.Fprintln(w, "  "+highlightYAML(row.old.Text))
+	case row.old != nil && row.new != nil && yamlKeyOf(row.old.Text) != "" && yamlKeyOf(row.old.Text) == yamlKeyOf(row.new.Text):
+		key := yamlKeyOf(row.old.Text)
+		diffChanged.Fprintln(w, "~ "+key+" "+yamlValueOf(row.old.Text)+" → "+yamlValueOf(row.new.Text))
+	default:
+		if row.old != nil {
+			diffRemoved.Fprintln(w, "- "+row.old.Text)
+		}
+		if row.new != nil {
+			diffAdded.Fprintln(w, "+ "+row.new.Text)
+		}
+	}
+}
+
+// renderSideBySideRow renders the old line on the left and the new one on the right, with a marker
+// between them: < removed, > added, | changed
+func renderSideBySideRow(w io.Writer, row diffRow, width int) {
+	column := (width - 3) / 2
+	if column < 10 {
+		column = 10
+	}
+
+	var left, right string
+	if row.old != nil {
+		left = fitColumn(row.old.Text, column)
+	}
+	if row.new != nil {
+		right = fitColumn(row.new.Text, column)
+	}
+	padding := strings.Repeat(" ", column-len([]rune(left)))
+
+	switch {
+	case row.old == row.new:
+		fmt.Fprintln(w, highlightYAML(left)+padding+"   "+highlightYAML(right))
+	case row.new == nil:
+		diffRemoved.Fprintln(w, left+padding+" < ")
+	case row.old == nil:
+		fmt.Fprintln(w, strings.Repeat(" ", column)+diffAdded.Sprint(" > "+right))
+	default:
+		fmt.Fprintln(w, diffRemoved.Sprint(left+padding)+diffChanged.Sprint(" | ")+diffAdded.Sprint(right))
+	}
+}
+
+// fitColumn expands the tabs of the line, and cuts it to the width of the column
+func fitColumn(text string, column int) string {
+	runes := []rune(strings.ReplaceAll(text, "\t", "    "))
+	if len(runes) > column {
+		return string(runes[:column-1]) + "…"
+	}
+	return string(runes)
+}
+
+// highlightYAML colors the key and the comment of a YAML line
+func highlightYAML(text string) string {
+	trimmed := strings.TrimLeft(text, " ")
+	indent := text[:len(text)-len(trimmed)]
+	if strings.HasPrefix(trimmed, "#") {
+		return indent + yamlComment.Sprint(trimmed)
+	}
+	if strings.HasPrefix(trimmed, "- ") {
+		indent += "- "
+		trimmed = trimmed[2:]
+	}
+	if key := yamlKeyOf(trimmed); key != "" {
+		return indent + yamlKey.Sprint(key) + strings.TrimPrefix(trimmed, key)
+	}
+	return text
+}
+
+// yamlKeyOf returns the key of a YAML line with its colon, e.g. "image:", or an empty string if it has no key
+func yamlKeyOf(text string) string {
+	trimmed := strings.TrimLeft(text, " ")
+	trimmed = strings.TrimPrefix(trimmed, "- ")
+	end := strings.Index(trimmed, ": ")
+	if end < 0 {
+		if !strings.HasSuffix(trimmed, ":") {
+			return ""
+		}
+		end = len(trimmed) - 1
+	}
+	key := trimmed[:end]
+	if key == "" || strings.ContainsAny(key, " \"'{[#") {
+		return ""
+	}
+	return text[:len(text)-len(trimmed)] + key + ":"
+}
+
+// yamlValueOf returns the value of a YAML line with a key
+func yamlValueOf(text string) string {
+	return strings.TrimSpace(strings.TrimPrefix(text, yamlKeyOf(text)))
+}
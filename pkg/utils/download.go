@@ -0,0 +1,150 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+)
+
+// cachedDownloadHeaders are the headers of a download kept in the cache, to validate the cached body
+var cachedDownloadHeaders = []string{"Content-Type", "X-Checksum-Sha256", "Digest"}
+
+// Download is the response of a conditional download
+type Download struct {
+	StatusCode int
+	Status     string
+	Header     http.Header
+	Body       []byte
+
+	// NotModified is set when the server answered 304 Not Modified, and the body was read from the cache
+	NotModified bool
+}
+
+type downloadCacheEntry struct {
+	ETag         string      `json:"etag,omitempty"`
+	LastModified string      `json:"lastModified,omitempty"`
+	Header       http.Header `json:"header"`
+	Body         []byte      `json:"body"`
+}
+
+// ConditionalDownload sends the GET request with the If-None-Match and If-Modified-Since validators of the
+// previous download of the URL, and serves the cached body when the server answers 304 Not Modified. The
+// successful downloads carrying an ETag or a Last-Modified header are cached. The progress of the
+// download is shown with the description, unless it's empty. The body is kept on disk, so it's only for
+// downloads which hold no secrets, e.g. the compatibility matrix.
+func ConditionalDownload(client *http.Client, req *http.Request, description string) (Download, error) {
+	cacheFile, cacheable := downloadCacheFile(req.URL.String())
+
+	var entry downloadCacheEntry
+	cached := false
+	if cacheable {
+		if data, err := ioutil.ReadFile(cacheFile); err == nil && json.Unmarshal(data, &entry) == nil {
+			cached = true
+			if entry.ETag != "" {
+				req.Header.Set("If-None-Match", entry.ETag)
+			}
+			if entry.LastModified != "" {
+				req.Header.Set("If-Modified-Since", entry.LastModified)
+			}
+		}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return Download{}, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified && cached {
+		// The server may send updated validators with the 304 response
+		if etag := resp.Header.Get("ETag"); etag != "" {
+			entry.ETag = etag
+		}
+		saveDownload(cacheFile, entry)
+		return Download{StatusCode: http.StatusOK, Status: "200 OK", Header: entry.Header, Body: entry.Body, NotModified: true}, nil
+	}
+
+	var reader io.Reader = resp.Body
+	if description != "" {
+		reader = NewProgressReader(resp.Body, resp.ContentLength, description)
+	}
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return Download{}, err
+	}
+	download := Download{StatusCode: resp.StatusCode, Status: resp.Status, Header: resp.Header, Body: body}
+
+	etag, lastModified := resp.Header.Get("ETag"), resp.Header.Get("Last-Modified")
+	if cacheable && resp.StatusCode == http.StatusOK && (etag != "" || lastModified != "") {
+		entry = downloadCacheEntry{ETag: etag, LastModified: lastModified, Header: make(http.Header), Body: body}
+		for _, key := range cachedDownloadHeaders {
+			if value := resp.Header.Get(key); value != "" {
+				entry.Header.Set(key, value)
+			}
+		}
+		saveDownload(cacheFile, entry)
+	}
+
+	return download, nil
+}
+
+// downloadCacheFile returns the cache file of the URL. The URL is hashed, as it may carry a token.
+func downloadCacheFile(url string) (string, bool) {
+	cacheDir, err := os.UserCacheDir()
+	if err != nil {
+		return "", false
+	}
+	key := sha256.Sum256([]byte(url))
+	return filepath.Join(cacheDir, "litmusctl", "downloads", hex.EncodeToString(key[:])+".json"), true
+}
+
+// RemoveCachedDownloads deletes the cached downloads whose body matches, e.g. the ones holding secrets
+// which were cached by an earlier version
+func RemoveCachedDownloads(matches func(body []byte) bool) {
+	cacheFile, ok := downloadCacheFile("")
+	if !ok {
+		return
+	}
+	cacheDir := filepath.Dir(cacheFile)
+	files, err := ioutil.ReadDir(cacheDir)
+	if err != nil {
+		return
+	}
+	for _, file := range files {
+		path := filepath.Join(cacheDir, file.Name())
+		var entry downloadCacheEntry
+		if data, err := ioutil.ReadFile(path); err == nil && json.Unmarshal(data, &entry) == nil && matches(entry.Body) {
+			_ = os.Remove(path)
+		}
+	}
+}
+
+func saveDownload(cacheFile string, entry downloadCacheEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(cacheFile), 0700); err == nil {
+		_ = ioutil.WriteFile(cacheFile, data, 0600)
+	}
+}
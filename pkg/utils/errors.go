@@ -0,0 +1,117 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+// errorTranslation maps the errors of the ChaosCenter matching the pattern to an actionable message
+type errorTranslation struct {
+	pattern *regexp.Regexp
+	message string
+	hint    string
+}
+
+// errorTranslations are the common errors of the ChaosCenter, the first matching one is used. The
+// patterns avoid matching the errors of the local system, e.g. a file which isn't found.
+var errorTranslations = []errorTranslation{
+	{
+		pattern: regexp.MustCompile(`(?i)unauthori[sz]ed|invalid[_ ]token|token is expired|signature is invalid|invalid_grant`),
+		message: "The session of the account is not valid anymore",
+		hint:    "Log in again with: litmusctl config set-account",
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)permission_denied|access denied|doesn't have .*access`),
+		message: "Your role in the project doesn't allow this operation",
+		hint:    "Check the members of the project and their roles with: litmusctl get project-members --project-id=<project-id>",
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)project.*not found|invalid project|no project`),
+		message: "The project was not found",
+		hint:    "List your projects with: litmusctl get projects",
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)(cluster|infra|agent|delegate)\w*.*(not active|inactive|not connected|isn't active)`),
+		message: "The Chaos Delegate is not active",
+		hint:    "Check its status with: litmusctl get chaos-delegates --project-id=<project-id>",
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)duplicate key|already exists|multiple write errors`),
+		message: "A resource with the same name already exists",
+		hint:    "Use another name, or list the existing resources with litmusctl get",
+	},
+	{
+		pattern: regexp.MustCompile(`(?i)mongo: no documents in result|(workflow|experiment|scenario|environment|probe|hub|member|user|invitation|infra|cluster)\w* not found`),
+		message: "The resource was not found",
+		hint:    "Check the IDs with litmusctl get",
+	},
+}
+
+// TranslateError returns an actionable message for the common errors of the ChaosCenter, along with
+// the next command to run. The message of the error is returned as is when it isn't known.
+func TranslateError(err error) (message string, hint string) {
+	serverMessage := serverErrorMessage(err.Error())
+	for _, translation := range errorTranslations {
+		// The error codes of the JSON errors are matched too, only their descriptions are printed
+		if translation.pattern.MatchString(err.Error()) {
+			return translation.message + " (" + serverMessage + ")", translation.hint
+		}
+	}
+	return serverMessage, ""
+}
+
+// serverErrorMessage extracts the message from the JSON errors of the auth and GraphQL servers,
+// which are otherwise printed as is
+func serverErrorMessage(message string) string {
+	start := strings.Index(message, "{")
+	if start < 0 {
+		return message
+	}
+
+	var body struct {
+		Error            string `json:"error"`
+		ErrorDescription string `json:"errorDescription"`
+		Message          string `json:"message"`
+		Errors           []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if json.Unmarshal([]byte(message[start:]), &body) != nil {
+		return message
+	}
+
+	prefix := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(message[:start]), ":"))
+	switch {
+	case len(body.Errors) > 0:
+		message = body.Errors[0].Message
+	case body.ErrorDescription != "":
+		message = body.ErrorDescription
+	case body.Error != "":
+		message = body.Error
+	case body.Message != "":
+		message = body.Message
+	default:
+		return message
+	}
+	// The prefixes of the status code mismatches aren't meaningful to the users
+	if prefix != "" && !strings.HasPrefix(prefix, "Unmatched status code") {
+		message = prefix + ": " + message
+	}
+	return message
+}
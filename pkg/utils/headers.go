@@ -0,0 +1,127 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"errors"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// HeaderFlags are the headers passed with --header, as "Name: value"
+var HeaderFlags []string
+
+// reservedHeaders are set by litmusctl itself and can't be overridden
+var reservedHeaders = []string{"Authorization", "Content-Type", "Content-Length", "Host", RequestIDHeader}
+
+// customHeaders are the headers sent with the requests, per host of the ChaosCenter. The
+// headers of --header are stored under the empty host, and sent with every request.
+var customHeaders = make(map[string]http.Header)
+
+// ParseHeader parses a header in the "Name: value" format
+func ParseHeader(header string) (string, string, error) {
+	parts := strings.SplitN(header, ":", 2)
+	if len(parts) != 2 {
+		return "", "", errors.New("invalid header " + header + ", expected \"Name: value\"")
+	}
+	name, value := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+	if err := ValidateHeader(name, value); err != nil {
+		return "", "", err
+	}
+	return textproto.CanonicalMIMEHeaderKey(name), value, nil
+}
+
+// ValidateHeader checks that the header can be sent, and isn't one of the headers set by litmusctl
+func ValidateHeader(name string, value string) error {
+	if name == "" || strings.ContainsAny(name, " \t\r\n\"(),/:;<=>?@[\\]{}") {
+		return errors.New("invalid header name " + name)
+	}
+	if strings.ContainsAny(value, "\r\n") {
+		return errors.New("invalid value of the header " + name + ", it can't contain line breaks")
+	}
+	for _, reserved := range reservedHeaders {
+		if strings.EqualFold(name, reserved) {
+			return errors.New("the header " + name + " is set by litmusctl and can't be overridden")
+		}
+	}
+	return nil
+}
+
+// LoadCustomHeaders reads the headers of --header, and the headers stored in the config file for
+// each account, which are only sent to the ChaosCenter of the account
+func LoadCustomHeaders(cmd *cobra.Command) error {
+	customHeaders = make(map[string]http.Header)
+
+	if obj, ok := optionalConfig(cmd); ok {
+		for _, account := range obj.Accounts {
+			if len(account.Headers) == 0 {
+				continue
+			}
+			host := endpointHost(account.Endpoint)
+			if host == "" {
+				continue
+			}
+			if customHeaders[host] == nil {
+				customHeaders[host] = make(http.Header)
+			}
+			for name, value := range account.Headers {
+				if err := ValidateHeader(name, value); err != nil {
+					return errors.New("invalid header of the account " + account.Endpoint + ": " + err.Error())
+				}
+				customHeaders[host].Set(name, value)
+			}
+		}
+	}
+
+	for _, header := range HeaderFlags {
+		name, value, err := ParseHeader(header)
+		if err != nil {
+			return err
+		}
+		if customHeaders[""] == nil {
+			customHeaders[""] = make(http.Header)
+		}
+		customHeaders[""].Add(name, value)
+	}
+	return nil
+}
+
+// setCustomHeaders attaches the headers of the account of the URL, and the headers of --header,
+// which take precedence over the ones stored in the config file
+func setCustomHeaders(header http.Header, rawURL string) {
+	hosts := []string{""}
+	if host := endpointHost(rawURL); host != "" {
+		hosts = []string{host, ""}
+	}
+	for _, host := range hosts {
+		for name, values := range customHeaders[host] {
+			header[name] = append([]string(nil), values...)
+		}
+	}
+}
+
+// endpointHost returns the host and port of the URL, which is the same for the http and websocket URLs
+func endpointHost(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(u.Host)
+}
@@ -0,0 +1,100 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/url"
+
+	"github.com/litmuschaos/litmusctl/pkg/types"
+)
+
+// ParseProbeManifest reads the resilience probe manifest that is passed as an
+// argument and populates the payload for the addProbe API request. The manifest
+// can be either a local file or a remote file.
+func ParseProbeManifest(file string, probeRequest *types.ProbeRequest) error {
+	var body []byte
+	var err error
+
+	// Read the manifest file.
+	parsedURL, ok := url.ParseRequestURI(file)
+	if ok != nil || !(parsedURL.Scheme == "http" || parsedURL.Scheme == "https") {
+		body, err = ioutil.ReadFile(file)
+	} else {
+		body, err = ReadRemoteFile(file)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = UnmarshalObject(body, probeRequest)
+	if err != nil {
+		return err
+	}
+
+	if probeRequest.Name == "" {
+		return errors.New("No name provided for the Resilience Probe.")
+	}
+
+	// Probes connected via litmusctl always target Kubernetes infrastructures
+	if probeRequest.InfrastructureType == "" {
+		probeRequest.InfrastructureType = "Kubernetes"
+	}
+
+	return ValidateProbeProperties(*probeRequest)
+}
+
+// ValidateProbeProperties checks that the properties of the given probe type are present
+func ValidateProbeProperties(probeRequest types.ProbeRequest) error {
+	switch probeRequest.Type {
+	case types.HTTPProbe:
+		if probeRequest.KubernetesHTTPProperties == nil {
+			return errors.New("kubernetesHTTPProperties are required for " + string(types.HTTPProbe))
+		}
+		if probeRequest.KubernetesHTTPProperties.URL == "" || probeRequest.KubernetesHTTPProperties.Method == nil {
+			return errors.New("url and method are required for " + string(types.HTTPProbe))
+		}
+	case types.CMDProbe:
+		if probeRequest.KubernetesCMDProperties == nil {
+			return errors.New("kubernetesCMDProperties are required for " + string(types.CMDProbe))
+		}
+		if probeRequest.KubernetesCMDProperties.Command == "" || probeRequest.KubernetesCMDProperties.Comparator == nil {
+			return errors.New("command and comparator are required for " + string(types.CMDProbe))
+		}
+	case types.PrometheusProbe:
+		if probeRequest.PromProperties == nil {
+			return errors.New("promProperties are required for " + string(types.PrometheusProbe))
+		}
+		if probeRequest.PromProperties.Endpoint == "" || probeRequest.PromProperties.Comparator == nil {
+			return errors.New("endpoint and comparator are required for " + string(types.PrometheusProbe))
+		}
+		if probeRequest.PromProperties.Query == nil && probeRequest.PromProperties.QueryPath == nil {
+			return errors.New("either query or queryPath is required for " + string(types.PrometheusProbe))
+		}
+	case types.K8sProbe:
+		if probeRequest.K8sProperties == nil {
+			return errors.New("k8sProperties are required for " + string(types.K8sProbe))
+		}
+		if probeRequest.K8sProperties.Version == "" || probeRequest.K8sProperties.Resource == "" || probeRequest.K8sProperties.Operation == "" {
+			return errors.New("version, resource and operation are required for " + string(types.K8sProbe))
+		}
+	default:
+		return errors.New("Invalid probe type " + string(probeRequest.Type) + ". Supported types: httpProbe, cmdProbe, promProbe, k8sProbe")
+	}
+
+	return nil
+}
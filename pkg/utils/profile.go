@@ -0,0 +1,106 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"text/tabwriter"
+	"time"
+)
+
+// Profile records how long the API calls, downloads and Kubernetes operations of the command take,
+// and prints them once the command is done. It's set by the --profile flag.
+var Profile bool
+
+type profileSpan struct {
+	category string
+	name     string
+	start    time.Time
+	duration time.Duration
+}
+
+var profile = struct {
+	sync.Mutex
+	started time.Time
+	spans   []profileSpan
+	printed bool
+}{started: time.Now()}
+
+// ProfileSpan starts timing an operation of the category, e.g. "graphql", and returns the function
+// ending it. It does nothing unless --profile is set.
+func ProfileSpan(category string, name string) func() {
+	if !Profile {
+		return func() {}
+	}
+	start := time.Now()
+	return func() {
+		profile.Lock()
+		defer profile.Unlock()
+		profile.spans = append(profile.spans, profileSpan{category: category, name: name, start: start, duration: time.Since(start)})
+	}
+}
+
+// PrintProfile prints the timed operations to stderr, followed by the total time per category.
+// It's only printed once, whether the command succeeds or exits with an error.
+func PrintProfile() {
+	profile.Lock()
+	defer profile.Unlock()
+	if !Profile || profile.printed {
+		return
+	}
+	profile.printed = true
+	wallTime := time.Since(profile.started)
+
+	spans := profile.spans
+	sort.SliceStable(spans, func(i, j int) bool { return spans[i].start.Before(spans[j].start) })
+
+	writer := tabwriter.NewWriter(os.Stderr, 4, 8, 1, '\t', 0)
+	fmt.Fprintln(writer, "\n⏱  Profile")
+	fmt.Fprintln(writer, "CATEGORY\tOPERATION\tSTARTED AT\tDURATION")
+	totals := make(map[string]time.Duration)
+	counts := make(map[string]int)
+	var categories []string
+	for _, span := range spans {
+		if _, ok := totals[span.category]; !ok {
+			categories = append(categories, span.category)
+		}
+		totals[span.category] += span.duration
+		counts[span.category]++
+		fmt.Fprintln(writer, span.category+"\t"+span.name+"\t+"+roundDuration(span.start.Sub(profile.started)).String()+"\t"+roundDuration(span.duration).String())
+	}
+	writer.Flush()
+
+	fmt.Fprintln(os.Stderr)
+	for _, category := range categories {
+		operations := strconv.Itoa(counts[category]) + " operations"
+		if counts[category] == 1 {
+			operations = "1 operation"
+		}
+		fmt.Fprintln(os.Stderr, category+": "+operations+", "+roundDuration(totals[category]).String())
+	}
+	fmt.Fprintln(os.Stderr, "total: "+roundDuration(wallTime).String())
+}
+
+func roundDuration(d time.Duration) time.Duration {
+	if d < time.Second {
+		return d.Round(100 * time.Microsecond)
+	}
+	return d.Round(time.Millisecond)
+}
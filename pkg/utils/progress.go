@@ -0,0 +1,204 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+)
+
+const (
+	// progressDelay is the time an operation has to take before its progress is shown, so that the
+	// quick ones don't flicker
+	progressDelay = 300 * time.Millisecond
+
+	// progressInterval is the interval the progress is redrawn at
+	progressInterval = 100 * time.Millisecond
+
+	progressBarWidth = 30
+)
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// ShowProgress reports whether the progress of the long-running operations is shown. It's drawn on
+// stderr, so it's only shown when stderr is a terminal and --quiet isn't set.
+func ShowProgress() bool {
+	return !Quiet && term.IsTerminal(int(os.Stderr.Fd()))
+}
+
+// Spinner shows that an operation is in progress, with its elapsed time, so that it's not mistaken for
+// a hang. It's inactive when the progress isn't shown, and its methods do nothing then.
+type Spinner struct {
+	mu      sync.Mutex
+	message string
+	start   time.Time
+	stop    chan struct{}
+	stopped chan struct{}
+}
+
+// StartSpinner shows the spinner with the message until it's stopped
+func StartSpinner(message string) *Spinner {
+	s := &Spinner{message: message, start: time.Now()}
+	if !ShowProgress() {
+		return s
+	}
+
+	stop, stopped := make(chan struct{}), make(chan struct{})
+	s.stop, s.stopped = stop, stopped
+	go func() {
+		defer close(stopped)
+		ticker := time.NewTicker(progressInterval)
+		defer ticker.Stop()
+		for frame := 0; ; frame++ {
+			select {
+			case <-stop:
+				clearProgress()
+				return
+			case <-ticker.C:
+			}
+			elapsed := time.Since(s.start)
+			if elapsed < progressDelay {
+				continue
+			}
+			s.mu.Lock()
+			drawProgress(spinnerFrames[frame%len(spinnerFrames)] + " " + s.message + " (" + formatElapsed(elapsed) + ")")
+			s.mu.Unlock()
+		}
+	}()
+	return s
+}
+
+// Active reports whether the spinner is shown. The progress of the operation is printed line by
+// line instead when it's not.
+func (s *Spinner) Active() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stop != nil
+}
+
+// Update replaces the message of the spinner
+func (s *Spinner) Update(message string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.message = message
+}
+
+// Stop removes the spinner. It can be called more than once.
+func (s *Spinner) Stop() {
+	s.mu.Lock()
+	stop := s.stop
+	s.stop = nil
+	s.mu.Unlock()
+	if stop != nil {
+		close(stop)
+		<-s.stopped
+	}
+}
+
+// progressReader shows a progress bar while the body is read
+type progressReader struct {
+	reader      io.Reader
+	description string
+	total       int64
+	read        int64
+	start       time.Time
+	drawn       time.Time
+	done        bool
+}
+
+// NewProgressReader shows the progress of reading the body of the given size as a progress bar, or as
+// the size read so far if the size is unknown, i.e. not above 0. The bar is removed once the body is read.
+// The reader is returned as is when the progress isn't shown.
+func NewProgressReader(reader io.Reader, total int64, description string) io.Reader {
+	if !ShowProgress() {
+		return reader
+	}
+	return &progressReader{reader: reader, description: description, total: total, start: time.Now()}
+}
+
+func (r *progressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+
+	if r.done {
+		return n, err
+	}
+	if err != nil || (r.total > 0 && r.read >= r.total) {
+		r.done = true
+		if !r.drawn.IsZero() {
+			clearProgress()
+		}
+		return n, err
+	}
+
+	if now := time.Now(); now.Sub(r.start) >= progressDelay && now.Sub(r.drawn) >= progressInterval {
+		r.drawn = now
+		elapsed := formatElapsed(now.Sub(r.start))
+		if r.total > 0 {
+			filled := int(r.read * progressBarWidth / r.total)
+			bar := strings.Repeat("█", filled) + strings.Repeat("░", progressBarWidth-filled)
+			drawProgress(fmt.Sprintf("%s %s %3d%% %s of %s (%s)", r.description, bar, r.read*100/r.total, HumanizeSize(int(r.read)), HumanizeSize(int(r.total)), elapsed))
+		} else {
+			drawProgress(fmt.Sprintf("%s %s (%s)", r.description, HumanizeSize(int(r.read)), elapsed))
+		}
+	}
+	return n, err
+}
+
+// Close closes the body read, if it's closable
+func (r *progressReader) Close() error {
+	if closer, ok := r.reader.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// HumanizeSize formats a size in bytes, e.g. 1.5 MiB
+func HumanizeSize(size int) string {
+	switch {
+	case size >= 1<<20:
+		return fmt.Sprintf("%.1f MiB", float64(size)/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.1f KiB", float64(size)/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", size)
+	}
+}
+
+// formatElapsed formats the elapsed time to the second, e.g. 1m5s
+func formatElapsed(elapsed time.Duration) string {
+	return elapsed.Truncate(time.Second).String()
+}
+
+// drawProgress replaces the line of stderr with the progress, cut to the width of the terminal
+func drawProgress(line string) {
+	if width, _, err := term.GetSize(int(os.Stderr.Fd())); err == nil && width > 1 {
+		if runes := []rune(line); len(runes) > width-1 {
+			line = string(runes[:width-1])
+		}
+	}
+	fmt.Fprint(os.Stderr, "\r\x1b[K"+line)
+}
+
+func clearProgress() {
+	fmt.Fprint(os.Stderr, "\r\x1b[K")
+}
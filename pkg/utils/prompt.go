@@ -0,0 +1,231 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"golang.org/x/term"
+)
+
+// ErrPromptCancelled is returned by the prompts when they are interrupted with Ctrl-C
+var ErrPromptCancelled = errors.New("cancelled by the user")
+
+// errInputEnded is returned by the prompts when stdin ends before an answer is given
+var errInputEnded = errors.New("no answer given, the input has ended")
+
+// Validator checks an answer of PromptInput
+type Validator func(answer string) error
+
+// promptReader reads the answers when stdin isn't a terminal, e.g. when they are piped. It's
+// shared by the prompts, so that the answers buffered by one prompt aren't lost for the next ones.
+var promptReader = bufio.NewReader(os.Stdin)
+
+// IsInteractive reports whether the prompts can use the interactive selection lists, which need
+// stdin and stdout to be a terminal. Otherwise the answers are read line by line.
+func IsInteractive() bool {
+	return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// NotEmpty validates that an answer is given for the named value
+func NotEmpty(name string) Validator {
+	return func(answer string) error {
+		if strings.TrimSpace(answer) == "" {
+			return errors.New(name + " can't be empty")
+		}
+		return nil
+	}
+}
+
+// PromptInput asks for a value until it's valid. The default value, if any, is used for an empty answer.
+func PromptInput(message string, defaultValue string, validate Validator) (string, error) {
+	if validate == nil {
+		validate = func(string) error { return nil }
+	}
+
+	if IsInteractive() {
+		var answer string
+		err := survey.AskOne(&survey.Input{Message: message, Default: defaultValue}, &answer, survey.WithValidator(func(ans interface{}) error {
+			return validate(strings.TrimSpace(ans.(string)))
+		}))
+		return strings.TrimSpace(answer), promptError(err)
+	}
+
+	for {
+		if defaultValue != "" {
+			White_B.Print("\n" + message + " [Default: " + defaultValue + "]: ")
+		} else {
+			White_B.Print("\n" + message + ": ")
+		}
+		answer, err := readAnswer()
+		if err != nil {
+			return "", err
+		}
+		if answer == "" {
+			answer = defaultValue
+		}
+		if err := validate(answer); err != nil {
+			Red.Println("⛔ " + err.Error())
+			continue
+		}
+		return answer, nil
+	}
+}
+
+// PromptSelect asks for one of the options with an arrow-key selection list, fuzzy filtered by typing,
+// and returns its index. The default option is highlighted.
+func PromptSelect(message string, options []string, defaultIndex int) (int, error) {
+	if len(options) == 0 {
+		return 0, errors.New("nothing to select for: " + message)
+	}
+	if defaultIndex < 0 || defaultIndex >= len(options) {
+		defaultIndex = 0
+	}
+
+	if IsInteractive() {
+		var index int
+		err := survey.AskOne(&survey.Select{Message: message, Options: options, Default: options[defaultIndex]}, &index, survey.WithPageSize(10), survey.WithFilter(fuzzyFilter))
+		return index, promptError(err)
+	}
+
+	White_B.Println("\n" + message)
+	for i, option := range options {
+		White.Printf("%d.  %s\n", i+1, option)
+	}
+	answer, err := PromptInput(fmt.Sprintf("Select [Range: 1-%d]", len(options)), strconv.Itoa(defaultIndex+1), func(answer string) error {
+		if index, err := strconv.Atoi(answer); err != nil || index < 1 || index > len(options) {
+			return fmt.Errorf("invalid selection, enter a number from 1 to %d", len(options))
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	index, _ := strconv.Atoi(answer)
+	return index - 1, nil
+}
+
+// PromptMultiSelect asks for any of the options, and returns their indexes in the order of the options
+// on a terminal, or in the order they were entered otherwise
+func PromptMultiSelect(message string, options []string, allowEmpty bool) ([]int, error) {
+	if len(options) == 0 {
+		return nil, errors.New("nothing to select for: " + message)
+	}
+
+	if IsInteractive() {
+		var indexes []int
+		opts := []survey.AskOpt{survey.WithPageSize(10), survey.WithFilter(fuzzyFilter)}
+		if !allowEmpty {
+			opts = append(opts, survey.WithValidator(survey.MinItems(1)))
+		}
+		err := survey.AskOne(&survey.MultiSelect{Message: message, Options: options}, &indexes, opts...)
+		return indexes, promptError(err)
+	}
+
+	White_B.Println("\n" + message)
+	for i, option := range options {
+		White.Printf("%d.  %s\n", i+1, option)
+	}
+	var indexes []int
+	_, err := PromptInput(fmt.Sprintf("Select, e.g. 1,3 [Range: 1-%d]", len(options)), "", func(answer string) error {
+		indexes = nil
+		if answer == "" {
+			if allowEmpty {
+				return nil
+			}
+			return errors.New("select at least one")
+		}
+		seen := make(map[int]bool)
+		for _, item := range strings.Split(answer, ",") {
+			index, err := strconv.Atoi(strings.TrimSpace(item))
+			if err != nil || index < 1 || index > len(options) {
+				return fmt.Errorf("invalid selection %s, enter numbers from 1 to %d", strings.TrimSpace(item), len(options))
+			}
+			if seen[index] {
+				return fmt.Errorf("%d is selected more than once", index)
+			}
+			seen[index] = true
+			indexes = append(indexes, index-1)
+		}
+		return nil
+	})
+	return indexes, err
+}
+
+// PromptConfirm asks a yes or no question
+func PromptConfirm(message string, defaultValue bool) (bool, error) {
+	if IsInteractive() {
+		var confirmed bool
+		err := survey.AskOne(&survey.Confirm{Message: message, Default: defaultValue}, &confirmed)
+		return confirmed, promptError(err)
+	}
+
+	White_B.Print("\n" + message + " [Y/N]: ")
+	answer, err := readAnswer()
+	if err != nil {
+		return false, err
+	}
+	switch strings.ToLower(answer) {
+	case "y", "yes":
+		return true, nil
+	case "":
+		return defaultValue, nil
+	default:
+		return false, nil
+	}
+}
+
+// fuzzyFilter keeps the options of the selection lists containing the typed characters in order, but not
+// necessarily next to each other, e.g. "pdel" matches "pod-delete". Spaces and the case are ignored.
+func fuzzyFilter(filter string, option string, index int) bool {
+	option = strings.ToLower(option)
+	for _, char := range strings.ToLower(filter) {
+		if char == ' ' {
+			continue
+		}
+		i := strings.IndexRune(option, char)
+		if i < 0 {
+			return false
+		}
+		option = option[i+utf8.RuneLen(char):]
+	}
+	return true
+}
+
+// readAnswer reads a line of stdin
+func readAnswer() (string, error) {
+	line, err := promptReader.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", errInputEnded
+	}
+	return strings.TrimSpace(line), nil
+}
+
+func promptError(err error) error {
+	if err == terminal.InterruptErr {
+		return ErrPromptCancelled
+	}
+	return err
+}
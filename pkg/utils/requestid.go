@@ -0,0 +1,79 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/http"
+	"os"
+	"sync/atomic"
+)
+
+const (
+	// RequestIDHeader is the header carrying the request ID of the invocation
+	RequestIDHeader = "X-Request-ID"
+
+	// RequestIDEnv sets the request ID instead of generating one, e.g. to reuse the ID of a CI job
+	RequestIDEnv = "LITMUSCTL_REQUEST_ID"
+
+	// TraceParentEnv holds the W3C trace context of the caller, which is propagated to the ChaosCenter
+	TraceParentEnv = "TRACEPARENT"
+)
+
+// RequestID identifies the API calls of this invocation of litmusctl, so that its failures can be
+// correlated with the logs of the ChaosCenter
+var RequestID = requestID()
+
+// requestIDSent is set once an API call carried the request ID, it's only printed on errors then
+var requestIDSent int32
+
+// SetRequestHeaders attaches the request ID, the trace context if any, and the custom headers of
+// --header and of the account of the URL to a request to the ChaosCenter
+func SetRequestHeaders(header http.Header, url string) {
+	setCustomHeaders(header, url)
+	header.Set(RequestIDHeader, RequestID)
+	if traceParent := os.Getenv(TraceParentEnv); traceParent != "" {
+		header.Set("traceparent", traceParent)
+	}
+	atomic.StoreInt32(&requestIDSent, 1)
+}
+
+// printRequestID prints the request ID of the invocation on errors, if any API call was made
+func printRequestID() {
+	if atomic.LoadInt32(&requestIDSent) == 1 {
+		Red.Fprintln(os.Stderr, "Request ID: "+RequestID)
+	}
+}
+
+// requestID returns the request ID set in the environment, or generates a random UUID
+func requestID() string {
+	if id := os.Getenv(RequestIDEnv); id != "" {
+		return id
+	}
+	return NewUUID()
+}
+
+// NewUUID returns a random version 4 UUID
+func NewUUID() string {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "unknown"
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
@@ -0,0 +1,239 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"sigs.k8s.io/yaml"
+)
+
+// ProbeModes are the modes a resilience probe can be run in by a Chaos Fault
+var ProbeModes = []string{"SOT", "EOT", "Edge", "Continuous", "OnChaos"}
+
+// AppKinds are the kinds of applications a Chaos Fault can target
+var AppKinds = []string{"deployment", "statefulset", "daemonset", "deploymentconfig", "rollout"}
+
+const (
+	// DefaultFaultWeight is the weight of a Chaos Fault in the resiliency score, if none is given
+	DefaultFaultWeight = 10
+
+	// workflowNamespace is replaced by Argo with the namespace of the Chaos Delegate
+	workflowNamespace = "{{workflow.parameters.adminModeNamespace}}"
+)
+
+// ScenarioFault is a Chaos Fault of a generated Chaos Scenario, with the manifests of the ChaosHub
+type ScenarioFault struct {
+	Name       string
+	Experiment string
+	Engine     string
+	Weight     int
+}
+
+// ScenarioProbe is a resilience probe referenced by the Chaos Faults of a generated Chaos Scenario
+type ScenarioProbe struct {
+	Name string `json:"name"`
+	Mode string `json:"mode"`
+}
+
+// ScenarioSpec describes a Chaos Scenario generated by litmusctl create chaos-scenario --interactive
+type ScenarioSpec struct {
+	Name string
+	// Namespace is the namespace of the Chaos Delegate, in which the Chaos Faults run
+	Namespace string
+	// Schedule is the cron syntax of a CronWorkflow, the Chaos Scenario runs once if empty
+	Schedule string
+	Faults   []ScenarioFault
+
+	AppNamespace string
+	AppLabel     string
+	AppKind      string
+
+	// Probes are attached to every Chaos Fault
+	Probes []ScenarioProbe
+}
+
+// GenerateScenarioManifest generates the Argo Workflow manifest of a Chaos Scenario, laid out like the
+// ones generated by the ChaosCenter: the ChaosExperiments are installed first, the Chaos Faults then run
+// one after another, and their ChaosEngines are cleaned up at the end.
+func GenerateScenarioManifest(spec ScenarioSpec) ([]byte, error) {
+	if len(spec.Faults) == 0 {
+		return nil, errors.New("no Chaos Faults selected for the Chaos Scenario")
+	}
+
+	var (
+		installArtifacts []interface{}
+		steps            []interface{}
+		faultTemplates   []interface{}
+	)
+	steps = append(steps, step("install-chaos-faults"))
+	for _, fault := range spec.Faults {
+		installArtifacts = append(installArtifacts, map[string]interface{}{
+			"name": fault.Name,
+			"path": "/tmp/" + fault.Name + ".yaml",
+			"raw":  map[string]interface{}{"data": fault.Experiment},
+		})
+
+		engine, err := scenarioEngine(fault, spec)
+		if err != nil {
+			return nil, err
+		}
+		steps = append(steps, step(fault.Name))
+		faultTemplates = append(faultTemplates, map[string]interface{}{
+			"name": fault.Name,
+			"metadata": map[string]interface{}{
+				"labels": map[string]interface{}{"weight": strconv.Itoa(fault.Weight)},
+			},
+			"inputs": map[string]interface{}{
+				"artifacts": []interface{}{map[string]interface{}{
+					"name": fault.Name,
+					"path": "/tmp/chaosengine-" + fault.Name + ".yaml",
+					"raw":  map[string]interface{}{"data": engine},
+				}},
+			},
+			"container": map[string]interface{}{
+				"name":  "",
+				"image": image("litmus-checker"),
+				"args":  []interface{}{"-file=/tmp/chaosengine-" + fault.Name + ".yaml", "-saveName=/tmp/engine-name"},
+			},
+		})
+	}
+	steps = append(steps, step("cleanup-chaos-resources"))
+
+	templates := []interface{}{
+		map[string]interface{}{"name": "custom-chaos", "steps": steps},
+		map[string]interface{}{
+			"name":   "install-chaos-faults",
+			"inputs": map[string]interface{}{"artifacts": installArtifacts},
+			"container": map[string]interface{}{
+				"name":    "",
+				"image":   image("k8s"),
+				"command": []interface{}{"sh", "-c"},
+				"args":    []interface{}{"kubectl apply -f /tmp/ -n " + workflowNamespace + " && sleep 30"},
+			},
+		},
+	}
+	templates = append(templates, faultTemplates...)
+	templates = append(templates, map[string]interface{}{
+		"name": "cleanup-chaos-resources",
+		"container": map[string]interface{}{
+			"name":    "",
+			"image":   image("k8s"),
+			"command": []interface{}{"sh", "-c"},
+			"args":    []interface{}{"kubectl delete chaosengine -l workflow_run_id={{workflow.uid}} -n " + workflowNamespace},
+		},
+	})
+
+	workflowSpec := map[string]interface{}{
+		"entrypoint":         "custom-chaos",
+		"serviceAccountName": "argo-chaos",
+		"securityContext":    map[string]interface{}{"runAsUser": 1000, "runAsNonRoot": true},
+		"arguments": map[string]interface{}{
+			"parameters": []interface{}{map[string]interface{}{"name": "adminModeNamespace", "value": spec.Namespace}},
+		},
+		"templates": templates,
+	}
+
+	manifest := map[string]interface{}{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Workflow",
+		"metadata":   map[string]interface{}{"name": spec.Name, "namespace": spec.Namespace},
+		"spec":       workflowSpec,
+	}
+	if spec.Schedule != "" {
+		manifest["kind"] = "CronWorkflow"
+		manifest["spec"] = map[string]interface{}{
+			"schedule":          spec.Schedule,
+			"concurrencyPolicy": "Forbid",
+			"workflowSpec":      workflowSpec,
+		}
+	}
+
+	return yaml.Marshal(manifest)
+}
+
+// scenarioEngine targets the ChaosEngine of the ChaosHub at the application, and labels it with the
+// run of the Chaos Scenario so that it's cleaned up afterwards
+func scenarioEngine(fault ScenarioFault, spec ScenarioSpec) (string, error) {
+	var engine map[string]interface{}
+	if err := yaml.Unmarshal([]byte(fault.Engine), &engine); err != nil {
+		return "", errors.New("invalid ChaosEngine of the Chaos Fault " + fault.Name + ": " + err.Error())
+	}
+	if engine == nil {
+		return "", errors.New("the Chaos Fault " + fault.Name + " has no ChaosEngine in the ChaosHub")
+	}
+
+	metadata, _ := engine["metadata"].(map[string]interface{})
+	if metadata == nil {
+		metadata = make(map[string]interface{})
+	}
+	delete(metadata, "name")
+	metadata["generateName"] = fault.Name
+	metadata["namespace"] = workflowNamespace
+
+	labels, _ := metadata["labels"].(map[string]interface{})
+	if labels == nil {
+		labels = make(map[string]interface{})
+	}
+	labels["workflow_run_id"] = "{{workflow.uid}}"
+	labels["workflow_name"] = spec.Name
+	metadata["labels"] = labels
+
+	if len(spec.Probes) > 0 {
+		probeRef, err := json.Marshal(spec.Probes)
+		if err != nil {
+			return "", err
+		}
+		annotations, _ := metadata["annotations"].(map[string]interface{})
+		if annotations == nil {
+			annotations = make(map[string]interface{})
+		}
+		annotations["probeRef"] = string(probeRef)
+		metadata["annotations"] = annotations
+	}
+	engine["metadata"] = metadata
+
+	engineSpec, _ := engine["spec"].(map[string]interface{})
+	if engineSpec == nil {
+		engineSpec = make(map[string]interface{})
+	}
+	engineSpec["engineState"] = "active"
+	engineSpec["appinfo"] = map[string]interface{}{
+		"appns":    spec.AppNamespace,
+		"applabel": spec.AppLabel,
+		"appkind":  spec.AppKind,
+	}
+	if _, ok := engineSpec["chaosServiceAccount"]; !ok {
+		engineSpec["chaosServiceAccount"] = "litmus-admin"
+	}
+	engine["spec"] = engineSpec
+
+	data, err := yaml.Marshal(engine)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func step(template string) []interface{} {
+	return []interface{}{map[string]interface{}{"name": template, "template": template}}
+}
+
+func image(name string) string {
+	return DefaultImageRegistry + "/" + DefaultImageRepo + "/" + name + ":latest"
+}
@@ -0,0 +1,157 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// WideOutput is the value of the --output flag of the list commands which adds the wide columns to their table
+const WideOutput = "wide"
+
+// AbsoluteTime makes the tables show the times in RFC3339 instead of relative to now, e.g. "3h ago".
+// It's set by the --absolute-time flag.
+var AbsoluteTime bool
+
+// TableColumn is a column of the table of a list command. The wide columns are only shown with -o wide,
+// so that the default table stays compact.
+type TableColumn struct {
+	Header string
+	Wide   bool
+}
+
+// Table prints the rows of a list command aligned in columns, with the header in bold
+type Table struct {
+	columns []TableColumn
+	wide    bool
+	rows    [][]string
+	// headerPrinted is set once the header is printed, the rows are then printed as they're added
+	headerPrinted bool
+}
+
+// NewTable returns a table with the given columns, the wide ones are shown if wide is set
+func NewTable(wide bool, columns ...TableColumn) *Table {
+	return &Table{columns: columns, wide: wide}
+}
+
+// AddRow adds a row with a value for each column of the table, including the wide ones. The times are
+// formatted with FormatTime, the other values with fmt.Sprint.
+func (t *Table) AddRow(values ...interface{}) {
+	var row []string
+	for _, value := range values {
+		switch value := value.(type) {
+		case string:
+			row = append(row, value)
+		case time.Time:
+			row = append(row, FormatTime(value))
+		default:
+			row = append(row, fmt.Sprint(value))
+		}
+	}
+	t.rows = append(t.rows, row)
+}
+
+// Print prints the table to stdout. It can be called again after adding rows, e.g. to follow the updates
+// of a resource, the header is then not repeated.
+func (t *Table) Print() {
+	writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+
+	if !t.headerPrinted {
+		var headers []string
+		for _, column := range t.columns {
+			if t.wide || !column.Wide {
+				headers = append(headers, column.Header)
+			}
+		}
+		White_B.Fprintln(writer, strings.Join(headers, "\t"))
+		t.headerPrinted = true
+	}
+
+	for _, row := range t.rows {
+		var values []string
+		for i, column := range t.columns {
+			if t.wide || !column.Wide {
+				var value string
+				if i < len(row) {
+					value = row[i]
+				}
+				values = append(values, value)
+			}
+		}
+		White.Fprintln(writer, strings.Join(values, "\t"))
+	}
+	t.rows = nil
+	writer.Flush()
+}
+
+// ParseTimestamp parses a timestamp of the ChaosCenter, which is in seconds or in milliseconds since the
+// epoch depending on the resource. It returns the zero time if the timestamp isn't set.
+func ParseTimestamp(timestamp string) time.Time {
+	value, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || value <= 0 {
+		return time.Time{}
+	}
+
+	// A timestamp in seconds only exceeds 1e12 after the year 30000, one in milliseconds after 2001
+	if value > 1e12 {
+		return time.Unix(0, value*int64(time.Millisecond))
+	}
+	return time.Unix(value, 0)
+}
+
+// FormatTime formats a time of a table relative to now, e.g. "3h ago" or "in 5m", or in RFC3339 with
+// --absolute-time. It returns "-" for the zero time.
+func FormatTime(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	if AbsoluteTime {
+		return t.Format(time.RFC3339)
+	}
+	return relativeTime(t, time.Now())
+}
+
+// relativeTime formats the time as the largest whole unit between it and now
+func relativeTime(t time.Time, now time.Time) string {
+	elapsed := now.Sub(t)
+	format := "%s ago"
+	if elapsed < 0 {
+		elapsed = -elapsed
+		format = "in %s"
+	}
+
+	var duration string
+	switch {
+	case elapsed < time.Second:
+		return "just now"
+	case elapsed < time.Minute:
+		duration = fmt.Sprintf("%ds", int(elapsed/time.Second))
+	case elapsed < time.Hour:
+		duration = fmt.Sprintf("%dm", int(elapsed/time.Minute))
+	case elapsed < 24*time.Hour:
+		duration = fmt.Sprintf("%dh", int(elapsed/time.Hour))
+	case elapsed < 365*24*time.Hour:
+		duration = fmt.Sprintf("%dd", int(elapsed/(24*time.Hour)))
+	default:
+		duration = fmt.Sprintf("%dy", int(elapsed/(365*24*time.Hour)))
+	}
+	return fmt.Sprintf(format, duration)
+}
@@ -0,0 +1,90 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/litmuschaos/litmusctl/pkg/config"
+)
+
+// DefaultMaxIdleConnsPerHost is the number of idle connections kept open to the ChaosCenter by default,
+// enough for the concurrent requests of the bulk operations
+const DefaultMaxIdleConnsPerHost = 16
+
+var (
+	// MaxIdleConnsPerHost is the number of idle connections kept open per host, set by the --max-idle-conns flag
+	MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+
+	// NoHTTP2 disables HTTP/2 with the ChaosCenter, set by the --no-http2 flag
+	NoHTTP2 bool
+)
+
+// Transport is shared by the requests to the ChaosCenter and the downloads, so that commands making
+// many calls reuse the connections and the TLS sessions instead of setting up new ones
+var Transport = newTransport()
+
+// HTTPClient sends the requests with the shared transport
+var HTTPClient = &http.Client{Transport: Transport}
+
+func newTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = DefaultMaxIdleConnsPerHost
+	transport.IdleConnTimeout = 90 * time.Second
+	transport.TLSClientConfig = &tls.Config{ClientSessionCache: tls.NewLRUClientSessionCache(0)}
+	return transport
+}
+
+// ConfigureTransport applies the connection pool, HTTP/2 and TLS settings of the flags to the shared
+// transport. It's called by the root command before the requests are sent, after the --skipSSL flag
+// may have been set by the defaults of the account.
+func ConfigureTransport() error {
+	if MaxIdleConnsPerHost < 0 {
+		return errors.New("invalid --max-idle-conns, expected a number greater than or equal to 0")
+	}
+	Transport.MaxIdleConnsPerHost = MaxIdleConnsPerHost
+	if MaxIdleConnsPerHost == 0 {
+		// No idle connections are kept, every request opens a new one
+		Transport.DisableKeepAlives = true
+	}
+
+	if config.SkipSSLVerify {
+		Transport.TLSClientConfig.InsecureSkipVerify = true
+	} else if config.CACert != "" {
+		caCert, err := ioutil.ReadFile(config.CACert)
+		if err != nil {
+			return err
+		}
+		caCertPool := x509.NewCertPool()
+		caCertPool.AppendCertsFromPEM(caCert)
+		Transport.TLSClientConfig.RootCAs = caCertPool
+	}
+
+	if NoHTTP2 {
+		// A non-nil empty map disables the HTTP/2 upgrade, see the net/http docs
+		Transport.ForceAttemptHTTP2 = false
+		Transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	}
+
+	// The clients which don't use the shared transport, e.g. the telemetry one, keep honoring --skipSSL and --cacert
+	http.DefaultTransport.(*http.Transport).TLSClientConfig = Transport.TLSClientConfig.Clone()
+	return nil
+}
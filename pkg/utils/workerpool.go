@@ -0,0 +1,113 @@
+/*
+Copyright © 2021 The LitmusChaos Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// DefaultConcurrency is the number of items processed in parallel by the bulk operations
+const DefaultConcurrency = 4
+
+// BulkResult is the outcome of a bulk operation on a single item
+type BulkResult struct {
+	Item     string
+	Message  string
+	Err      error
+	Duration time.Duration
+}
+
+// AddConcurrencyFlag registers the --concurrency flag of a bulk operation
+func AddConcurrencyFlag(cmd *cobra.Command) {
+	cmd.Flags().Int("concurrency", DefaultConcurrency, "Set the number of items processed in parallel")
+}
+
+// GetConcurrency reads the --concurrency flag
+func GetConcurrency(cmd *cobra.Command) (int, error) {
+	concurrency, err := cmd.Flags().GetInt("concurrency")
+	if err != nil {
+		return 0, err
+	}
+	if concurrency < 1 {
+		return 0, errors.New("--concurrency should be at least 1, got " + strconv.Itoa(concurrency))
+	}
+	return concurrency, nil
+}
+
+// RunBulk processes the items with a pool of at most concurrency workers. The results are
+// returned in the order of the items, whatever the order they completed in.
+func RunBulk(items []string, concurrency int, do func(item string) (string, error)) []BulkResult {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	results := make([]BulkResult, len(items))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency && w < len(items); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				start := time.Now()
+				message, err := do(items[i])
+				results[i] = BulkResult{Item: items[i], Message: message, Err: err, Duration: time.Since(start)}
+			}
+		}()
+	}
+	for i := range items {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+
+	return results
+}
+
+// PrintBulkSummary prints the results of a bulk operation as a table, followed by the count
+// of the succeeded and failed items. It returns the number of failed items.
+func PrintBulkSummary(noun string, results []BulkResult) int {
+	writer := tabwriter.NewWriter(os.Stdout, 4, 8, 1, '\t', 0)
+	fmt.Fprintln(writer, "\n"+noun+"\tRESULT\tDURATION\tDETAILS")
+
+	failed := 0
+	for _, result := range results {
+		status, details := "✅ succeeded", result.Message
+		if result.Err != nil {
+			failed++
+			status = "❌ failed"
+			details, _ = TranslateError(result.Err)
+		}
+		fmt.Fprintln(writer, result.Item+"\t"+status+"\t"+result.Duration.Round(time.Millisecond).String()+"\t"+details)
+	}
+	writer.Flush()
+
+	summary := fmt.Sprintf("\n%d succeeded, %d failed", len(results)-failed, failed)
+	if failed > 0 {
+		Red.Println(summary)
+	} else {
+		White_B.Println(summary)
+	}
+	return failed
+}
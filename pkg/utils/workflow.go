@@ -52,6 +52,14 @@ func ParseWorkflowManifest(file string, chaosWorkFlowRequest *model.ChaosWorkFlo
 		return err
 	}
 
+	return ParseWorkflowManifestBody(body, chaosWorkFlowRequest)
+}
+
+// ParseWorkflowManifestBody populates the payload for the CreateChaosWorkflow API request from the
+// content of a manifest, e.g. one generated by litmusctl.
+func ParseWorkflowManifestBody(body []byte, chaosWorkFlowRequest *model.ChaosWorkFlowRequest) error {
+	var err error
+
 	// Extract the kind of Argo Workflow from the given manifest
 	re := regexp.MustCompile(`\bkind:\s*(?P<kind>Workflow|CronWorkflow)\b`)
 	extractKind := fmt.Sprintf("${%s}", re.SubexpNames()[1])
@@ -28,7 +28,7 @@ do
     echo 'Building' $GOOS-$GOARCH
     output_name='litmusctl-'$GOOS-$GOARCH
 
-    env GOOS=$GOOS GOARCH=$GOARCH VERSION=$tag go build -ldflags "-X main.CLIVersion=$tag" -v -o platforms-$tag/$output_name $package
+    env GOOS=$GOOS GOARCH=$GOARCH VERSION=$tag go build -ldflags "-X main.CLIVersion=$tag -X github.com/litmuschaos/litmusctl/pkg/utils.CompatibilityMatrixPublicKey=$COMPATIBILITY_MATRIX_PUBLIC_KEY -X github.com/litmuschaos/litmusctl/pkg/telemetry.Endpoint=$TELEMETRY_ENDPOINT" -v -o platforms-$tag/$output_name $package
 
     if [ $? -ne 0 ]; then
         echo 'An error has occurred! Aborting the script execution...'